@@ -23,6 +23,7 @@ val services = mapOf(
     "auditmanager" to ServiceSpec("Audit Manager"),
     "autoscaling" to ServiceSpec("Auto Scaling", vpcLock = true),
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
+    "b2bi" to ServiceSpec("B2B Data Interchange"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
     "bcmdataexports" to ServiceSpec("BCM Data Exports"),
@@ -69,6 +70,7 @@ val services = mapOf(
     "datasync" to ServiceSpec("DataSync", vpcLock = true),
     "datazone" to ServiceSpec("DataZone"),
     "dax" to ServiceSpec("DynamoDB Accelerator (DAX)"),
+    "deadline" to ServiceSpec("Deadline Cloud"),
     "deploy" to ServiceSpec("CodeDeploy", vpcLock = true),
     "detective" to ServiceSpec("Detective"),
     "devicefarm" to ServiceSpec("Device Farm"),
@@ -98,6 +100,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
@@ -29,6 +29,7 @@ variable "service_labels" {
     "auditmanager",
     "autoscaling",
     "autoscalingplans",
+    "b2bi",
     "backup",
     "backupgateway",
     "batch",
@@ -89,6 +90,7 @@ variable "service_labels" {
     "datasync",
     "datazone",
     "dax",
+    "deadline",
     "deploy",
     "detective",
     "devicefarm",
@@ -123,6 +125,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -38,6 +38,8 @@ type AWSClient struct {
 	ServicePackages   map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
+	batchReads                bool // From provider configuration.
+	batchers                  map[string]any
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
@@ -45,6 +47,7 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	preventAccountClosure     bool // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -140,6 +143,37 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	return c.s3ExpressClient
 }
 
+// BatchReads returns the batch_reads provider configuration value.
+func (c *AWSClient) BatchReads(context.Context) bool {
+	return c.batchReads
+}
+
+// Batcher returns the read batcher registered under the specified key, creating it with newBatcher on first use.
+// Batchers share the lifetime of the client.
+// newBatcher is called with the client's lock held and must not call other AWSClient methods.
+func (c *AWSClient) Batcher(_ context.Context, key string, newBatcher func() any) any {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.batchers[key]; ok {
+		return v
+	}
+
+	if c.batchers == nil {
+		c.batchers = make(map[string]any)
+	}
+
+	v := newBatcher()
+	c.batchers[key] = v
+
+	return v
+}
+
+// OrganizationsPreventAccountClosure returns the organizations_prevent_account_closure provider configuration value.
+func (c *AWSClient) OrganizationsPreventAccountClosure(context.Context) bool {
+	return c.preventAccountClosure
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -185,6 +185,7 @@ import (
 	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
 	appmesh_sdkv1 "github.com/aws/aws-sdk-go/service/appmesh"
 	appsync_sdkv1 "github.com/aws/aws-sdk-go/service/appsync"
+	b2bi_sdkv1 "github.com/aws/aws-sdk-go/service/b2bi"
 	backup_sdkv1 "github.com/aws/aws-sdk-go/service/backup"
 	batch_sdkv1 "github.com/aws/aws-sdk-go/service/batch"
 	chime_sdkv1 "github.com/aws/aws-sdk-go/service/chime"
@@ -194,6 +195,7 @@ import (
 	databasemigrationservice_sdkv1 "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	dataexchange_sdkv1 "github.com/aws/aws-sdk-go/service/dataexchange"
 	datapipeline_sdkv1 "github.com/aws/aws-sdk-go/service/datapipeline"
+	deadline_sdkv1 "github.com/aws/aws-sdk-go/service/deadline"
 	detective_sdkv1 "github.com/aws/aws-sdk-go/service/detective"
 	directconnect_sdkv1 "github.com/aws/aws-sdk-go/service/directconnect"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
@@ -207,11 +209,13 @@ import (
 	elbv2_sdkv1 "github.com/aws/aws-sdk-go/service/elbv2"
 	emr_sdkv1 "github.com/aws/aws-sdk-go/service/emr"
 	emrcontainers_sdkv1 "github.com/aws/aws-sdk-go/service/emrcontainers"
+	entityresolution_sdkv1 "github.com/aws/aws-sdk-go/service/entityresolution"
 	fsx_sdkv1 "github.com/aws/aws-sdk-go/service/fsx"
 	gamelift_sdkv1 "github.com/aws/aws-sdk-go/service/gamelift"
 	glue_sdkv1 "github.com/aws/aws-sdk-go/service/glue"
 	greengrass_sdkv1 "github.com/aws/aws-sdk-go/service/greengrass"
 	guardduty_sdkv1 "github.com/aws/aws-sdk-go/service/guardduty"
+	health_sdkv1 "github.com/aws/aws-sdk-go/service/health"
 	imagebuilder_sdkv1 "github.com/aws/aws-sdk-go/service/imagebuilder"
 	inspector_sdkv1 "github.com/aws/aws-sdk-go/service/inspector"
 	iot_sdkv1 "github.com/aws/aws-sdk-go/service/iot"
@@ -343,6 +347,10 @@ func (c *AWSClient) AutoScalingPlansClient(ctx context.Context) *autoscalingplan
 	return errs.Must(client[*autoscalingplans_sdkv2.Client](ctx, c, names.AutoScalingPlans, make(map[string]any)))
 }
 
+func (c *AWSClient) B2BIConn(ctx context.Context) *b2bi_sdkv1.B2bi {
+	return errs.Must(conn[*b2bi_sdkv1.B2bi](ctx, c, names.B2BI, make(map[string]any)))
+}
+
 func (c *AWSClient) BCMDataExportsClient(ctx context.Context) *bcmdataexports_sdkv2.Client {
 	return errs.Must(client[*bcmdataexports_sdkv2.Client](ctx, c, names.BCMDataExports, make(map[string]any)))
 }
@@ -551,6 +559,10 @@ func (c *AWSClient) DataZoneClient(ctx context.Context) *datazone_sdkv2.Client {
 	return errs.Must(client[*datazone_sdkv2.Client](ctx, c, names.DataZone, make(map[string]any)))
 }
 
+func (c *AWSClient) DeadlineConn(ctx context.Context) *deadline_sdkv1.Deadline {
+	return errs.Must(conn[*deadline_sdkv1.Deadline](ctx, c, names.Deadline, make(map[string]any)))
+}
+
 func (c *AWSClient) DeployClient(ctx context.Context) *codedeploy_sdkv2.Client {
 	return errs.Must(client[*codedeploy_sdkv2.Client](ctx, c, names.Deploy, make(map[string]any)))
 }
@@ -663,6 +675,10 @@ func (c *AWSClient) ElasticsearchConn(ctx context.Context) *elasticsearchservice
 	return errs.Must(conn[*elasticsearchservice_sdkv1.ElasticsearchService](ctx, c, names.Elasticsearch, make(map[string]any)))
 }
 
+func (c *AWSClient) EntityResolutionConn(ctx context.Context) *entityresolution_sdkv1.EntityResolution {
+	return errs.Must(conn[*entityresolution_sdkv1.EntityResolution](ctx, c, names.EntityResolution, make(map[string]any)))
+}
+
 func (c *AWSClient) EventsClient(ctx context.Context) *eventbridge_sdkv2.Client {
 	return errs.Must(client[*eventbridge_sdkv2.Client](ctx, c, names.Events, make(map[string]any)))
 }
@@ -727,6 +743,10 @@ func (c *AWSClient) GuardDutyClient(ctx context.Context) *guardduty_sdkv2.Client
 	return errs.Must(client[*guardduty_sdkv2.Client](ctx, c, names.GuardDuty, make(map[string]any)))
 }
 
+func (c *AWSClient) HealthConn(ctx context.Context) *health_sdkv1.Health {
+	return errs.Must(conn[*health_sdkv1.Health](ctx, c, names.Health, make(map[string]any)))
+}
+
 func (c *AWSClient) HealthLakeClient(ctx context.Context) *healthlake_sdkv2.Client {
 	return errs.Must(client[*healthlake_sdkv2.Client](ctx, c, names.HealthLake, make(map[string]any)))
 }
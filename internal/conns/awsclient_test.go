@@ -181,3 +181,30 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientBatcher(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	c1, c2 := &AWSClient{}, &AWSClient{}
+	var n int
+	newBatcher := func() any {
+		n++
+		return new(int)
+	}
+
+	if got, want := c1.Batcher(ctx, "test", newBatcher), c1.Batcher(ctx, "test", newBatcher); got != want {
+		t.Errorf("got %p, expected %p", got, want)
+	}
+
+	if n != 1 {
+		t.Errorf("got %d batchers created, expected 1", n)
+	}
+
+	c2.Batcher(ctx, "test", newBatcher)
+	c1.Batcher(ctx, "other", newBatcher)
+
+	if n != 3 {
+		t.Errorf("got %d batchers created, expected 3", n)
+	}
+}
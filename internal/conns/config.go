@@ -10,7 +10,10 @@ import (
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -30,7 +33,10 @@ type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
+	AssumeRoleChain                []*awsbase.AssumeRole // Roles assumed in order using the credentials of the preceding role.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchReads                     bool
+	CredentialsCacheDir            string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -44,6 +50,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PreventAccountClosure          bool
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
@@ -114,8 +121,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
+	assumeRoleChain := c.AssumeRoleChain
 	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+		if c.CredentialsCacheDir != "" {
+			// The first role is assumed by the provider, rather than by aws-sdk-go-base, so that its credentials can be cached.
+			assumeRoleChain = append([]*awsbase.AssumeRole{c.AssumeRole}, assumeRoleChain...)
+		} else {
+			awsbaseConfig.AssumeRole = c.AssumeRole
+		}
 	}
 
 	if c.CustomCABundle != "" {
@@ -159,6 +172,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	for _, v := range assumeRoleChain {
+		if v == nil || v.RoleARN == "" {
+			return nil, sdkdiag.AppendErrorf(diags, "assume_role: role_arn is required when multiple assume_role blocks are specified")
+		}
+
+		tflog.Debug(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn": v.RoleARN,
+		})
+		credentialsProvider, err := c.assumeRoleCredentialsProvider(ctx, cfg, v)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "assuming IAM Role (%s): %s", v.RoleARN, err)
+		}
+
+		cfg.Credentials = credentialsProvider
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -220,10 +250,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
+	client.batchReads = c.BatchReads
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.preventAccountClosure = c.PreventAccountClosure
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -231,6 +263,74 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	return client, diags
 }
 
+// assumeRoleCredentialsProvider returns a credentials provider for the specified IAM Role,
+// using the credentials from the specified AWS SDK for Go v2 configuration to call STS.
+func (c *Config) assumeRoleCredentialsProvider(ctx context.Context, cfg aws_sdkv2.Config, assumeRole *awsbase.AssumeRole) (aws_sdkv2.CredentialsProvider, error) {
+	client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+		if c.STSRegion != "" {
+			o.Region = c.STSRegion
+		}
+
+		if endpoint := c.Endpoints[names.STS]; endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	})
+
+	var provider aws_sdkv2.CredentialsProvider = stscreds_sdkv2.NewAssumeRoleProvider(client, assumeRole.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+		o.RoleSessionName = assumeRole.SessionName
+		o.Duration = assumeRole.Duration
+
+		if assumeRole.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(assumeRole.ExternalID)
+		}
+
+		if assumeRole.Policy != "" {
+			o.Policy = aws_sdkv2.String(assumeRole.Policy)
+		}
+
+		for _, v := range assumeRole.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		if assumeRole.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(assumeRole.SourceIdentity)
+		}
+
+		for k, v := range assumeRole.Tags {
+			o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		o.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+	})
+
+	if c.CredentialsCacheDir != "" {
+		source, err := cfg.Credentials.Retrieve(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := credentialsCacheKey(source.AccessKeyID, assumeRole)
+
+		if err != nil {
+			return nil, err
+		}
+
+		provider = newFileCredentialsProvider(c.CredentialsCacheDir, key, provider)
+	}
+
+	if _, err := provider.Retrieve(ctx); err != nil {
+		return nil, err
+	}
+
+	return aws_sdkv2.NewCredentialsCache(provider), nil
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
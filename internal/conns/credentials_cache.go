@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Cached credentials expiring within this window are refreshed.
+	credentialsCacheExpiryWindow = 5 * time.Minute
+)
+
+// fileCredentialsProvider is an AWS SDK for Go v2 credentials provider that persists the
+// credentials returned by another provider in a file so that they can be shared across
+// provider instances and Terraform runs until they expire.
+type fileCredentialsProvider struct {
+	path     string
+	provider aws_sdkv2.CredentialsProvider
+}
+
+var _ aws_sdkv2.CredentialsProvider = (*fileCredentialsProvider)(nil)
+
+func newFileCredentialsProvider(dir, key string, provider aws_sdkv2.CredentialsProvider) *fileCredentialsProvider {
+	return &fileCredentialsProvider{
+		path:     filepath.Join(dir, key+".json"),
+		provider: provider,
+	}
+}
+
+func (p *fileCredentialsProvider) Retrieve(ctx context.Context) (aws_sdkv2.Credentials, error) {
+	if credentials, err := p.read(); err == nil && credentials.HasKeys() && !credentials.Expired() {
+		tflog.Debug(ctx, "Using cached credentials", map[string]any{
+			"tf_aws.credentials_cache.path": p.path,
+		})
+
+		return credentials, nil
+	}
+
+	credentials, err := p.provider.Retrieve(ctx)
+
+	if err != nil {
+		return aws_sdkv2.Credentials{}, err
+	}
+
+	if err := p.write(credentials); err != nil {
+		tflog.Warn(ctx, "Unable to cache credentials", map[string]any{
+			"tf_aws.credentials_cache.path": p.path,
+			"error":                         err.Error(),
+		})
+	}
+
+	return credentials, nil
+}
+
+func (p *fileCredentialsProvider) read() (aws_sdkv2.Credentials, error) {
+	var credentials aws_sdkv2.Credentials
+
+	b, err := os.ReadFile(p.path)
+
+	if err != nil {
+		return credentials, err
+	}
+
+	if err := json.Unmarshal(b, &credentials); err != nil {
+		return credentials, err
+	}
+
+	if credentials.CanExpire {
+		credentials.Expires = credentials.Expires.Add(-credentialsCacheExpiryWindow)
+	}
+
+	return credentials, nil
+}
+
+func (p *fileCredentialsProvider) write(credentials aws_sdkv2.Credentials) error {
+	if !credentials.CanExpire {
+		// Only temporary credentials are cached.
+		return nil
+	}
+
+	b, err := json.Marshal(credentials)
+
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(p.path)
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it so that concurrent readers never see a partial file.
+	f, err := os.CreateTemp(dir, filepath.Base(p.path)+".*")
+
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), p.path)
+}
+
+// credentialsCacheKey returns the cache key for credentials obtained by assuming the specified IAM Role
+// using source credentials with the specified access key ID.
+func credentialsCacheKey(sourceAccessKeyID string, assumeRole *awsbase.AssumeRole) (string, error) {
+	b, err := json.Marshal(struct {
+		SourceAccessKeyID string
+		AssumeRole        *awsbase.AssumeRole
+	}{
+		SourceAccessKeyID: sourceAccessKeyID,
+		AssumeRole:        assumeRole,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
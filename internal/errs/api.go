@@ -4,6 +4,12 @@
 package errs
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	smithy "github.com/aws/smithy-go"
 )
 
@@ -14,3 +20,104 @@ func APIError[T ~string](code T, message string) smithy.APIError {
 		Message: message,
 	}
 }
+
+// serviceRequestIDer is implemented by AWS SDK for Go v2 HTTP response errors.
+type serviceRequestIDer interface {
+	ServiceRequestID() string
+}
+
+// APIErrorDetail contains the details of a failed AWS API call useful for triage.
+type APIErrorDetail struct {
+	Code      string
+	RequestID string
+	Attempts  int
+}
+
+// APIErrorDetails returns the AWS API error code, request ID and number of attempts made from an error returned by an AWS SDK.
+// Fields that cannot be determined are left as their zero value.
+func APIErrorDetails(err error) APIErrorDetail {
+	var detail APIErrorDetail
+
+	if err == nil {
+		return detail
+	}
+
+	if apiErr, ok := As[smithy.APIError](err); ok {
+		detail.Code = apiErr.ErrorCode()
+	} else if awsErr, ok := As[awserr.Error](err); ok {
+		detail.Code = awsErr.Code()
+	}
+
+	var requestIDErr serviceRequestIDer
+	if errors.As(err, &requestIDErr) {
+		detail.RequestID = requestIDErr.ServiceRequestID()
+	} else if v, ok := As[awserr.RequestFailure](err); ok {
+		detail.RequestID = v.RequestID()
+	}
+
+	if v, ok := As[*retry.MaxAttemptsError](err); ok {
+		detail.Attempts = v.Attempt
+	}
+
+	return detail
+}
+
+// Prefixes of the lines written by APIErrorDetail.String.
+// The lines are stable so that tooling can match on them in diagnostic details.
+const (
+	APIErrorDetailCodePrefix      = "Error code: "
+	APIErrorDetailRequestIDPrefix = "Request ID: "
+	APIErrorDetailAttemptsPrefix  = "Attempts: "
+)
+
+// String returns the details formatted as one "Key: value" line per known field.
+func (d APIErrorDetail) String() string {
+	var lines []string
+
+	if d.Code != "" {
+		lines = append(lines, APIErrorDetailCodePrefix+d.Code)
+	}
+	if d.RequestID != "" {
+		lines = append(lines, APIErrorDetailRequestIDPrefix+d.RequestID)
+	}
+	if d.Attempts > 0 {
+		lines = append(lines, APIErrorDetailAttemptsPrefix+strconv.Itoa(d.Attempts))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ParseAPIErrorDetail returns the AWS API error details written by APIErrorDetail.String found in s.
+// Lines that are not details are ignored.
+func ParseAPIErrorDetail(s string) APIErrorDetail {
+	var detail APIErrorDetail
+
+	for _, line := range strings.Split(s, "\n") {
+		if v, ok := strings.CutPrefix(line, APIErrorDetailCodePrefix); ok {
+			detail.Code = v
+		} else if v, ok := strings.CutPrefix(line, APIErrorDetailRequestIDPrefix); ok {
+			detail.RequestID = v
+		} else if v, ok := strings.CutPrefix(line, APIErrorDetailAttemptsPrefix); ok {
+			if n, err := strconv.Atoi(v); err == nil {
+				detail.Attempts = n
+			}
+		}
+	}
+
+	return detail
+}
+
+// AppendAPIErrorDetail returns detail followed by the AWS API error details of err, if any,
+// separated by a blank line.
+func AppendAPIErrorDetail(detail string, err error) string {
+	v := APIErrorDetails(err).String()
+
+	switch {
+	case v == "":
+		return detail
+	case detail == "":
+		return v
+	default:
+		return detail + "\n\n" + v
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestAPIErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err        error
+		want       errs.APIErrorDetail
+		wantString string
+	}{
+		"nil": {},
+		"not an API error": {
+			err: errors.New("test"),
+		},
+		"AWS SDK for Go v2": {
+			err: &smithy.OperationError{
+				ServiceID:     "Test",
+				OperationName: "Test",
+				Err: &retry.MaxAttemptsError{
+					Attempt: 3,
+					Err: &awshttp.ResponseError{
+						ResponseError: &smithyhttp.ResponseError{
+							Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+							Err:      errs.APIError("ThrottlingException", "Rate exceeded"),
+						},
+						RequestID: "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE",
+					},
+				},
+			},
+			want: errs.APIErrorDetail{
+				Code:      "ThrottlingException",
+				RequestID: "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE",
+				Attempts:  3,
+			},
+			wantString: "Error code: ThrottlingException\nRequest ID: 7a62c49f-347e-4fc4-9331-6e8eEXAMPLE\nAttempts: 3",
+		},
+		"AWS SDK for Go v1": {
+			err: awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "4442587FB7D0A2F9"),
+			want: errs.APIErrorDetail{
+				Code:      "AccessDenied",
+				RequestID: "4442587FB7D0A2F9",
+			},
+			wantString: "Error code: AccessDenied\nRequest ID: 4442587FB7D0A2F9",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := errs.APIErrorDetails(testCase.err)
+
+			if got != testCase.want {
+				t.Errorf("got %+v, want %+v", got, testCase.want)
+			}
+
+			if got, want := got.String(), testCase.wantString; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+
+			if got, want := errs.ParseAPIErrorDetail("test\n\n"+got.String()), testCase.want; got != want {
+				t.Errorf("ParseAPIErrorDetail() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestAppendAPIErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	apiErr := awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "4442587FB7D0A2F9")
+	testCases := map[string]struct {
+		detail string
+		err    error
+		want   string
+	}{
+		"no detail, not an API error": {
+			err: errors.New("test"),
+		},
+		"detail, not an API error": {
+			detail: "test",
+			err:    errors.New("test"),
+			want:   "test",
+		},
+		"no detail, API error": {
+			err:  apiErr,
+			want: "Error code: AccessDenied\nRequest ID: 4442587FB7D0A2F9",
+		},
+		"detail, API error": {
+			detail: "test",
+			err:    apiErr,
+			want:   "test\n\nError code: AccessDenied\nRequest ID: 4442587FB7D0A2F9",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.AppendAPIErrorDetail(testCase.detail, testCase.err), testCase.want; got != want {
+				t.Errorf("AppendAPIErrorDetail() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -38,6 +39,16 @@ func DiagnosticString(d diag.Diagnostic) string {
 	return buf.String()
 }
 
+// NewErrorDiagnostic returns an error Diagnostic whose Detail is the error message
+// followed by the AWS API error details of err, if any.
+func NewErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	if err == nil {
+		return diag.NewErrorDiagnostic(summary, "")
+	}
+
+	return diag.NewErrorDiagnostic(summary, errs.AppendAPIErrorDetail(err.Error(), err))
+}
+
 func NewResourceNotFoundWarningDiagnostic(err error) diag.Diagnostic {
 	return diag.NewWarningDiagnostic(
 		"AWS resource not found during refresh",
@@ -4,9 +4,13 @@
 package fwdiag_test
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 )
 
@@ -69,3 +73,42 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want diag.Diagnostic
+	}{
+		"nil error": {
+			want: diag.NewErrorDiagnostic("reading Thing (id)", ""),
+		},
+		"not an API error": {
+			err:  errors.New("test"),
+			want: diag.NewErrorDiagnostic("reading Thing (id)", "test"),
+		},
+		"API error": {
+			err:  awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "4442587FB7D0A2F9"),
+			want: diag.NewErrorDiagnostic("reading Thing (id)", "AccessDenied: Access Denied\n\tstatus code: 403, request id: 4442587FB7D0A2F9\n\nError code: AccessDenied\nRequest ID: 4442587FB7D0A2F9"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwdiag.NewErrorDiagnostic("reading Thing (id)", testCase.err)
+
+			if !got.Equal(testCase.want) {
+				t.Errorf("got %q, want %q", fwdiag.DiagnosticString(got), fwdiag.DiagnosticString(testCase.want))
+			}
+
+			if got, want := errs.ParseAPIErrorDetail(got.Detail()).Code, errs.APIErrorDetails(testCase.err).Code; got != want {
+				t.Errorf("error code = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -18,14 +19,33 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 }
 
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withAPIErrorDetail(diag.Errorf(format, a...), a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
 }
 
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, withAPIErrorDetail(diag.FromErr(err), err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+}
+
+// withAPIErrorDetail appends the AWS API error details of the first error in a, if any,
+// to the Detail of Diagnostics.
+func withAPIErrorDetail(diags diag.Diagnostics, a ...any) diag.Diagnostics {
+	for _, v := range a {
+		err, ok := v.(error)
+		if !ok {
+			continue
+		}
+
+		for i := range diags {
+			diags[i].Detail = errs.AppendAPIErrorDetail(diags[i].Detail, err)
+		}
+
+		break
+	}
+
+	return diags
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendErrorf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want diag.Diagnostics
+	}{
+		"not an API error": {
+			err: errors.New("test"),
+			want: diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "reading Thing (id): test",
+				},
+			},
+		},
+		"API error": {
+			err: awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "4442587FB7D0A2F9"),
+			want: diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "reading Thing (id): AccessDenied: Access Denied\n\tstatus code: 403, request id: 4442587FB7D0A2F9",
+					Detail:   "Error code: AccessDenied\nRequest ID: 4442587FB7D0A2F9",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := sdkdiag.AppendErrorf(nil, "reading Thing (%s): %s", "id", testCase.err)
+
+			if diff := cmp.Diff(got, testCase.want, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAppendFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want diag.Diagnostics
+	}{
+		"nil": {},
+		"not an API error": {
+			err: errors.New("test"),
+			want: diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "test",
+				},
+			},
+		},
+		"API error": {
+			err: awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "4442587FB7D0A2F9"),
+			want: diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "AccessDenied: Access Denied\n\tstatus code: 403, request id: 4442587FB7D0A2F9",
+					Detail:   "Error code: AccessDenied\nRequest ID: 4442587FB7D0A2F9",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := sdkdiag.AppendFromErr(nil, testCase.err)
+
+			if diff := cmp.Diff(got, testCase.want, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := errs.ParseAPIErrorDetail(diagsDetail(got)).Code, errs.APIErrorDetails(testCase.err).Code; got != want {
+				t.Errorf("error code = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func diagsDetail(diags diag.Diagnostics) string {
+	if len(diags) == 0 {
+		return ""
+	}
+
+	return diags[0].Detail
+}
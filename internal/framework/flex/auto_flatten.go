@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/shopspring/decimal"
@@ -342,7 +343,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 				doc := vFrom.Interface().(smithyjson.JSONStringer)
 				b, err := doc.MarshalSmithyDocument()
 				if err != nil {
-					diags.AddError("AutoFlEx", err.Error())
+					diags.Append(fwdiag.NewErrorDiagnostic("AutoFlEx", err))
 					return diags
 				}
 				stringValue = types.StringValue(string(b))
@@ -73,7 +73,10 @@ func main() {
 			td.GoV1Package = l.GoV1Package()
 
 			switch packageName {
-			case "imagebuilder",
+			case "b2bi",
+				"deadline",
+				"entityresolution",
+				"imagebuilder",
 				"globalaccelerator",
 				"route53recoveryreadiness",
 				"worklink":
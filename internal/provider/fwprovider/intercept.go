@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -505,7 +506,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 					}
 
 					if err != nil {
-						diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+						diags.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err))
 
 						return ctx, diags
 					}
@@ -637,7 +638,7 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 					}
 
 					if err != nil {
-						diags.AddError(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+						diags.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err))
 
 						return ctx, diags
 					}
@@ -56,6 +56,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"batch_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to combine concurrent reads of resources that support\nbulk describe operations into fewer API calls during refresh.",
+			},
+			"credentials_cache_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: "Directory in which to cache temporary credentials obtained by assuming IAM roles. Can also be configured using the `TF_AWS_CREDENTIALS_CACHE_DIR` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -92,6 +100,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"organizations_prevent_account_closure": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to prevent `aws_organizations_account` resources from closing\nmember accounts on destroy. Specific to the AWS Organizations service.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -167,9 +179,6 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -480,6 +489,11 @@ func endpointsBlock() schema.SetNestedBlock {
 		}
 	}
 
+	endpointsAttributes["template"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Use this to override the default endpoint URL of all services not otherwise configured, except iam, sso and sts. The placeholder `{service}` is replaced by the service's endpoint key",
+	}
+
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
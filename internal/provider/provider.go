@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -51,6 +52,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to combine concurrent reads of resources that support\n" +
+					"bulk describe operations into fewer API calls during refresh.",
+			},
+			"credentials_cache_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Directory in which to cache temporary credentials obtained by assuming IAM roles. " +
+					"Can also be configured using the `TF_AWS_CREDENTIALS_CACHE_DIR` environment variable.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -146,6 +159,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"organizations_prevent_account_closure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to prevent `aws_organizations_account` resources from closing\n" +
+					"member accounts on destroy. Specific to the AWS Organizations service.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -477,12 +496,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		BatchReads:                     d.Get("batch_reads").(bool),
+		CredentialsCacheDir:            d.Get("credentials_cache_dir").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PreventAccountClosure:          d.Get("organizations_prevent_account_closure").(bool),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -510,18 +532,41 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.S3USEast1RegionalEndpoint = conns.NormalizeS3USEast1RegionalEndpoint(v)
 	}
 
+	if config.CredentialsCacheDir == "" {
+		config.CredentialsCacheDir = os.Getenv("TF_AWS_CREDENTIALS_CACHE_DIR")
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		// The first role is assumed using the base credentials and any subsequent roles are chained.
+		tfList := v.([]interface{})
+		for i, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok || tfMap["role_arn"].(string) == "" {
+				if len(tfList) > 1 {
+					return nil, sdkdiag.AppendErrorf(diags, "assume_role.%d: role_arn is required when multiple assume_role blocks are specified", i)
+				}
+
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			if i == 0 {
+				config.AssumeRole = assumeRole
+			} else {
+				config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
+			}
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -607,7 +652,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -743,6 +787,13 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	endpointsAttributes[endpointTemplateKey] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "Use this to override the default endpoint URL of all services not otherwise configured, except iam, sso and sts. The placeholder `{service}` is replaced by the service's endpoint key",
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -886,6 +937,7 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 	}
 
 	endpoints := make(map[string]string)
+	var template string
 
 	for i, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -896,6 +948,10 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 
 		elementPath := endpointsPath.IndexInt(i)
 
+		if v, ok := tfMap[endpointTemplateKey].(string); ok && v != "" && template == "" {
+			template = v
+		}
+
 		for _, endpoint := range names.Endpoints() {
 			pkg := endpoint.ProviderPackage
 
@@ -972,9 +1028,46 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 		}
 	}
 
+	if template != "" {
+		for _, pkg := range names.ProviderPackages() {
+			if endpoints[pkg] != "" {
+				continue
+			}
+
+			// The STS, IAM and SSO endpoints are used to authenticate and are only changed when set explicitly.
+			if slices.Contains(endpointTemplateExcludedPackages, pkg) {
+				continue
+			}
+
+			// Services configured via the AWS SDK's own environment variables take precedence over the template.
+			if v := names.AWSServiceEnvVar(pkg); v != "" && os.Getenv(v) != "" {
+				continue
+			}
+
+			endpoints[pkg] = expandEndpointTemplate(template, pkg)
+		}
+	}
+
 	return endpoints, diags
 }
 
+const (
+	endpointTemplateKey            = "template"
+	endpointTemplateServiceElement = "{service}"
+)
+
+// endpointTemplateExcludedPackages are the services whose endpoints are not set from an endpoint template.
+var endpointTemplateExcludedPackages = []string{
+	names.IAM,
+	names.SSO,
+	names.STS,
+}
+
+// expandEndpointTemplate returns the endpoint URL for the specified service from an endpoint template.
+func expandEndpointTemplate(template, pkg string) string {
+	return strings.ReplaceAll(template, endpointTemplateServiceElement, pkg)
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
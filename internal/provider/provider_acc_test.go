@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -158,6 +159,26 @@ func TestAccProvider_endpoints(t *testing.T) {
 	})
 }
 
+func TestAccProvider_endpointTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider *schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactoriesInternal(ctx, t, &provider),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_endpoints(`template = "http://{service}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplatedEndpoints(ctx, &provider),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProvider_customEndpoint(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -549,6 +570,50 @@ func TestAccProvider_AssumeRole_empty(t *testing.T) {
 	})
 }
 
+func TestAccProvider_AssumeRole_chain(t *testing.T) {
+	ctx := acctest.Context(t)
+	envvarRoleARN1 := acctest.SkipIfEnvVarNotSet(t, "TF_ACC_ASSUME_ROLE_ARN")
+	envvarRoleARN2 := acctest.SkipIfEnvVarNotSet(t, "TF_ACC_ASSUME_ROLE_CHAINED_ARN")
+	chainedRoleName := envvarRoleARN2[strings.LastIndex(envvarRoleARN2, "/")+1:]
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_assumeRoleChain(envvarRoleARN1, envvarRoleARN2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.aws_caller_identity.current", names.AttrARN, func(value string) error {
+						if !strings.Contains(value, ":assumed-role/"+chainedRoleName+"/") {
+							return fmt.Errorf("caller identity (%s) is not the chained role (%s)", value, envvarRoleARN2)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_AssumeRole_chainEmpty(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_assumeRoleChainEmpty,
+				ExpectError: regexache.MustCompile(`assume_role.0: role_arn is required when multiple assume_role blocks are specified`),
+			},
+		},
+	})
+}
+
 func testAccProtoV5ProviderFactoriesInternal(ctx context.Context, t *testing.T, v **schema.Provider) map[string]func() (tfprotov5.ProviderServer, error) {
 	providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
 
@@ -869,6 +934,38 @@ func testAccCheckProviderDefaultTags_Tags(ctx context.Context, t *testing.T, p *
 }
 
 func testAccCheckEndpoints(_ context.Context, p **schema.Provider) resource.TestCheckFunc {
+	return testAccCheckServiceEndpoints(p, names.Aliases(), func(serviceKey, actualEndpoint string) error {
+		expectedEndpoint := fmt.Sprintf("http://%s", serviceKey)
+
+		if actualEndpoint != expectedEndpoint {
+			return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", serviceKey, expectedEndpoint, actualEndpoint)
+		}
+
+		return nil
+	})
+}
+
+// testAccCheckTemplatedEndpoints checks the endpoints set from the template "http://{service}".
+func testAccCheckTemplatedEndpoints(_ context.Context, p **schema.Provider) resource.TestCheckFunc {
+	return testAccCheckServiceEndpoints(p, names.ProviderPackages(), func(pkg, actualEndpoint string) error {
+		templatedEndpoint := fmt.Sprintf("http://%s", pkg)
+
+		switch pkg {
+		case names.IAM, names.SSO, names.STS:
+			if actualEndpoint == templatedEndpoint {
+				return fmt.Errorf("expected endpoint (%s) not to be set from template, got: %s", pkg, actualEndpoint)
+			}
+		default:
+			if actualEndpoint != templatedEndpoint {
+				return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", pkg, templatedEndpoint, actualEndpoint)
+			}
+		}
+
+		return nil
+	})
+}
+
+func testAccCheckServiceEndpoints(p **schema.Provider, serviceKeys []string, check func(serviceKey, actualEndpoint string) error) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if p == nil || *p == nil || (*p).Meta() == nil || (*p).Meta().(*conns.AWSClient) == nil {
 			return fmt.Errorf("provider not initialized")
@@ -876,7 +973,7 @@ func testAccCheckEndpoints(_ context.Context, p **schema.Provider) resource.Test
 
 		providerClient := (*p).Meta().(*conns.AWSClient)
 
-		for _, serviceKey := range names.Aliases() {
+		for _, serviceKey := range serviceKeys {
 			methodName := serviceConn(serviceKey)
 			method := reflect.ValueOf(providerClient).MethodByName(methodName)
 			if !method.IsValid() {
@@ -906,10 +1003,9 @@ func testAccCheckEndpoints(_ context.Context, p **schema.Provider) resource.Test
 			}
 
 			actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
-			expectedEndpoint := fmt.Sprintf("http://%s", serviceKey)
 
-			if actualEndpoint != expectedEndpoint {
-				return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", serviceKey, expectedEndpoint, actualEndpoint)
+			if err := check(serviceKey, actualEndpoint); err != nil {
+				return err
 			}
 		}
 
@@ -988,6 +1084,36 @@ provider "aws" {
 data "aws_caller_identity" "current" {}
 ` //lintignore:AT004
 
+const testAccProviderConfig_assumeRoleChainEmpty = `
+provider "aws" {
+  assume_role {
+  }
+
+  assume_role {
+    role_arn = "arn:aws:iam::123456789012:role/test"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+` //lintignore:AT004
+
+func testAccProviderConfig_assumeRoleChain(roleARN1, roleARN2 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  assume_role {
+    role_arn = %[1]q
+  }
+
+  assume_role {
+    role_arn = %[2]q
+  }
+}
+
+data "aws_caller_identity" "current" {}
+`, roleARN1, roleARN2)
+}
+
 const testAccProviderConfig_base = `
 data "aws_region" "provider_test" {}
 
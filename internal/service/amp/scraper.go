@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -250,10 +251,10 @@ func (r *scraperResource) Create(ctx context.Context, req resource.CreateRequest
 	output, err := conn.CreateScraper(ctx, input)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AMP, create.ErrActionCreating, ResNameScraper, "", err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -264,10 +265,10 @@ func (r *scraperResource) Create(ctx context.Context, req resource.CreateRequest
 	scraper, err := waitScraperCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AMP, create.ErrActionWaitingForCreation, ResNameScraper, "", err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -303,10 +304,10 @@ func (r *scraperResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AMP, create.ErrActionSetting, ResNameScraper, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -376,18 +377,18 @@ func (r *scraperResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AMP, create.ErrActionDeleting, ResNameScraper, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	if _, err := waitScraperDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AMP, create.ErrActionWaitingForDeletion, ResNameScraper, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -166,10 +166,10 @@ func (r *resourceEnvironment) Create(ctx context.Context, request resource.Creat
 
 	environment, err := conn.CreateEnvironment(ctx, input)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("creating AppConfig Environment for Application (%s)", appId),
-			err.Error(),
-		)
+			err,
+		))
 	}
 	if environment == nil {
 		response.Diagnostics.AddError(
@@ -204,10 +204,10 @@ func (r *resourceEnvironment) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("reading AppConfig Environment (%s) for Application (%s)", state.EnvironmentID.ValueString(), state.ApplicationID.ValueString()),
-			err.Error(),
-		)
+			err,
+		))
 	}
 
 	response.Diagnostics.Append(state.refreshFromGetOutput(ctx, r.Meta(), output)...)
@@ -257,10 +257,10 @@ func (r *resourceEnvironment) Update(ctx context.Context, request resource.Updat
 
 		output, err := conn.UpdateEnvironment(ctx, updateInput)
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				fmt.Sprintf("updating AppConfig Environment (%s) for Application (%s)", state.EnvironmentID.ValueString(), state.ApplicationID.ValueString()),
-				err.Error(),
-			)
+				err,
+			))
 		}
 
 		response.Diagnostics.Append(plan.refreshFromUpdateOutput(ctx, r.Meta(), output)...)
@@ -291,10 +291,10 @@ func (r *resourceEnvironment) Delete(ctx context.Context, request resource.Delet
 		return
 	}
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("deleting AppConfig Environment (%s) for Application (%s)", state.EnvironmentID.ValueString(), state.ApplicationID.ValueString()),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -5,6 +5,7 @@ package appconfig
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -52,14 +55,18 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
 			},
 			names.AttrContent: {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{names.AttrContent, "feature_flags"},
+				RequiredWith: []string{names.AttrContentType},
 			},
 			names.AttrContentType: {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
@@ -69,6 +76,113 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"feature_flags": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{names.AttrContent, "feature_flags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flag": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enum": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"maximum": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidTypeStringNullableFloat,
+												},
+												"minimum": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidTypeStringNullableFloat,
+												},
+												"pattern": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"required": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												names.AttrType: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+												},
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												names.AttrValues: {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"deprecation_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"planned"}, false),
+									},
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									names.AttrKey: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"version_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -91,6 +205,20 @@ func resourceHostedConfigurationVersionCreate(ctx context.Context, d *schema.Res
 		ContentType:            aws.String(d.Get(names.AttrContentType).(string)),
 	}
 
+	if v, ok := d.GetOk("feature_flags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		content, err := expandFeatureFlags(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating AppConfig HostedConfigurationVersion for Application (%s): %s", appID, err)
+		}
+
+		input.Content = content
+
+		if input.ContentType == nil || aws.ToString(input.ContentType) == "" {
+			input.ContentType = aws.String(featureFlagsContentType)
+		}
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -199,3 +327,226 @@ func HostedConfigurationVersionParseID(id string) (string, string, int32, error)
 
 	return parts[0], parts[1], int32(version), nil
 }
+
+const (
+	featureFlagsContentType = "application/json"
+	featureFlagsVersion     = "1"
+)
+
+const (
+	featureFlagAttributeTypeBoolean     = "boolean"
+	featureFlagAttributeTypeNumber      = "number"
+	featureFlagAttributeTypeNumberArray = "number[]"
+	featureFlagAttributeTypeString      = "string"
+	featureFlagAttributeTypeStringArray = "string[]"
+)
+
+func featureFlagAttributeType_Values() []string {
+	return []string{
+		featureFlagAttributeTypeBoolean,
+		featureFlagAttributeTypeNumber,
+		featureFlagAttributeTypeNumberArray,
+		featureFlagAttributeTypeString,
+		featureFlagAttributeTypeStringArray,
+	}
+}
+
+type featureFlagsDocument struct {
+	Flags   map[string]*featureFlagDefinition `json:"flags"`
+	Values  map[string]map[string]interface{} `json:"values"`
+	Version string                            `json:"version"`
+}
+
+type featureFlagDefinition struct {
+	Attributes  map[string]*featureFlagAttribute `json:"attributes,omitempty"`
+	Deprecation *featureFlagDeprecation          `json:"_deprecation,omitempty"`
+	Description string                           `json:"description,omitempty"`
+	Name        string                           `json:"name"`
+}
+
+type featureFlagDeprecation struct {
+	Status string `json:"status"`
+}
+
+type featureFlagAttribute struct {
+	Constraints *featureFlagAttributeConstraints `json:"constraints"`
+}
+
+type featureFlagAttributeConstraints struct {
+	Enum     []string `json:"enum,omitempty"`
+	Maximum  *float64 `json:"maximum,omitempty"`
+	Minimum  *float64 `json:"minimum,omitempty"`
+	Pattern  string   `json:"pattern,omitempty"`
+	Required bool     `json:"required,omitempty"`
+	Type     string   `json:"type"`
+}
+
+// expandFeatureFlags renders a feature_flags block as an AWS.AppConfig.FeatureFlags JSON document.
+func expandFeatureFlags(tfMap map[string]interface{}) ([]byte, error) {
+	doc := &featureFlagsDocument{
+		Flags:   make(map[string]*featureFlagDefinition),
+		Values:  make(map[string]map[string]interface{}),
+		Version: featureFlagsVersion,
+	}
+
+	for _, tfMapRaw := range tfMap["flag"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := tfMap[names.AttrKey].(string)
+
+		if _, ok := doc.Flags[key]; ok {
+			return nil, fmt.Errorf("duplicate feature flag key (%s)", key)
+		}
+
+		flag := &featureFlagDefinition{
+			Description: tfMap[names.AttrDescription].(string),
+			Name:        tfMap[names.AttrName].(string),
+		}
+
+		if v, ok := tfMap["deprecation_status"].(string); ok && v != "" {
+			flag.Deprecation = &featureFlagDeprecation{
+				Status: v,
+			}
+		}
+
+		values := map[string]interface{}{
+			names.AttrEnabled: tfMap[names.AttrEnabled].(bool),
+		}
+
+		for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			attributeKey := tfMap[names.AttrKey].(string)
+
+			if attributeKey == names.AttrEnabled {
+				return nil, fmt.Errorf("feature flag (%s) attribute key %q is reserved", key, attributeKey)
+			}
+
+			if flag.Attributes == nil {
+				flag.Attributes = make(map[string]*featureFlagAttribute)
+			}
+
+			if _, ok := flag.Attributes[attributeKey]; ok {
+				return nil, fmt.Errorf("feature flag (%s) has duplicate attribute key (%s)", key, attributeKey)
+			}
+
+			attribute, value, err := expandFeatureFlagAttribute(tfMap)
+
+			if err != nil {
+				return nil, fmt.Errorf("feature flag (%s) attribute (%s): %w", key, attributeKey, err)
+			}
+
+			flag.Attributes[attributeKey] = attribute
+
+			if value != nil {
+				values[attributeKey] = value
+			}
+		}
+
+		doc.Flags[key] = flag
+		doc.Values[key] = values
+	}
+
+	return json.Marshal(doc)
+}
+
+func expandFeatureFlagAttribute(tfMap map[string]interface{}) (*featureFlagAttribute, interface{}, error) {
+	constraints := &featureFlagAttributeConstraints{
+		Pattern:  tfMap["pattern"].(string),
+		Required: tfMap["required"].(bool),
+		Type:     tfMap[names.AttrType].(string),
+	}
+
+	if v, ok := tfMap["enum"].([]interface{}); ok && len(v) > 0 {
+		constraints.Enum = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["maximum"].(string); ok && v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		constraints.Maximum = aws.Float64(f)
+	}
+
+	if v, ok := tfMap["minimum"].(string); ok && v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		constraints.Minimum = aws.Float64(f)
+	}
+
+	var value interface{}
+	scalar, values := tfMap[names.AttrValue].(string), flex.ExpandStringValueList(tfMap[names.AttrValues].([]interface{}))
+
+	switch constraints.Type {
+	case featureFlagAttributeTypeBoolean, featureFlagAttributeTypeNumber, featureFlagAttributeTypeString:
+		if len(values) > 0 {
+			return nil, nil, fmt.Errorf("values can only be set for %s and %s attributes", featureFlagAttributeTypeNumberArray, featureFlagAttributeTypeStringArray)
+		}
+
+		if scalar == "" {
+			break
+		}
+
+		switch constraints.Type {
+		case featureFlagAttributeTypeBoolean:
+			v, err := strconv.ParseBool(scalar)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			value = v
+		case featureFlagAttributeTypeNumber:
+			v, err := strconv.ParseFloat(scalar, 64)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			value = v
+		default:
+			value = scalar
+		}
+	case featureFlagAttributeTypeNumberArray, featureFlagAttributeTypeStringArray:
+		if scalar != "" {
+			return nil, nil, fmt.Errorf("value can only be set for %s, %s and %s attributes", featureFlagAttributeTypeBoolean, featureFlagAttributeTypeNumber, featureFlagAttributeTypeString)
+		}
+
+		if len(values) == 0 {
+			break
+		}
+
+		if constraints.Type == featureFlagAttributeTypeNumberArray {
+			numbers := make([]float64, 0, len(values))
+
+			for _, v := range values {
+				f, err := strconv.ParseFloat(v, 64)
+
+				if err != nil {
+					return nil, nil, err
+				}
+
+				numbers = append(numbers, f)
+			}
+
+			value = numbers
+		} else {
+			value = values
+		}
+	}
+
+	return &featureFlagAttribute{Constraints: constraints}, value, nil
+}
@@ -55,6 +55,39 @@ func TestAccAppConfigHostedConfigurationVersion_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_featureFlags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionConfig_featureFlags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrContentType, "application/json"),
+					resource.TestMatchResourceAttr(resourceName, names.AttrContent, regexache.MustCompile(`"flags":`)),
+					resource.TestMatchResourceAttr(resourceName, names.AttrContent, regexache.MustCompile(`"someOtherAttribute":123`)),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "feature_flags.0.flag.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"feature_flags"},
+			},
+		},
+	})
+}
+
 func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -172,3 +205,53 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_featureFlags(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  description              = %[1]q
+
+  feature_flags {
+    flag {
+      key                = "foo"
+      name               = "foo"
+      deprecation_status = "planned"
+      enabled            = true
+    }
+
+    flag {
+      key     = "bar"
+      name    = "bar"
+      enabled = true
+
+      attribute {
+        key      = "someAttribute"
+        type     = "string"
+        required = true
+        value    = "Hello World"
+      }
+
+      attribute {
+        key      = "someOtherAttribute"
+        type     = "number"
+        required = true
+        minimum  = "0"
+        maximum  = "1000"
+        value    = "123"
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -230,10 +230,10 @@ func (r *appAuthorizationResource) Create(ctx context.Context, request resource.
 
 	output, err := conn.CreateAppAuthorization(ctx, input)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AppFabric, create.ErrActionCreating, ResNameAppAuthorization, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -244,7 +244,7 @@ func (r *appAuthorizationResource) Create(ctx context.Context, request resource.
 
 	aAuth, err := waitAppAuthorizationCreated(ctx, conn, data.AppAuthorizationARN.ValueString(), data.AppBundleARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for App Fabric App Authorization (%s) to be created", data.AppAuthorizationARN.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for App Fabric App Authorization (%s) to be created", data.AppAuthorizationARN.ValueString()), err))
 
 		return
 	}
@@ -253,7 +253,7 @@ func (r *appAuthorizationResource) Create(ctx context.Context, request resource.
 	data.Persona = fwflex.StringValueToFramework(ctx, aAuth.Persona)
 	data.AuthUrl = fwflex.StringToFramework(ctx, aAuth.AuthUrl)
 	if err := data.parseAuthURL(); err != nil {
-		response.Diagnostics.AddError("parsing Auth URL", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing Auth URL", err))
 
 		return
 	}
@@ -273,7 +273,7 @@ func (r *appAuthorizationResource) Read(ctx context.Context, request resource.Re
 	conn := r.Meta().AppFabricClient(ctx)
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -288,7 +288,7 @@ func (r *appAuthorizationResource) Read(ctx context.Context, request resource.Re
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading App Fabric AppAuthorization ID  (%s)", data.AppAuthorizationARN.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading App Fabric AppAuthorization ID  (%s)", data.AppAuthorizationARN.ValueString()), err))
 
 		return
 	}
@@ -301,7 +301,7 @@ func (r *appAuthorizationResource) Read(ctx context.Context, request resource.Re
 	//Seting it because of the dynamic nature of Auth Url
 	data.AuthUrl = fwflex.StringToFramework(ctx, output.AuthUrl)
 	if err := data.parseAuthURL(); err != nil {
-		response.Diagnostics.AddError("parsing Auth URL", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing Auth URL", err))
 
 		return
 	}
@@ -370,7 +370,7 @@ func (r *appAuthorizationResource) Update(ctx context.Context, request resource.
 		new.Persona = fwflex.StringValueToFramework(ctx, appAuth.Persona)
 		new.AuthUrl = fwflex.StringToFramework(ctx, appAuth.AuthUrl)
 		if err := new.parseAuthURL(); err != nil {
-			response.Diagnostics.AddError("parsing Auth URL", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing Auth URL", err))
 
 			return
 		}
@@ -400,13 +400,13 @@ func (r *appAuthorizationResource) Delete(ctx context.Context, request resource.
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting App Fabric AppAuthorizations (%s)", data.AppAuthorizationARN.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting App Fabric AppAuthorizations (%s)", data.AppAuthorizationARN.ValueString()), err))
 
 		return
 	}
 
 	if _, err = waitAppAuthorizationDeleted(ctx, conn, data.AppAuthorizationARN.ValueString(), data.AppBundleARN.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for App Fabric AppAuthenticator (%s) delete", data.AppAuthorizationARN.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for App Fabric AppAuthenticator (%s) delete", data.AppAuthorizationARN.ValueString()), err))
 
 		return
 	}
@@ -81,7 +81,7 @@ func (r *appBundleResource) Create(ctx context.Context, request resource.CreateR
 	output, err := conn.CreateAppBundle(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating AppFabric App Bundle", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating AppFabric App Bundle", err))
 
 		return
 	}
@@ -100,7 +100,7 @@ func (r *appBundleResource) Read(ctx context.Context, request resource.ReadReque
 		return
 	}
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -116,7 +116,7 @@ func (r *appBundleResource) Read(ctx context.Context, request resource.ReadReque
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading AppFabric App Bundle (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading AppFabric App Bundle (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -148,7 +148,7 @@ func (r *appBundleResource) Delete(ctx context.Context, request resource.DeleteR
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting AppFabric App Bundle (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting AppFabric App Bundle (%s)", data.ID.ValueString()), err))
 
 		return
 	}
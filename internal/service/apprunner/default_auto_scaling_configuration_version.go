@@ -61,7 +61,7 @@ func (r *defaultAutoScalingConfigurationVersionResource) Create(ctx context.Cont
 	conn := r.Meta().AppRunnerClient(ctx)
 
 	if err := putDefaultAutoScalingConfiguration(ctx, conn, data.AutoScalingConfigurationARN.ValueString()); err != nil {
-		response.Diagnostics.AddError("creating App Runner Default AutoScaling Configuration Version", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating App Runner Default AutoScaling Configuration Version", err))
 
 		return
 	}
@@ -93,7 +93,7 @@ func (r *defaultAutoScalingConfigurationVersionResource) Read(ctx context.Contex
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading App Runner Default AutoScaling Configuration Version (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading App Runner Default AutoScaling Configuration Version (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -115,7 +115,7 @@ func (r *defaultAutoScalingConfigurationVersionResource) Update(ctx context.Cont
 	conn := r.Meta().AppRunnerClient(ctx)
 
 	if err := putDefaultAutoScalingConfiguration(ctx, conn, new.AutoScalingConfigurationARN.ValueString()); err != nil {
-		response.Diagnostics.AddError("updating App Runner Default AutoScaling Configuration Version", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("updating App Runner Default AutoScaling Configuration Version", err))
 
 		return
 	}
@@ -5,6 +5,7 @@ package apprunner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -95,7 +96,7 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	output, err := conn.StartDeployment(ctx, input)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("starting App Runner Deployment (%s)", serviceARN), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("starting App Runner Deployment (%s)", serviceARN), err))
 
 		return
 	}
@@ -110,7 +111,13 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	op, err := waitDeploymentSucceeded(ctx, conn, serviceARN, operationID, createTimeout)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Deployment (%s/%s)", serviceARN, operationID), err.Error())
+		// Persist the failed or rolled back operation so that its status is visible.
+		if op != nil {
+			data.Status = fwflex.StringValueToFramework(ctx, op.Status)
+			resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+		}
+
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for App Runner Deployment (%s/%s)", serviceARN, operationID), err))
 
 		return
 	}
@@ -141,7 +148,7 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("reading App Runner Deployment (%s/%s)", serviceARN, operationID), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading App Runner Deployment (%s/%s)", serviceARN, operationID), err))
 
 		return
 	}
@@ -217,7 +224,7 @@ func statusOperation(ctx context.Context, conn *apprunner.Client, serviceARN, op
 
 func waitDeploymentSucceeded(ctx context.Context, conn *apprunner.Client, serviceARN, operationID string, timeout time.Duration) (*awstypes.OperationSummary, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:        enum.Slice(awstypes.OperationStatusPending, awstypes.OperationStatusInProgress),
+		Pending:        enum.Slice(awstypes.OperationStatusPending, awstypes.OperationStatusInProgress, awstypes.OperationStatusRollbackInProgress),
 		Target:         enum.Slice(awstypes.OperationStatusSucceeded),
 		Refresh:        statusOperation(ctx, conn, serviceARN, operationID),
 		Timeout:        timeout,
@@ -228,6 +235,13 @@ func waitDeploymentSucceeded(ctx context.Context, conn *apprunner.Client, servic
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.OperationSummary); ok {
+		switch output.Status {
+		case awstypes.OperationStatusRollbackSucceeded:
+			tfresource.SetLastError(err, errors.New("deployment failed and the service was rolled back to its previous configuration"))
+		case awstypes.OperationStatusRollbackFailed:
+			tfresource.SetLastError(err, errors.New("deployment failed and the service could not be rolled back"))
+		}
+
 		return output, err
 	}
 
@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	aasawstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -182,3 +184,27 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.Client, flee
 
 	return nil
 }
+
+// FindScalingPolicyByTwoPartKey Retrieve an Application Auto Scaling policy attached to an appstream fleet
+func FindScalingPolicyByTwoPartKey(ctx context.Context, conn *applicationautoscaling.Client, fleetName, name string) (*aasawstypes.ScalingPolicy, error) {
+	input := &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       []string{name},
+		ResourceId:        aws.String(scalingPolicyResourceID(fleetName)),
+		ScalableDimension: aasawstypes.ScalableDimensionAppstreamFleetDesiredCapacity,
+		ServiceNamespace:  aasawstypes.ServiceNamespaceAppstream,
+	}
+
+	output, err := conn.DescribeScalingPolicies(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output.ScalingPolicies, func(v aasawstypes.ScalingPolicy) bool {
+		return aws.ToString(v.PolicyName) == name
+	}))
+}
@@ -5,6 +5,8 @@ package appstream
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -55,7 +57,8 @@ func ResourceFleet() *schema.Resource {
 			"compute_capacity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"available": {
@@ -167,6 +170,11 @@ func ResourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_concurrent_sessions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"max_sessions_per_instance": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -182,6 +190,31 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"platform": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PlatformType](),
+			},
+			"session_script_s3_location": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrS3Bucket: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"s3_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
 			"stream_view": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -225,10 +258,13 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
 	input := &appstream.CreateFleetInput{
-		Name:            aws.String(d.Get(names.AttrName).(string)),
-		InstanceType:    aws.String(d.Get(names.AttrInstanceType).(string)),
-		ComputeCapacity: expandComputeCapacity(d.Get("compute_capacity").([]interface{})),
-		Tags:            getTagsIn(ctx),
+		Name:         aws.String(d.Get(names.AttrName).(string)),
+		InstanceType: aws.String(d.Get(names.AttrInstanceType).(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("compute_capacity"); ok {
+		input.ComputeCapacity = expandComputeCapacity(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -271,6 +307,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("max_sessions_per_instance"); ok {
 		input.MaxSessionsPerInstance = aws.Int32(int32(v.(int)))
 	}
@@ -279,6 +319,14 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.MaxUserDurationInSeconds = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = awstypes.PlatformType(v.(string))
+	}
+
+	if v, ok := d.GetOk("session_script_s3_location"); ok {
+		input.SessionScriptS3Location = expandS3Location(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("stream_view"); ok {
 		input.StreamView = awstypes.StreamView(v.(string))
 	}
@@ -388,9 +436,20 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_name", fleet.ImageName)
 	d.Set("image_arn", fleet.ImageArn)
 	d.Set(names.AttrInstanceType, fleet.InstanceType)
+	d.Set("max_concurrent_sessions", fleet.MaxConcurrentSessions)
 	d.Set("max_sessions_per_instance", fleet.MaxSessionsPerInstance)
 	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
 	d.Set(names.AttrName, fleet.Name)
+	d.Set("platform", fleet.Platform)
+
+	if fleet.SessionScriptS3Location != nil {
+		if err = d.Set("session_script_s3_location", []interface{}{flattenS3Location(fleet.SessionScriptS3Location)}); err != nil {
+			return create.AppendDiagSettingError(diags, names.AppStream, "Fleet", d.Id(), "session_script_s3_location", err)
+		}
+	} else {
+		d.Set("session_script_s3_location", nil)
+	}
+
 	d.Set(names.AttrState, fleet.State)
 	d.Set("stream_view", fleet.StreamView)
 
@@ -414,7 +473,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	shouldStop := false
 
-	if d.HasChanges(names.AttrDescription, "domain_join_info", "enable_default_internet_access", names.AttrIAMRoleARN, names.AttrInstanceType, "max_user_duration_in_seconds", "stream_view", names.AttrVPCConfig) {
+	if d.HasChanges(names.AttrDescription, "domain_join_info", "enable_default_internet_access", names.AttrIAMRoleARN, names.AttrInstanceType, "max_user_duration_in_seconds", "platform", "stream_view", names.AttrVPCConfig) {
 		shouldStop = true
 	}
 
@@ -479,6 +538,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceType = aws.String(d.Get(names.AttrInstanceType).(string))
 	}
 
+	if d.HasChange("max_concurrent_sessions") {
+		input.MaxConcurrentSessions = aws.Int32(int32(d.Get("max_concurrent_sessions").(int)))
+	}
+
 	if d.HasChange("max_sessions_per_instance") {
 		input.MaxSessionsPerInstance = aws.Int32(int32(d.Get("max_sessions_per_instance").(int)))
 	}
@@ -487,6 +550,18 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.MaxUserDurationInSeconds = aws.Int32(int32(d.Get("max_user_duration_in_seconds").(int)))
 	}
 
+	if d.HasChange("platform") {
+		input.Platform = awstypes.PlatformType(d.Get("platform").(string))
+	}
+
+	if d.HasChange("session_script_s3_location") {
+		if v := d.Get("session_script_s3_location").([]interface{}); len(v) > 0 {
+			input.SessionScriptS3Location = expandS3Location(v)
+		} else {
+			input.AttributesToDelete = append(input.AttributesToDelete, awstypes.FleetAttributeSessionScriptS3Location)
+		}
+	}
+
 	if d.HasChange(names.AttrVPCConfig) {
 		input.VpcConfig = expandVPCConfig(d.Get(names.AttrVPCConfig).([]interface{}))
 	}
@@ -553,6 +628,18 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceFleetCustDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Elastic fleets are sized by max_concurrent_sessions, all others by compute_capacity.
+	if fleetType := awstypes.FleetType(diff.Get("fleet_type").(string)); fleetType == awstypes.FleetTypeElastic {
+		if v, ok := diff.GetOk("max_concurrent_sessions"); !ok || v.(int) == 0 {
+			return fmt.Errorf(`"max_concurrent_sessions" is required when "fleet_type" is %q`, fleetType)
+		}
+		if v := diff.GetRawConfig().GetAttr("compute_capacity"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf(`"compute_capacity" cannot be set when "fleet_type" is %q`, fleetType)
+		}
+	} else if v := diff.GetRawConfig().GetAttr("compute_capacity"); v.IsNull() || (v.IsKnown() && v.LengthInt() == 0) {
+		return errors.New(`"compute_capacity" is required unless "fleet_type" is "ELASTIC"`)
+	}
+
 	if diff.HasChange("domain_join_info") {
 		o, n := diff.GetChange("domain_join_info")
 
@@ -671,6 +758,35 @@ func flattenDomainInfo(apiObject *awstypes.DomainJoinInfo) map[string]interface{
 	return tfMap
 }
 
+func expandS3Location(tfList []interface{}) *awstypes.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.S3Location{
+		S3Bucket: aws.String(tfMap[names.AttrS3Bucket].(string)),
+	}
+
+	if v, ok := tfMap["s3_key"].(string); ok && v != "" {
+		apiObject.S3Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Location(apiObject *awstypes.S3Location) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		names.AttrS3Bucket: aws.ToString(apiObject.S3Bucket),
+		"s3_key":           aws.ToString(apiObject.S3Key),
+	}
+}
+
 func expandVPCConfig(tfList []interface{}) *awstypes.VpcConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -358,6 +358,60 @@ func TestAccAppStreamFleet_multiSession(t *testing.T) {
 	})
 }
 
+func TestAccAppStreamFleet_elastic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleetOutput awstypes.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceType := "stream.standard.small"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_elastic(rName, instanceType, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", string(awstypes.FleetTypeElastic)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, instanceType),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "platform", string(awstypes.PlatformTypeWindowsServer2019)),
+					resource.TestCheckResourceAttr(resourceName, "session_script_s3_location.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_elasticSessionScript(rName, instanceType, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "session_script_s3_location.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "session_script_s3_location.0.s3_bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "session_script_s3_location.0.s3_key", "aws_s3_object.test", names.AttrKey),
+				),
+			},
+			{
+				Config: testAccFleetConfig_elastic(rName, instanceType, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "session_script_s3_location.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFleetExists(ctx context.Context, resourceName string, appStreamFleet *awstypes.Fleet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -607,3 +661,78 @@ resource "aws_appstream_fleet" "test" {
 }
 `, name, instanceType, desiredSessions, maxSessionsPerInstance))
 }
+
+func testAccFleetConfig_elasticBase(name string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, name))
+}
+
+func testAccFleetConfig_elastic(name, instanceType string, maxConcurrentSessions int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_elasticBase(name), fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                         = %[1]q
+  fleet_type                   = "ELASTIC"
+  instance_type                = %[2]q
+  max_concurrent_sessions      = %[3]d
+  max_user_duration_in_seconds = 600
+  platform                     = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, name, instanceType, maxConcurrentSessions))
+}
+
+func testAccFleetConfig_elasticSessionScript(name, instanceType string, maxConcurrentSessions int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_elasticBase(name), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "session-scripts.zip"
+  content = "placeholder"
+}
+
+resource "aws_appstream_fleet" "test" {
+  name                         = %[1]q
+  fleet_type                   = "ELASTIC"
+  instance_type                = %[2]q
+  max_concurrent_sessions      = %[3]d
+  max_user_duration_in_seconds = 600
+  platform                     = "WINDOWS_SERVER_2019"
+
+  session_script_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.test.key
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, name, instanceType, maxConcurrentSessions))
+}
@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_scaling_policy", name="Scaling Policy")
+func ResourceScalingPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScalingPolicyPut,
+		ReadWithoutTimeout:   resourceScalingPolicyRead,
+		UpdateWithoutTimeout: resourceScalingPolicyPut,
+		DeleteWithoutTimeout: resourceScalingPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceScalingPolicyCustDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alarm_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(awstypes.PolicyTypeTargetTrackingScaling),
+				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+			},
+			"step_scaling_policy_configuration": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"step_scaling_policy_configuration", "target_tracking_scaling_policy_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adjustment_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AdjustmentType](),
+						},
+						"cooldown": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"metric_aggregation_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.MetricAggregationType](),
+						},
+						"min_adjustment_magnitude": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"step_adjustment": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_interval_lower_bound": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidTypeStringNullableFloat,
+									},
+									"metric_interval_upper_bound": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidTypeStringNullableFloat,
+									},
+									"scaling_adjustment": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"target_tracking_scaling_policy_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_scale_in": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"scale_in_cooldown": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"scale_out_cooldown": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						// Target value for the AppStreamAverageCapacityUtilization metric, as a percentage.
+						"target_value": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(1, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScalingPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	fleetName, name := d.Get("fleet_name").(string), d.Get(names.AttrName).(string)
+	input := &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(name),
+		PolicyType:        awstypes.PolicyType(d.Get("policy_type").(string)),
+		ResourceId:        aws.String(scalingPolicyResourceID(fleetName)),
+		ScalableDimension: awstypes.ScalableDimensionAppstreamFleetDesiredCapacity,
+		ServiceNamespace:  awstypes.ServiceNamespaceAppstream,
+	}
+
+	if v, ok := d.GetOk("step_scaling_policy_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StepScalingPolicyConfiguration = expandScalingPolicyStepScalingPolicyConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_tracking_scaling_policy_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetTrackingScalingPolicyConfiguration = expandScalingPolicyTargetTrackingScalingPolicyConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.FailedResourceAccessException](ctx, fleetOperationTimeout, func() (interface{}, error) {
+		return conn.PutScalingPolicy(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting AppStream Scaling Policy (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(EncodeScalingPolicyID(fleetName, name))
+	}
+
+	return append(diags, resourceScalingPolicyRead(ctx, d, meta)...)
+}
+
+func resourceScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	fleetName, name, err := DecodeScalingPolicyID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decoding AppStream Scaling Policy ID (%s): %s", d.Id(), err)
+	}
+
+	policy, err := FindScalingPolicyByTwoPartKey(ctx, conn, fleetName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Scaling Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Scaling Policy (%s): %s", d.Id(), err)
+	}
+
+	alarmARNs := make([]string, 0, len(policy.Alarms))
+	for _, v := range policy.Alarms {
+		alarmARNs = append(alarmARNs, aws.ToString(v.AlarmARN))
+	}
+	d.Set("alarm_arns", alarmARNs)
+	d.Set(names.AttrARN, policy.PolicyARN)
+	d.Set("fleet_name", fleetName)
+	d.Set(names.AttrName, policy.PolicyName)
+	d.Set("policy_type", policy.PolicyType)
+
+	if policy.StepScalingPolicyConfiguration != nil {
+		if err := d.Set("step_scaling_policy_configuration", []interface{}{flattenScalingPolicyStepScalingPolicyConfiguration(policy.StepScalingPolicyConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting step_scaling_policy_configuration: %s", err)
+		}
+	} else {
+		d.Set("step_scaling_policy_configuration", nil)
+	}
+
+	if policy.TargetTrackingScalingPolicyConfiguration != nil {
+		if err := d.Set("target_tracking_scaling_policy_configuration", []interface{}{flattenScalingPolicyTargetTrackingScalingPolicyConfiguration(policy.TargetTrackingScalingPolicyConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting target_tracking_scaling_policy_configuration: %s", err)
+		}
+	} else {
+		d.Set("target_tracking_scaling_policy_configuration", nil)
+	}
+
+	return diags
+}
+
+func resourceScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	fleetName, name, err := DecodeScalingPolicyID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decoding AppStream Scaling Policy ID (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Scaling Policy: (%s)", d.Id())
+	_, err = tfresource.RetryWhenIsA[*awstypes.FailedResourceAccessException](ctx, fleetOperationTimeout, func() (interface{}, error) {
+		return conn.DeleteScalingPolicy(ctx, &applicationautoscaling.DeleteScalingPolicyInput{
+			PolicyName:        aws.String(name),
+			ResourceId:        aws.String(scalingPolicyResourceID(fleetName)),
+			ScalableDimension: awstypes.ScalableDimensionAppstreamFleetDesiredCapacity,
+			ServiceNamespace:  awstypes.ServiceNamespaceAppstream,
+		})
+	})
+
+	if errs.IsA[*awstypes.ObjectNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream Scaling Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceScalingPolicyCustDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	switch policyType := awstypes.PolicyType(diff.Get("policy_type").(string)); policyType {
+	case awstypes.PolicyTypeStepScaling:
+		if v := diff.Get("target_tracking_scaling_policy_configuration").([]interface{}); len(v) > 0 {
+			return fmt.Errorf(`"target_tracking_scaling_policy_configuration" cannot be set when "policy_type" is %q`, policyType)
+		}
+	case awstypes.PolicyTypeTargetTrackingScaling:
+		if v := diff.Get("step_scaling_policy_configuration").([]interface{}); len(v) > 0 {
+			return fmt.Errorf(`"step_scaling_policy_configuration" cannot be set when "policy_type" is %q`, policyType)
+		}
+	default:
+		return fmt.Errorf(`"policy_type" %q is not supported for AppStream fleets`, policyType)
+	}
+
+	return nil
+}
+
+func scalingPolicyResourceID(fleetName string) string {
+	return "fleet/" + fleetName
+}
+
+func EncodeScalingPolicyID(fleetName, name string) string {
+	return fmt.Sprintf("%s/%s", fleetName, name)
+}
+
+func DecodeScalingPolicyID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format FleetName/PolicyName, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
+
+func expandScalingPolicyStepScalingPolicyConfiguration(tfMap map[string]interface{}) *awstypes.StepScalingPolicyConfiguration {
+	apiObject := &awstypes.StepScalingPolicyConfiguration{
+		AdjustmentType: awstypes.AdjustmentType(tfMap["adjustment_type"].(string)),
+	}
+
+	if v, ok := tfMap["cooldown"].(int); ok && v != 0 {
+		apiObject.Cooldown = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["metric_aggregation_type"].(string); ok && v != "" {
+		apiObject.MetricAggregationType = awstypes.MetricAggregationType(v)
+	}
+
+	if v, ok := tfMap["min_adjustment_magnitude"].(int); ok && v != 0 {
+		apiObject.MinAdjustmentMagnitude = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["step_adjustment"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			stepAdjustment := awstypes.StepAdjustment{
+				ScalingAdjustment: aws.Int32(int32(tfMap["scaling_adjustment"].(int))),
+			}
+
+			if v, ok := tfMap["metric_interval_lower_bound"].(string); ok && v != "" {
+				if v, err := strconv.ParseFloat(v, 64); err == nil {
+					stepAdjustment.MetricIntervalLowerBound = aws.Float64(v)
+				}
+			}
+
+			if v, ok := tfMap["metric_interval_upper_bound"].(string); ok && v != "" {
+				if v, err := strconv.ParseFloat(v, 64); err == nil {
+					stepAdjustment.MetricIntervalUpperBound = aws.Float64(v)
+				}
+			}
+
+			apiObject.StepAdjustments = append(apiObject.StepAdjustments, stepAdjustment)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenScalingPolicyStepScalingPolicyConfiguration(apiObject *awstypes.StepScalingPolicyConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"adjustment_type":          apiObject.AdjustmentType,
+		"cooldown":                 aws.ToInt32(apiObject.Cooldown),
+		"metric_aggregation_type":  apiObject.MetricAggregationType,
+		"min_adjustment_magnitude": aws.ToInt32(apiObject.MinAdjustmentMagnitude),
+	}
+
+	stepAdjustments := make([]interface{}, 0, len(apiObject.StepAdjustments))
+	for _, v := range apiObject.StepAdjustments {
+		stepAdjustment := map[string]interface{}{
+			"scaling_adjustment": aws.ToInt32(v.ScalingAdjustment),
+		}
+
+		if v := v.MetricIntervalLowerBound; v != nil {
+			stepAdjustment["metric_interval_lower_bound"] = strconv.FormatFloat(aws.ToFloat64(v), 'f', -1, 64)
+		}
+
+		if v := v.MetricIntervalUpperBound; v != nil {
+			stepAdjustment["metric_interval_upper_bound"] = strconv.FormatFloat(aws.ToFloat64(v), 'f', -1, 64)
+		}
+
+		stepAdjustments = append(stepAdjustments, stepAdjustment)
+	}
+	tfMap["step_adjustment"] = stepAdjustments
+
+	return tfMap
+}
+
+func expandScalingPolicyTargetTrackingScalingPolicyConfiguration(tfMap map[string]interface{}) *awstypes.TargetTrackingScalingPolicyConfiguration {
+	apiObject := &awstypes.TargetTrackingScalingPolicyConfiguration{
+		PredefinedMetricSpecification: &awstypes.PredefinedMetricSpecification{
+			PredefinedMetricType: awstypes.MetricTypeAppStreamAverageCapacityUtilization,
+		},
+		TargetValue: aws.Float64(tfMap["target_value"].(float64)),
+	}
+
+	if v, ok := tfMap["disable_scale_in"].(bool); ok {
+		apiObject.DisableScaleIn = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok && v != 0 {
+		apiObject.ScaleInCooldown = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok && v != 0 {
+		apiObject.ScaleOutCooldown = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenScalingPolicyTargetTrackingScalingPolicyConfiguration(apiObject *awstypes.TargetTrackingScalingPolicyConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"disable_scale_in":   aws.ToBool(apiObject.DisableScaleIn),
+		"scale_in_cooldown":  aws.ToInt32(apiObject.ScaleInCooldown),
+		"scale_out_cooldown": aws.ToInt32(apiObject.ScaleOutCooldown),
+		"target_value":       aws.ToFloat64(apiObject.TargetValue),
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppStreamScalingPolicy_targetTracking(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.ScalingPolicy
+	resourceName := "aws_appstream_scaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScalingPolicyDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalingPolicyConfig_targetTracking(rName, 75),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", "aws_appstream_fleet.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", string(awstypes.PolicyTypeTargetTrackingScaling)),
+					resource.TestCheckResourceAttr(resourceName, "step_scaling_policy_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.target_value", "75"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScalingPolicyConfig_targetTracking(rName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.target_value", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamScalingPolicy_stepScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.ScalingPolicy
+	resourceName := "aws_appstream_scaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScalingPolicyDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalingPolicyConfig_stepScaling(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", string(awstypes.PolicyTypeStepScaling)),
+					resource.TestCheckResourceAttr(resourceName, "step_scaling_policy_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "step_scaling_policy_configuration.0.adjustment_type", string(awstypes.AdjustmentTypeChangeInCapacity)),
+					resource.TestCheckResourceAttr(resourceName, "step_scaling_policy_configuration.0.cooldown", "120"),
+					resource.TestCheckResourceAttr(resourceName, "step_scaling_policy_configuration.0.step_adjustment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamScalingPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.ScalingPolicy
+	resourceName := "aws_appstream_scaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScalingPolicyDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalingPolicyConfig_targetTracking(rName, 75),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceScalingPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalingPolicyExists(ctx context.Context, n string, v *awstypes.ScalingPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		fleetName, name, err := tfappstream.DecodeScalingPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+		output, err := tfappstream.FindScalingPolicyByTwoPartKey(ctx, conn, fleetName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckScalingPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_scaling_policy" {
+				continue
+			}
+
+			fleetName, name, err := tfappstream.DecodeScalingPolicyID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfappstream.FindScalingPolicyByTwoPartKey(ctx, conn, fleetName, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream Scaling Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccScalingPolicyConfig_base(name string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_basic(name, "stream.standard.small"), `
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 2
+  min_capacity       = 1
+  resource_id        = "fleet/${aws_appstream_fleet.test.name}"
+  scalable_dimension = "appstream:fleet:DesiredCapacity"
+  service_namespace  = "appstream"
+}
+`)
+}
+
+func testAccScalingPolicyConfig_targetTracking(name string, targetValue int) string {
+	return acctest.ConfigCompose(testAccScalingPolicyConfig_base(name), fmt.Sprintf(`
+resource "aws_appstream_scaling_policy" "test" {
+  fleet_name = aws_appstream_fleet.test.name
+  name       = %[1]q
+
+  target_tracking_scaling_policy_configuration {
+    target_value       = %[2]d
+    scale_in_cooldown  = 300
+    scale_out_cooldown = 60
+  }
+
+  depends_on = [aws_appautoscaling_target.test]
+}
+`, name, targetValue))
+}
+
+func testAccScalingPolicyConfig_stepScaling(name string) string {
+	return acctest.ConfigCompose(testAccScalingPolicyConfig_base(name), fmt.Sprintf(`
+resource "aws_appstream_scaling_policy" "test" {
+  fleet_name  = aws_appstream_fleet.test.name
+  name        = %[1]q
+  policy_type = "StepScaling"
+
+  step_scaling_policy_configuration {
+    adjustment_type         = "ChangeInCapacity"
+    cooldown                = 120
+    metric_aggregation_type = "Average"
+
+    step_adjustment {
+      metric_interval_lower_bound = "0"
+      scaling_adjustment          = 1
+    }
+  }
+
+  depends_on = [aws_appautoscaling_target.test]
+}
+`, name))
+}
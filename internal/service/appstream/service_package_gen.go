@@ -53,6 +53,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceScalingPolicy,
+			TypeName: "aws_appstream_scaling_policy",
+			Name:     "Scaling Policy",
+		},
 		{
 			Factory:  ResourceStack,
 			TypeName: "aws_appstream_stack",
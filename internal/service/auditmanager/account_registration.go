@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -76,10 +77,10 @@ func (r *resourceAccountRegistration) Create(ctx context.Context, req resource.C
 	}
 	out, err := conn.RegisterAccount(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAccountRegistration, id, nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -103,10 +104,10 @@ func (r *resourceAccountRegistration) Read(ctx context.Context, req resource.Rea
 	// account status.
 	out, err := conn.GetAccountStatus(ctx, &auditmanager.GetAccountStatusInput{})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameAccountRegistration, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out.Status == awstypes.AccountStatusInactive {
@@ -139,10 +140,10 @@ func (r *resourceAccountRegistration) Update(ctx context.Context, req resource.U
 		}
 		out, err := conn.RegisterAccount(ctx, &in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.AuditManager, create.ErrActionUpdating, ResNameAccountRegistration, state.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -170,10 +171,10 @@ func (r *resourceAccountRegistration) Delete(ctx context.Context, req resource.D
 	if state.DeregisterOnDestroy.ValueBool() {
 		_, err := conn.DeregisterAccount(ctx, &auditmanager.DeregisterAccountInput{})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAccountRegistration, state.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 		}
 	}
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -219,10 +220,10 @@ func (r *resourceAssessment) Create(ctx context.Context, req resource.CreateRequ
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessment, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.Assessment == nil {
@@ -257,10 +258,10 @@ func (r *resourceAssessment) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameAssessment, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -319,10 +320,10 @@ func (r *resourceAssessment) Update(ctx context.Context, req resource.UpdateRequ
 
 		out, err := conn.UpdateAssessment(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.AuditManager, create.ErrActionUpdating, ResNameAssessment, plan.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil || out.Assessment == nil {
@@ -358,10 +359,10 @@ func (r *resourceAssessment) Delete(ctx context.Context, req resource.DeleteRequ
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAssessment, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
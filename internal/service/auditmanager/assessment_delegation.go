@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -143,10 +144,10 @@ func (r *resourceAssessmentDelegation) Create(ctx context.Context, req resource.
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, plan.RoleARN.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || len(out.Delegations) == 0 {
@@ -165,10 +166,10 @@ func (r *resourceAssessmentDelegation) Create(ctx context.Context, req resource.
 	// object, and therefore is not included as one of the matching parameters.
 	delegation, err := getMatchingDelegation(out.Delegations, plan.RoleARN.ValueString(), plan.ControlSetID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, plan.RoleARN.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -199,10 +200,10 @@ func (r *resourceAssessmentDelegation) Read(ctx context.Context, req resource.Re
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameAssessmentDelegation, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -232,10 +233,10 @@ func (r *resourceAssessmentDelegation) Delete(ctx context.Context, req resource.
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource
+func newDataSourceAssessmentEvidenceFolders(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceAssessmentEvidenceFolders{}, nil
+}
+
+type dataSourceAssessmentEvidenceFolders struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceAssessmentEvidenceFolders) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_auditmanager_assessment_evidence_folders"
+}
+
+func (d *dataSourceAssessmentEvidenceFolders) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assessment_id": schema.StringAttribute{
+				Required: true,
+			},
+			"control_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("control_set_id")),
+				},
+			},
+			"control_set_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("control_id")),
+				},
+			},
+			"evidence_folders": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assessmentEvidenceFolderData](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"assessment_id":                     types.StringType,
+						"assessment_report_selection_count": types.Int64Type,
+						"author":                            types.StringType,
+						"control_id":                        types.StringType,
+						"control_name":                      types.StringType,
+						"control_set_id":                    types.StringType,
+						"data_source":                       types.StringType,
+						"date":                              timetypes.RFC3339Type{},
+						"evidence_aws_service_source_count": types.Int64Type,
+						"evidence_by_type_compliance_check_count":        types.Int64Type,
+						"evidence_by_type_compliance_check_issues_count": types.Int64Type,
+						"evidence_by_type_configuration_data_count":      types.Int64Type,
+						"evidence_by_type_manual_count":                  types.Int64Type,
+						"evidence_by_type_user_activity_count":           types.Int64Type,
+						"evidence_resources_included_count":              types.Int64Type,
+						names.AttrID:                                     types.StringType,
+						names.AttrName:                                   types.StringType,
+						"total_evidence":                                 types.Int64Type,
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceAssessmentEvidenceFolders) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().AuditManagerClient(ctx)
+
+	var data dataSourceAssessmentEvidenceFoldersData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assessmentID := data.AssessmentID.ValueString()
+
+	var out []awstypes.AssessmentEvidenceFolder
+	var err error
+	if data.ControlID.IsNull() {
+		out, err = findEvidenceFoldersByAssessment(ctx, conn, assessmentID)
+	} else {
+		out, err = findEvidenceFoldersByAssessmentControl(ctx, conn, assessmentID, data.ControlSetID.ValueString(), data.ControlID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading evidence folders", err))
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data.EvidenceFolders)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(assessmentID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findEvidenceFoldersByAssessment(ctx context.Context, conn *auditmanager.Client, assessmentID string) ([]awstypes.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentInput{
+		AssessmentId: aws.String(assessmentID),
+	}
+
+	var out []awstypes.AssessmentEvidenceFolder
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.EvidenceFolders...)
+	}
+
+	return out, nil
+}
+
+func findEvidenceFoldersByAssessmentControl(ctx context.Context, conn *auditmanager.Client, assessmentID, controlSetID, controlID string) ([]awstypes.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentControlInput{
+		AssessmentId: aws.String(assessmentID),
+		ControlId:    aws.String(controlID),
+		ControlSetId: aws.String(controlSetID),
+	}
+
+	var out []awstypes.AssessmentEvidenceFolder
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentControlPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.EvidenceFolders...)
+	}
+
+	return out, nil
+}
+
+type dataSourceAssessmentEvidenceFoldersData struct {
+	AssessmentID    types.String                                                  `tfsdk:"assessment_id"`
+	ControlID       types.String                                                  `tfsdk:"control_id"`
+	ControlSetID    types.String                                                  `tfsdk:"control_set_id"`
+	EvidenceFolders fwtypes.ListNestedObjectValueOf[assessmentEvidenceFolderData] `tfsdk:"evidence_folders"`
+	ID              types.String                                                  `tfsdk:"id"`
+}
+
+type assessmentEvidenceFolderData struct {
+	AssessmentID                             types.String      `tfsdk:"assessment_id"`
+	AssessmentReportSelectionCount           types.Int64       `tfsdk:"assessment_report_selection_count"`
+	Author                                   types.String      `tfsdk:"author"`
+	ControlID                                types.String      `tfsdk:"control_id"`
+	ControlName                              types.String      `tfsdk:"control_name"`
+	ControlSetID                             types.String      `tfsdk:"control_set_id"`
+	DataSource                               types.String      `tfsdk:"data_source"`
+	Date                                     timetypes.RFC3339 `tfsdk:"date"`
+	EvidenceAwsServiceSourceCount            types.Int64       `tfsdk:"evidence_aws_service_source_count"`
+	EvidenceByTypeComplianceCheckCount       types.Int64       `tfsdk:"evidence_by_type_compliance_check_count"`
+	EvidenceByTypeComplianceCheckIssuesCount types.Int64       `tfsdk:"evidence_by_type_compliance_check_issues_count"`
+	EvidenceByTypeConfigurationDataCount     types.Int64       `tfsdk:"evidence_by_type_configuration_data_count"`
+	EvidenceByTypeManualCount                types.Int64       `tfsdk:"evidence_by_type_manual_count"`
+	EvidenceByTypeUserActivityCount          types.Int64       `tfsdk:"evidence_by_type_user_activity_count"`
+	EvidenceResourcesIncludedCount           types.Int64       `tfsdk:"evidence_resources_included_count"`
+	ID                                       types.String      `tfsdk:"id"`
+	Name                                     types.String      `tfsdk:"name"`
+	TotalEvidence                            types.Int64       `tfsdk:"total_evidence"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerAssessmentEvidenceFoldersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_auditmanager_assessment_evidence_folders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentEvidenceFoldersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "assessment_id", "aws_auditmanager_assessment.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evidence_folders.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessmentEvidenceFoldersDataSource_control(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_auditmanager_assessment_evidence_folders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentEvidenceFoldersDataSourceConfig_control(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "assessment_id", "aws_auditmanager_assessment.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_id", "aws_auditmanager_control.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evidence_folders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssessmentEvidenceFoldersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssessmentConfig_basic(rName),
+		`
+data "aws_auditmanager_assessment_evidence_folders" "test" {
+  assessment_id = aws_auditmanager_assessment.test.id
+}
+`)
+}
+
+func testAccAssessmentEvidenceFoldersDataSourceConfig_control(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssessmentConfig_basic(rName),
+		fmt.Sprintf(`
+# Assessment control sets are identified by the framework control set name.
+data "aws_auditmanager_assessment_evidence_folders" "test" {
+  assessment_id  = aws_auditmanager_assessment.test.id
+  control_set_id = %[1]q
+  control_id     = aws_auditmanager_control.test.id
+}
+`, rName))
+}
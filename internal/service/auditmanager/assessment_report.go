@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -96,10 +97,10 @@ func (r *resourceAssessmentReport) Create(ctx context.Context, req resource.Crea
 
 	out, err := conn.CreateAssessmentReport(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentReport, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.AssessmentReport == nil {
@@ -134,10 +135,10 @@ func (r *resourceAssessmentReport) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameAssessmentReport, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -182,10 +183,10 @@ func (r *resourceAssessmentReport) Delete(ctx context.Context, req resource.Dele
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentReport, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
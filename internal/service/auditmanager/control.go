@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -169,10 +170,10 @@ func (r *resourceControl) Create(ctx context.Context, req resource.CreateRequest
 
 	out, err := conn.CreateControl(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameControl, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.Control == nil {
@@ -207,10 +208,10 @@ func (r *resourceControl) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameControl, state.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -266,10 +267,10 @@ func (r *resourceControl) Update(ctx context.Context, req resource.UpdateRequest
 
 		out, err := conn.UpdateControl(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.AuditManager, create.ErrActionUpdating, ResNameControl, plan.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil || out.Control == nil {
@@ -302,10 +303,10 @@ func (r *resourceControl) Delete(ctx context.Context, req resource.DeleteRequest
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameControl, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -127,7 +128,7 @@ func (d *dataSourceControl) Read(ctx context.Context, req datasource.ReadRequest
 
 	controlMetadata, err := FindControlByName(ctx, conn, data.Name.ValueString(), data.Type.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("finding control by name", err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding control by name", err))
 		return
 	}
 
@@ -135,7 +136,7 @@ func (d *dataSourceControl) Read(ctx context.Context, req datasource.ReadRequest
 	// about a control. Use control ID to get complete information.
 	control, err := FindControlByID(ctx, conn, aws.ToString(controlMetadata.Id))
 	if err != nil {
-		resp.Diagnostics.AddError("finding control by ID", err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding control by ID", err))
 		return
 	}
 
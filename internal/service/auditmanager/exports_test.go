@@ -13,4 +13,7 @@ var (
 	ResourceControl                              = newResourceControl
 	ResourceFramework                            = newResourceFramework
 	ResourceFrameworkShare                       = newResourceFrameworkShare
+	ResourceFrameworkShareAccepter               = newResourceFrameworkShareAccepter
+
+	FindFrameworkShareByIDAndType = findFrameworkShareByIDAndType
 )
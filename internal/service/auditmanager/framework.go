@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -139,10 +140,10 @@ func (r *resourceFramework) Create(ctx context.Context, req resource.CreateReque
 
 	out, err := conn.CreateAssessmentFramework(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameFramework, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.Framework == nil {
@@ -177,10 +178,10 @@ func (r *resourceFramework) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameFramework, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -229,10 +230,10 @@ func (r *resourceFramework) Update(ctx context.Context, req resource.UpdateReque
 
 		out, err := conn.UpdateAssessmentFramework(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.AuditManager, create.ErrActionUpdating, ResNameFramework, plan.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil || out.Framework == nil {
@@ -265,10 +266,10 @@ func (r *resourceFramework) Delete(ctx context.Context, req resource.DeleteReque
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameFramework, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
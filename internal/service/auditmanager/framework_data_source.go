@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -95,7 +96,7 @@ func (d *dataSourceFramework) Read(ctx context.Context, req datasource.ReadReque
 
 	frameworkMetadata, err := FindFrameworkByName(ctx, conn, data.Name.ValueString(), data.FrameworkType.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("finding framework by name", err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding framework by name", err))
 		return
 	}
 
@@ -103,7 +104,7 @@ func (d *dataSourceFramework) Read(ctx context.Context, req datasource.ReadReque
 	// about a framework. Use framework ID to get complete information.
 	framework, err := FindFrameworkByID(ctx, conn, aws.ToString(frameworkMetadata.Id))
 	if err != nil {
-		resp.Diagnostics.AddError("finding framework by ID", err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding framework by ID", err))
 		return
 	}
 
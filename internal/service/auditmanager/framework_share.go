@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -99,10 +100,10 @@ func (r *resourceFrameworkShare) Create(ctx context.Context, req resource.Create
 	}
 	out, err := conn.StartAssessmentFrameworkShare(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameFrameworkShare, plan.FrameworkID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.AssessmentFrameworkShareRequest == nil {
@@ -133,10 +134,10 @@ func (r *resourceFrameworkShare) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameFrameworkShare, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -167,10 +168,10 @@ func (r *resourceFrameworkShare) Delete(ctx context.Context, req resource.Delete
 		}
 		_, err := conn.UpdateAssessmentFrameworkShare(ctx, &in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameFrameworkShare, state.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 		}
 	}
 
@@ -180,10 +181,10 @@ func (r *resourceFrameworkShare) Delete(ctx context.Context, req resource.Delete
 	}
 	_, err := conn.DeleteAssessmentFrameworkShare(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameFrameworkShare, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -192,8 +193,12 @@ func (r *resourceFrameworkShare) ImportState(ctx context.Context, req resource.I
 }
 
 func FindFrameworkShareByID(ctx context.Context, conn *auditmanager.Client, id string) (*awstypes.AssessmentFrameworkShareRequest, error) {
+	return findFrameworkShareByIDAndType(ctx, conn, id, awstypes.ShareRequestTypeSent)
+}
+
+func findFrameworkShareByIDAndType(ctx context.Context, conn *auditmanager.Client, id string, requestType awstypes.ShareRequestType) (*awstypes.AssessmentFrameworkShareRequest, error) {
 	in := &auditmanager.ListAssessmentFrameworkShareRequestsInput{
-		RequestType: awstypes.ShareRequestTypeSent,
+		RequestType: requestType,
 	}
 	pages := auditmanager.NewListAssessmentFrameworkShareRequestsPaginator(conn, in)
 
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource
+func newResourceFrameworkShareAccepter(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceFrameworkShareAccepter{}, nil
+}
+
+const (
+	ResNameFrameworkShareAccepter = "FrameworkShareAccepter"
+
+	frameworkShareAcceptedTimeout = 10 * time.Minute
+)
+
+type resourceFrameworkShareAccepter struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceFrameworkShareAccepter) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_auditmanager_framework_share_accepter"
+}
+
+func (r *resourceFrameworkShareAccepter) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Computed: true,
+			},
+			"compliance_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"framework_description": schema.StringAttribute{
+				Computed: true,
+			},
+			"framework_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"framework_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"share_request_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_account": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceFrameworkShareAccepter) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var plan resourceFrameworkShareAccepterData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ShareRequestID.ValueString()
+	in := auditmanager.UpdateAssessmentFrameworkShareInput{
+		Action:      awstypes.ShareRequestActionAccept,
+		RequestId:   aws.String(id),
+		RequestType: awstypes.ShareRequestTypeReceived,
+	}
+	out, err := conn.UpdateAssessmentFrameworkShare(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameFrameworkShareAccepter, id, nil),
+			err,
+		))
+		return
+	}
+	if out == nil || out.AssessmentFrameworkShareRequest == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameFrameworkShareAccepter, id, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	// The shared framework is copied into the recipient's library asynchronously.
+	share, err := waitFrameworkShareAccepted(ctx, conn, id, frameworkShareAcceptedTimeout)
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionWaitingForCreation, ResNameFrameworkShareAccepter, id, nil),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.refreshFromOutput(ctx, share)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceFrameworkShareAccepter) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var state resourceFrameworkShareAccepterData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findFrameworkShareByIDAndType(ctx, conn, state.ID.ValueString(), awstypes.ShareRequestTypeReceived)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameFrameworkShareAccepter, state.ID.String(), nil),
+			err,
+		))
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op. Changing share_request_id will result in a destroy and replace.
+func (r *resourceFrameworkShareAccepter) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete removes the received share request. The framework copied into the
+// recipient's library is not affected.
+func (r *resourceFrameworkShareAccepter) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var state resourceFrameworkShareAccepterData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := auditmanager.DeleteAssessmentFrameworkShareInput{
+		RequestId:   aws.String(state.ID.ValueString()),
+		RequestType: awstypes.ShareRequestTypeReceived,
+	}
+	_, err := conn.DeleteAssessmentFrameworkShare(ctx, &in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameFrameworkShareAccepter, state.ID.String(), nil),
+			err,
+		))
+	}
+}
+
+func (r *resourceFrameworkShareAccepter) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("share_request_id"), req.ID)...)
+}
+
+func statusFrameworkShare(ctx context.Context, conn *auditmanager.Client, id string, requestType awstypes.ShareRequestType) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findFrameworkShareByIDAndType(ctx, conn, id, requestType)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitFrameworkShareAccepted(ctx context.Context, conn *auditmanager.Client, id string, timeout time.Duration) (*awstypes.AssessmentFrameworkShareRequest, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ShareRequestStatusActive, awstypes.ShareRequestStatusReplicating),
+		Target:  enum.Slice(awstypes.ShareRequestStatusShared),
+		Refresh: statusFrameworkShare(ctx, conn, id, awstypes.ShareRequestTypeReceived),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.AssessmentFrameworkShareRequest); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+type resourceFrameworkShareAccepterData struct {
+	Comment              types.String `tfsdk:"comment"`
+	ComplianceType       types.String `tfsdk:"compliance_type"`
+	FrameworkDescription types.String `tfsdk:"framework_description"`
+	FrameworkID          types.String `tfsdk:"framework_id"`
+	FrameworkName        types.String `tfsdk:"framework_name"`
+	ID                   types.String `tfsdk:"id"`
+	ShareRequestID       types.String `tfsdk:"share_request_id"`
+	SourceAccount        types.String `tfsdk:"source_account"`
+	Status               types.String `tfsdk:"status"`
+}
+
+// refreshFromOutput writes state data from an AWS response object
+func (rd *resourceFrameworkShareAccepterData) refreshFromOutput(ctx context.Context, out *awstypes.AssessmentFrameworkShareRequest) {
+	if out == nil {
+		return
+	}
+
+	rd.Comment = flex.StringToFramework(ctx, out.Comment)
+	rd.ComplianceType = flex.StringToFramework(ctx, out.ComplianceType)
+	rd.FrameworkDescription = flex.StringToFramework(ctx, out.FrameworkDescription)
+	rd.FrameworkID = flex.StringToFramework(ctx, out.FrameworkId)
+	rd.FrameworkName = flex.StringToFramework(ctx, out.FrameworkName)
+	rd.ID = flex.StringToFramework(ctx, out.Id)
+	rd.ShareRequestID = flex.StringToFramework(ctx, out.Id)
+	rd.SourceAccount = flex.StringToFramework(ctx, out.SourceAccount)
+	rd.Status = flex.StringValueToFramework(ctx, out.Status)
+}
@@ -17,6 +17,9 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceAssessmentEvidenceFolders,
+		},
 		{
 			Factory: newDataSourceControl,
 		},
@@ -61,6 +64,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceFrameworkShare,
 		},
+		{
+			Factory: newResourceFrameworkShareAccepter,
+		},
 		{
 			Factory: newResourceOrganizationAdminAccountRegistration,
 		},
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Capability")
+// @Tags(identifierAttribute="arn")
+func newCapabilityResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &capabilityResource{}
+
+	return r, nil
+}
+
+type capabilityResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*capabilityResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_b2bi_capability"
+}
+
+func (r *capabilityResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 254),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(b2bi.CapabilityType_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capabilityConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"edi": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[ediConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"transformer_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"input_location":  s3LocationBlock(ctx, true),
+									"output_location": s3LocationBlock(ctx, true),
+									names.AttrType:    ediTypeBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"instructions_documents": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: s3LocationBlock(ctx, false).NestedObject,
+			},
+		},
+	}
+}
+
+func (r *capabilityResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data capabilityResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	input := &b2bi.CreateCapabilityInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCapabilityWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating B2BI Capability", err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CapabilityARN = fwflex.StringToFramework(ctx, output.CapabilityArn)
+	data.CapabilityID = fwflex.StringToFramework(ctx, output.CapabilityId)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *capabilityResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data capabilityResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	output, err := findCapabilityByID(ctx, conn, data.CapabilityID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading B2BI Capability (%s)", data.CapabilityID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *capabilityResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new capabilityResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) ||
+		!new.InstructionsDocuments.Equal(old.InstructionsDocuments) ||
+		!new.Name.Equal(old.Name) {
+		input := &b2bi.UpdateCapabilityInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCapabilityWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating B2BI Capability (%s)", new.CapabilityID.ValueString()), err))
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *capabilityResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data capabilityResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	_, err := conn.DeleteCapabilityWithContext(ctx, &b2bi.DeleteCapabilityInput{
+		CapabilityId: aws.String(data.CapabilityID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting B2BI Capability (%s)", data.CapabilityID.ValueString()), err))
+
+		return
+	}
+}
+
+func (r *capabilityResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCapabilityByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetCapabilityOutput, error) {
+	input := &b2bi.GetCapabilityInput{
+		CapabilityId: aws.String(id),
+	}
+
+	output, err := conn.GetCapabilityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func s3LocationBlock(ctx context.Context, required bool) schema.ListNestedBlock {
+	validators := []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+	if required {
+		validators = append(validators, listvalidator.IsRequired())
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrBucketName: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(3, 63),
+					},
+				},
+				names.AttrKey: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(0, 1024),
+					},
+				},
+			},
+		},
+	}
+}
+
+type capabilityResourceModel struct {
+	CapabilityARN         types.String                                                  `tfsdk:"arn"`
+	CapabilityID          types.String                                                  `tfsdk:"id"`
+	Configuration         fwtypes.ListNestedObjectValueOf[capabilityConfigurationModel] `tfsdk:"configuration"`
+	CreatedAt             timetypes.RFC3339                                             `tfsdk:"created_at"`
+	InstructionsDocuments fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"instructions_documents"`
+	Name                  types.String                                                  `tfsdk:"name"`
+	Tags                  types.Map                                                     `tfsdk:"tags"`
+	TagsAll               types.Map                                                     `tfsdk:"tags_all"`
+	Type                  types.String                                                  `tfsdk:"type"`
+}
+
+type capabilityConfigurationModel struct {
+	EDI fwtypes.ListNestedObjectValueOf[ediConfigurationModel] `tfsdk:"edi"`
+}
+
+type ediConfigurationModel struct {
+	InputLocation  fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"input_location"`
+	OutputLocation fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"output_location"`
+	TransformerID  types.String                                     `tfsdk:"transformer_id"`
+	Type           fwtypes.ListNestedObjectValueOf[ediTypeModel]    `tfsdk:"type"`
+}
+
+type s3LocationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	Key        types.String `tfsdk:"key"`
+}
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Transformer")
+// @Tags(identifierAttribute="arn")
+func newTransformerResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &transformerResource{}
+
+	return r, nil
+}
+
+type transformerResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*transformerResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_b2bi_transformer"
+}
+
+func (r *transformerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(b2bi.FileFormat_Values()...),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"mapping_template": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(350000),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 254),
+				},
+			},
+			"sample_document": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(b2bi.TransformerStatus_Values()...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"edi_type": ediTypeBlock(ctx),
+		},
+	}
+}
+
+func (r *transformerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	input := &b2bi.CreateTransformerInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTransformerWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating B2BI Transformer", err))
+
+		return
+	}
+
+	data.TransformerID = fwflex.StringToFramework(ctx, output.TransformerId)
+
+	// Transformers are always created inactive.
+	if status := data.Status.ValueString(); !data.Status.IsUnknown() && status != aws.StringValue(output.Status) {
+		_, err := conn.UpdateTransformerWithContext(ctx, &b2bi.UpdateTransformerInput{
+			Status:        aws.String(status),
+			TransformerId: output.TransformerId,
+		})
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating B2BI Transformer (%s) status", data.TransformerID.ValueString()), err))
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	transformer, err := findTransformerByID(ctx, conn, data.TransformerID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading B2BI Transformer (%s)", data.TransformerID.ValueString()), err))
+
+		return
+	}
+
+	data.CreatedAt = fwflex.TimeToFramework(ctx, transformer.CreatedAt)
+	data.Status = fwflex.StringToFramework(ctx, transformer.Status)
+	data.TransformerARN = fwflex.StringToFramework(ctx, transformer.TransformerArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *transformerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	output, err := findTransformerByID(ctx, conn, data.TransformerID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading B2BI Transformer (%s)", data.TransformerID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transformerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	if !new.EDIType.Equal(old.EDIType) ||
+		!new.FileFormat.Equal(old.FileFormat) ||
+		!new.MappingTemplate.Equal(old.MappingTemplate) ||
+		!new.Name.Equal(old.Name) ||
+		!new.SampleDocument.Equal(old.SampleDocument) ||
+		!new.Status.Equal(old.Status) {
+		input := &b2bi.UpdateTransformerInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateTransformerWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating B2BI Transformer (%s)", new.TransformerID.ValueString()), err))
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *transformerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIConn(ctx)
+
+	_, err := conn.DeleteTransformerWithContext(ctx, &b2bi.DeleteTransformerInput{
+		TransformerId: aws.String(data.TransformerID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting B2BI Transformer (%s)", data.TransformerID.ValueString()), err))
+
+		return
+	}
+}
+
+func (r *transformerResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTransformerByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetTransformerOutput, error) {
+	input := &b2bi.GetTransformerInput{
+		TransformerId: aws.String(id),
+	}
+
+	output, err := conn.GetTransformerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func ediTypeBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ediTypeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"x12_details": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[x12DetailsModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"transaction_set": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(b2bi.X12TransactionSet_Values()...),
+								},
+							},
+							names.AttrVersion: schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(b2bi.X12Version_Values()...),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type transformerResourceModel struct {
+	CreatedAt       timetypes.RFC3339                             `tfsdk:"created_at"`
+	EDIType         fwtypes.ListNestedObjectValueOf[ediTypeModel] `tfsdk:"edi_type"`
+	FileFormat      types.String                                  `tfsdk:"file_format"`
+	MappingTemplate types.String                                  `tfsdk:"mapping_template"`
+	Name            types.String                                  `tfsdk:"name"`
+	SampleDocument  types.String                                  `tfsdk:"sample_document"`
+	Status          types.String                                  `tfsdk:"status"`
+	Tags            types.Map                                     `tfsdk:"tags"`
+	TagsAll         types.Map                                     `tfsdk:"tags_all"`
+	TransformerARN  types.String                                  `tfsdk:"arn"`
+	TransformerID   types.String                                  `tfsdk:"id"`
+}
+
+type ediTypeModel struct {
+	X12Details fwtypes.ListNestedObjectValueOf[x12DetailsModel] `tfsdk:"x12_details"`
+}
+
+type x12DetailsModel struct {
+	TransactionSet types.String `tfsdk:"transaction_set"`
+	Version        types.String `tfsdk:"version"`
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_backup_logically_air_gapped_vault", name="Logically Air Gapped Vault")
+// @Tags(identifierAttribute="arn")
+func ResourceLogicallyAirGappedVault() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLogicallyAirGappedVaultCreate,
+		ReadWithoutTimeout:   resourceLogicallyAirGappedVaultRead,
+		UpdateWithoutTimeout: resourceLogicallyAirGappedVaultUpdate,
+		DeleteWithoutTimeout: resourceLogicallyAirGappedVaultDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(7, 36500),
+			},
+			"min_retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(7, 36500),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]*$`), "must consist of letters, numbers, and hyphens."),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLogicallyAirGappedVaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	minRetentionDays, maxRetentionDays := d.Get("min_retention_days").(int), d.Get("max_retention_days").(int)
+	if minRetentionDays > maxRetentionDays {
+		return sdkdiag.AppendErrorf(diags, "min_retention_days (%d) must not be greater than max_retention_days (%d)", minRetentionDays, maxRetentionDays)
+	}
+
+	name := d.Get(names.AttrName).(string)
+	input := &backup.CreateLogicallyAirGappedBackupVaultInput{
+		BackupVaultName:  aws.String(name),
+		BackupVaultTags:  getTagsIn(ctx),
+		CreatorRequestId: aws.String(id.UniqueId()),
+		MaxRetentionDays: aws.Int64(int64(maxRetentionDays)),
+		MinRetentionDays: aws.Int64(int64(minRetentionDays)),
+	}
+
+	output, err := conn.CreateLogicallyAirGappedBackupVaultWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Backup Logically Air Gapped Vault (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupVaultName))
+
+	if aws.StringValue(output.VaultState) != backup.VaultStateAvailable {
+		if _, err := waitLogicallyAirGappedVaultCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Backup Logically Air Gapped Vault (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceLogicallyAirGappedVaultRead(ctx, d, meta)...)
+}
+
+func resourceLogicallyAirGappedVaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	output, err := FindLogicallyAirGappedVaultByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Logically Air Gapped Vault (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.BackupVaultArn)
+	d.Set("max_retention_days", output.MaxRetentionDays)
+	d.Set("min_retention_days", output.MinRetentionDays)
+	d.Set(names.AttrName, output.BackupVaultName)
+
+	return diags
+}
+
+func resourceLogicallyAirGappedVaultUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceLogicallyAirGappedVaultRead(ctx, d, meta)...)
+}
+
+func resourceLogicallyAirGappedVaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Backup Logically Air Gapped Vault: %s", d.Id())
+	_, err := conn.DeleteBackupVaultWithContext(ctx, &backup.DeleteBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException, errCodeAccessDeniedException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLogicallyAirGappedVaultByName(ctx context.Context, conn *backup.Backup, name string) (*backup.DescribeBackupVaultOutput, error) {
+	output, err := FindVaultByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if vaultType := aws.StringValue(output.VaultType); vaultType != backup.VaultTypeLogicallyAirGappedBackupVault {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("Backup Vault (%s) has type %s", name, vaultType),
+		}
+	}
+
+	return output, nil
+}
+
+// statusLogicallyAirGappedVault reports the vault as AVAILABLE once it can be described.
+// DescribeBackupVault does not return the vault state.
+func statusLogicallyAirGappedVault(ctx context.Context, conn *backup.Backup, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLogicallyAirGappedVaultByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, backup.VaultStateCreating, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, backup.VaultStateAvailable, nil
+	}
+}
+
+func waitLogicallyAirGappedVaultCreated(ctx context.Context, conn *backup.Backup, name string, timeout time.Duration) (*backup.DescribeBackupVaultOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{backup.VaultStateCreating},
+		Target:                    []string{backup.VaultStateAvailable},
+		Refresh:                   statusLogicallyAirGappedVault(ctx, conn, name),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*backup.DescribeBackupVaultOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
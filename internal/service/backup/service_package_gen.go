@@ -64,6 +64,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalSettings,
 			TypeName: "aws_backup_global_settings",
 		},
+		{
+			Factory:  ResourceLogicallyAirGappedVault,
+			TypeName: "aws_backup_logically_air_gapped_vault",
+			Name:     "Logically Air Gapped Vault",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourcePlan,
 			TypeName: "aws_backup_plan",
@@ -84,6 +92,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceRestoreTestingPlan,
+			TypeName: "aws_backup_restore_testing_plan",
+			Name:     "Restore Testing Plan",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceRestoreTestingSelection,
+			TypeName: "aws_backup_restore_testing_selection",
+			Name:     "Restore Testing Selection",
+		},
 		{
 			Factory:  ResourceSelection,
 			TypeName: "aws_backup_selection",
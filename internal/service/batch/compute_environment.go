@@ -43,6 +43,12 @@ func ResourceComputeEnvironment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			resourceComputeEnvironmentCustomizeDiff,
 			verify.SetTagsDiff,
@@ -170,7 +176,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"placement_group": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						names.AttrSecurityGroupIDs: {
 							Type:     schema.TypeSet,
@@ -197,6 +202,11 @@ func ResourceComputeEnvironment() *schema.Resource {
 							},
 							ValidateFunc: validation.StringInSlice(batch.CRType_Values(), true),
 						},
+						"update_to_latest_image_version": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -330,7 +340,7 @@ func resourceComputeEnvironmentCreate(ctx context.Context, d *schema.ResourceDat
 			return sdkdiag.AppendErrorf(diags, "Create Batch Compute Environment extra arguments through UpdateComputeEnvironment (%s): %s", d.Id(), err)
 		}
 
-		if err := waitComputeEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitComputeEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "Create waiting for Batch Compute Environment (%s) extra arguments through UpdateComputeEnvironment: %s", d.Id(), err)
 		}
 	}
@@ -360,7 +370,10 @@ func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("compute_environment_name", computeEnvironment.ComputeEnvironmentName)
 	d.Set("compute_environment_name_prefix", create.NamePrefixFromName(aws.StringValue(computeEnvironment.ComputeEnvironmentName)))
 	if computeEnvironment.ComputeResources != nil {
-		if err := d.Set("compute_resources", []interface{}{flattenComputeResource(ctx, computeEnvironment.ComputeResources)}); err != nil {
+		tfMap := flattenComputeResource(ctx, computeEnvironment.ComputeResources)
+		// UpdateToLatestImageVersion is not returned by DescribeComputeEnvironments.
+		tfMap["update_to_latest_image_version"] = d.Get("compute_resources.0.update_to_latest_image_version").(bool)
+		if err := d.Set("compute_resources", []interface{}{tfMap}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting compute_resources: %s", err)
 		}
 	} else {
@@ -505,6 +518,14 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 					computeResourceUpdate.LaunchTemplate = expandLaunchTemplateSpecificationUpdate(launchTemplate)
 				}
 
+				if d.HasChange("compute_resources.0.placement_group") {
+					if placementGroup, ok := d.GetOk("compute_resources.0.placement_group"); ok {
+						computeResourceUpdate.PlacementGroup = aws.String(placementGroup.(string))
+					} else {
+						computeResourceUpdate.PlacementGroup = aws.String("")
+					}
+				}
+
 				if d.HasChange("compute_resources.0.tags") {
 					if tags, ok := d.GetOk("compute_resources.0.tags"); ok {
 						computeResourceUpdate.Tags = Tags(tftags.New(ctx, tags.(map[string]interface{})).IgnoreAWS())
@@ -512,6 +533,11 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 						computeResourceUpdate.Tags = aws.StringMap(map[string]string{})
 					}
 				}
+
+				// Infrastructure updates may only replace the AMI when the environment uses the service-linked role and an updatable allocation strategy.
+				if d.Get("compute_resources.0.update_to_latest_image_version").(bool) && isServiceLinkedRole(d.Get(names.AttrServiceRole).(string)) && isUpdatableAllocationStrategy(d.Get("compute_resources.0.allocation_strategy").(string)) {
+					computeResourceUpdate.UpdateToLatestImageVersion = aws.Bool(true)
+				}
 			}
 
 			input.ComputeResources = computeResourceUpdate
@@ -522,7 +548,7 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 			return sdkdiag.AppendErrorf(diags, "updating Batch Compute Environment (%s): %s", d.Id(), err)
 		}
 
-		if err := waitComputeEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitComputeEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Batch Compute Environment (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -672,11 +698,23 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 				}
 			}
 
+			if diff.HasChange("compute_resources.0.placement_group") {
+				if err := diff.ForceNew("compute_resources.0.placement_group"); err != nil {
+					return err
+				}
+			}
+
 			if diff.HasChange("compute_resources.0.tags") {
 				if err := diff.ForceNew("compute_resources.0.tags"); err != nil {
 					return err
 				}
 			}
+
+			if diff.HasChange("compute_resources.0.update_to_latest_image_version") {
+				if err := diff.ForceNew("compute_resources.0.update_to_latest_image_version"); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -797,7 +835,7 @@ func waitComputeEnvironmentDisabled(ctx context.Context, conn *batch.Batch, name
 	return nil, err
 }
 
-func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Batch, name string, timeout time.Duration) error {
+func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Batch, name string, timeout time.Duration) (*batch.ComputeEnvironmentDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{batch.CEStatusUpdating},
 		Target:  []string{batch.CEStatusValid},
@@ -807,11 +845,15 @@ func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Batch, name
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if _, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
-		return err
+	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
+		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
 	}
 
-	return err
+	return nil, err
 }
 
 func isFargateType(computeResourceType string) bool {
@@ -872,7 +914,12 @@ func isUpdatableAllocationStrategyDiff(diff *schema.ResourceDiff) bool {
 }
 
 func isUpdatableAllocationStrategy(allocationStrategy string) bool {
-	return allocationStrategy == batch.CRAllocationStrategyBestFitProgressive || allocationStrategy == batch.CRAllocationStrategySpotCapacityOptimized
+	switch allocationStrategy {
+	case batch.CRAllocationStrategyBestFitProgressive, batch.CRAllocationStrategySpotCapacityOptimized, batch.CRAllocationStrategySpotPriceCapacityOptimized:
+		return true
+	default:
+		return false
+	}
 }
 
 func expandComputeResource(ctx context.Context, tfMap map[string]interface{}) *batch.ComputeResource {
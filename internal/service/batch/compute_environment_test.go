@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1795,6 +1796,61 @@ func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 	})
 }
 
+func TestAccBatchComputeEnvironment_updateInfrastructure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ce batch.ComputeEnvironmentDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_compute_environment.test"
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeEnvironmentConfig_infrastructureUpdate(rName, publicKey, "c5.large", "placeholder", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.allocation_strategy", "SPOT_PRICE_CAPACITY_OPTIMIZED"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_resources.0.instance_type.*", "c5.large"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.launch_template.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_resources.0.launch_template.0.version", "aws_launch_template.test_infrastructure", "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.update_to_latest_image_version", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccComputeEnvironmentConfig_infrastructureUpdate(rName, publicKey, "c5.xlarge", "updated", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.allocation_strategy", "SPOT_PRICE_CAPACITY_OPTIMIZED"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_resources.0.instance_type.*", "c5.xlarge"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.launch_template.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_resources.0.launch_template.0.version", "aws_launch_template.test_infrastructure", "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.update_to_latest_image_version", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compute_resources.0.update_to_latest_image_version"},
+			},
+		},
+	})
+}
+
 // Test plan time errors...
 
 func TestAccBatchComputeEnvironment_createEC2WithoutComputeResources(t *testing.T) {
@@ -3129,3 +3185,43 @@ resource "aws_batch_compute_environment" "test" {
 }
 `, rName))
 }
+
+func testAccComputeEnvironmentConfig_infrastructureUpdate(rName, publicKey, instanceType, userData string, updateToLatestImageVersion bool) string {
+	return acctest.ConfigCompose(
+		testAccComputeEnvironmentConfig_base(rName),
+		testAccComputeEnvironmentConfig_baseForUpdates(rName, publicKey),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test_infrastructure" {
+  name      = "%[1]s-infrastructure"
+  user_data = base64encode(%[3]q)
+}
+
+resource "aws_batch_compute_environment" "test" {
+  compute_environment_name = %[1]q
+
+  compute_resources {
+    allocation_strategy = "SPOT_PRICE_CAPACITY_OPTIMIZED"
+    instance_role       = aws_iam_instance_profile.ecs_instance.arn
+    instance_type = [
+      %[2]q,
+    ]
+    launch_template {
+      launch_template_id = aws_launch_template.test_infrastructure.id
+      version            = aws_launch_template.test_infrastructure.latest_version
+    }
+    max_vcpus = 16
+    security_group_ids = [
+      aws_security_group.test.id
+    ]
+    subnets = [
+      aws_subnet.test.id
+    ]
+    type = "SPOT"
+
+    update_to_latest_image_version = %[4]t
+  }
+
+  type = "MANAGED"
+}
+`, rName, instanceType, userData, updateToLatestImageVersion))
+}
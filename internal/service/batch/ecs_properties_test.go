@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"testing"
+
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+)
+
+func TestEquivalentECSPropertiesJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ApiJson           string
+		ConfigurationJson string
+		ExpectEquivalent  bool
+		ExpectError       bool
+	}{
+		"empty": {
+			ApiJson:           ``,
+			ConfigurationJson: ``,
+			ExpectEquivalent:  true,
+		},
+		"server defaults": {
+			ApiJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"command": ["sleep", "60"],
+					"dependsOn": [],
+					"environment": [],
+					"essential": true,
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:2",
+					"mountPoints": [],
+					"name": "app",
+					"resourceRequirements": [
+						{"type": "VCPU", "value": "1"},
+						{"type": "MEMORY", "value": "2048"}
+					],
+					"secrets": [],
+					"ulimits": []
+				},
+				{
+					"essential": false,
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:2",
+					"name": "sidecar"
+				}
+			],
+			"platformVersion": "LATEST",
+			"volumes": []
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"command": ["sleep", "60"],
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:2",
+					"name": "app",
+					"resourceRequirements": [
+						{"type": "MEMORY", "value": "2048"},
+						{"type": "VCPU", "value": "1"}
+					]
+				},
+				{
+					"essential": false,
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:2",
+					"name": "sidecar"
+				}
+			]
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		"reordered environment": {
+			ApiJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"environment": [
+						{"name": "A", "value": "1"},
+						{"name": "B", "value": "2"}
+					],
+					"image": "busybox",
+					"name": "app"
+				}
+			]
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"environment": [
+						{"name": "B", "value": "2"},
+						{"name": "A", "value": "1"},
+						{"name": "C", "value": ""}
+					],
+					"image": "busybox",
+					"name": "app"
+				}
+			]
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		"different container": {
+			ApiJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"image": "busybox",
+					"name": "app"
+				}
+			]
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"image": "busybox",
+					"name": "app"
+				},
+				{
+					"image": "busybox",
+					"name": "sidecar"
+				}
+			]
+		}
+	]
+}
+`,
+			ExpectEquivalent: false,
+		},
+		"invalid JSON": {
+			ApiJson:           `{}`,
+			ConfigurationJson: `{`,
+			ExpectEquivalent:  false,
+			ExpectError:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfbatch.EquivalentECSPropertiesJSON(testCase.ConfigurationJson, testCase.ApiJson)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+
+			if got != testCase.ExpectEquivalent {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectEquivalent)
+			}
+		})
+	}
+}
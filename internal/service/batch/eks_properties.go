@@ -54,6 +54,15 @@ func expandEKSPodProperties(podPropsMap map[string]interface{}) *batch.EksPodPro
 	if v, ok := podPropsMap["host_network"]; ok {
 		podProps.HostNetwork = aws.Bool(v.(bool))
 	}
+
+	if v, ok := podPropsMap["image_pull_secret"]; ok {
+		podProps.ImagePullSecrets = expandImagePullSecrets(v.([]interface{}))
+	}
+
+	if v, ok := podPropsMap["init_containers"]; ok {
+		podProps.InitContainers = expandContainers(v.([]interface{}))
+	}
+
 	if m, ok := podPropsMap["metadata"].([]interface{}); ok && len(m) > 0 {
 		if v, ok := m[0].(map[string]interface{})["labels"]; ok {
 			podProps.Metadata = &batch.EksMetadata{}
@@ -63,6 +72,9 @@ func expandEKSPodProperties(podPropsMap map[string]interface{}) *batch.EksPodPro
 	if v, ok := podPropsMap["service_account_name"].(string); ok && v != "" {
 		podProps.ServiceAccountName = aws.String(v)
 	}
+	if v, ok := podPropsMap["share_process_namespace"]; ok {
+		podProps.ShareProcessNamespace = aws.Bool(v.(bool))
+	}
 	if v, ok := podPropsMap["volumes"]; ok {
 		podProps.Volumes = expandVolumes(v.([]interface{}))
 	}
@@ -70,6 +82,25 @@ func expandEKSPodProperties(podPropsMap map[string]interface{}) *batch.EksPodPro
 	return podProps
 }
 
+func expandImagePullSecrets(imagePullSecrets []interface{}) []*batch.ImagePullSecret {
+	var result []*batch.ImagePullSecret
+
+	for _, v := range imagePullSecrets {
+		imagePullSecretMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		imagePullSecret := &batch.ImagePullSecret{}
+		if v, ok := imagePullSecretMap[names.AttrName].(string); ok {
+			imagePullSecret.Name = aws.String(v)
+		}
+		result = append(result, imagePullSecret)
+	}
+
+	return result
+}
+
 func expandContainers(containers []interface{}) []*batch.EksContainer {
 	var result []*batch.EksContainer
 
@@ -242,6 +273,14 @@ func flattenEKSPodProperties(podProperties *batch.EksPodProperties) (tfList []in
 		tfMap["host_network"] = aws.BoolValue(v)
 	}
 
+	if v := podProperties.ImagePullSecrets; v != nil {
+		tfMap["image_pull_secret"] = flattenImagePullSecrets(v)
+	}
+
+	if v := podProperties.InitContainers; v != nil {
+		tfMap["init_containers"] = flattenEKSContainers(v)
+	}
+
 	if v := podProperties.Metadata; v != nil {
 		metaData := make([]map[string]interface{}, 0)
 		if v := v.Labels; v != nil {
@@ -254,6 +293,10 @@ func flattenEKSPodProperties(podProperties *batch.EksPodProperties) (tfList []in
 		tfMap["service_account_name"] = aws.StringValue(v)
 	}
 
+	if v := podProperties.ShareProcessNamespace; v != nil {
+		tfMap["share_process_namespace"] = aws.BoolValue(v)
+	}
+
 	if v := podProperties.Volumes; v != nil {
 		tfMap["volumes"] = flattenEKSVolumes(v)
 	}
@@ -262,6 +305,19 @@ func flattenEKSPodProperties(podProperties *batch.EksPodProperties) (tfList []in
 	return tfList
 }
 
+func flattenImagePullSecrets(imagePullSecrets []*batch.ImagePullSecret) (tfList []interface{}) {
+	for _, v := range imagePullSecrets {
+		tfMap := map[string]interface{}{}
+
+		if v := v.Name; v != nil {
+			tfMap[names.AttrName] = aws.StringValue(v)
+		}
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenEKSContainers(containers []*batch.EksContainer) (tfList []interface{}) {
 	for _, container := range containers {
 		tfMap := map[string]interface{}{}
@@ -60,7 +60,7 @@ func ResourceJobDefinition() *schema.Resource {
 			"container_properties": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"eks_properties", "node_properties"},
+				ConflictsWith: []string{"ecs_properties", "eks_properties", "node_properties"},
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
@@ -73,6 +73,21 @@ func ResourceJobDefinition() *schema.Resource {
 				ValidateFunc: validJobContainerProperties,
 			},
 
+			"ecs_properties": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"container_properties", "eks_properties", "node_properties"},
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := EquivalentECSPropertiesJSON(old, new)
+					return equal
+				},
+				ValidateFunc: validJobECSProperties,
+			},
+
 			"deregister_on_new_revision": {
 				Type:     schema.TypeBool,
 				Default:  true,
@@ -89,7 +104,7 @@ func ResourceJobDefinition() *schema.Resource {
 			"node_properties": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"container_properties", "eks_properties"},
+				ConflictsWith: []string{"container_properties", "ecs_properties", "eks_properties"},
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
@@ -105,7 +120,7 @@ func ResourceJobDefinition() *schema.Resource {
 				Type:          schema.TypeList,
 				MaxItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"container_properties", "node_properties"},
+				ConflictsWith: []string{"container_properties", "ecs_properties", "node_properties"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"pod_properties": {
@@ -117,118 +132,8 @@ func ResourceJobDefinition() *schema.Resource {
 									"containers": {
 										Type:     schema.TypeList,
 										Required: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"args": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-												"command": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-												"env": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrName: {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-															names.AttrValue: {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-														},
-													},
-												},
-												"image": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"image_pull_policy": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ValidateFunc: validation.StringInSlice(ImagePullPolicy_Values(), false),
-												},
-												names.AttrName: {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												names.AttrResources: {
-													Type:     schema.TypeList,
-													Optional: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"limits": {
-																Type:     schema.TypeMap,
-																Optional: true,
-																Elem:     &schema.Schema{Type: schema.TypeString},
-															},
-															"requests": {
-																Type:     schema.TypeMap,
-																Optional: true,
-																Elem:     &schema.Schema{Type: schema.TypeString},
-															},
-														},
-													},
-												},
-												"security_context": {
-													Type:     schema.TypeList,
-													Optional: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"privileged": {
-																Type:     schema.TypeBool,
-																Optional: true,
-															},
-															"read_only_root_file_system": {
-																Type:     schema.TypeBool,
-																Optional: true,
-															},
-															"run_as_group": {
-																Type:     schema.TypeInt,
-																Optional: true,
-															},
-															"run_as_non_root": {
-																Type:     schema.TypeBool,
-																Optional: true,
-															},
-															"run_as_user": {
-																Type:     schema.TypeInt,
-																Optional: true,
-															},
-														},
-													},
-												},
-												"volume_mounts": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"mount_path": {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-															names.AttrName: {
-																Type:     schema.TypeString,
-																Required: true,
-															},
-															"read_only": {
-																Type:     schema.TypeBool,
-																Optional: true,
-															},
-														},
-													},
-												},
-											},
-										},
+										MaxItems: 10,
+										Elem:     eksContainerSchema(),
 									},
 									"dns_policy": {
 										Type:         schema.TypeString,
@@ -240,6 +145,24 @@ func ResourceJobDefinition() *schema.Resource {
 										Optional: true,
 										Default:  true,
 									},
+									"image_pull_secret": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"init_containers": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 10,
+										Elem:     eksContainerSchema(),
+									},
 									"metadata": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -258,6 +181,10 @@ func ResourceJobDefinition() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
+									"share_process_namespace": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 									"volumes": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -448,6 +375,120 @@ func ResourceJobDefinition() *schema.Resource {
 	}
 }
 
+func eksContainerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"args": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"env": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image_pull_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ImagePullPolicy_Values(), false),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrResources: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limits": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"requests": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"security_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"privileged": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"read_only_root_file_system": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"run_as_group": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"run_as_non_root": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"run_as_user": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"volume_mounts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mount_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func jobDefinitionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if d.Id() != "" && needsJobDefUpdate(d) && d.Get(names.AttrARN).(string) != "" {
 		d.SetNewComputed(names.AttrARN)
@@ -480,6 +521,19 @@ func needsJobDefUpdate(d *schema.ResourceDiff) bool {
 		}
 	}
 
+	if d.HasChange("ecs_properties") {
+		o, n := d.GetChange("ecs_properties")
+
+		equivalent, err := EquivalentECSPropertiesJSON(o.(string), n.(string))
+		if err != nil {
+			return false
+		}
+
+		if !equivalent {
+			return true
+		}
+	}
+
 	if d.HasChange("node_properties") {
 		o, n := d.GetChange("node_properties")
 
@@ -604,6 +658,20 @@ func resourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, me
 			}
 		}
 
+		if v, ok := d.GetOk("ecs_properties"); ok {
+			props, err := expandJobECSProperties(v.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Batch Job Definition (%s): %s", name, err)
+			}
+
+			for _, taskProps := range props.TaskProperties {
+				for _, container := range taskProps.Containers {
+					removeEmptyEnvironmentVariables(&diags, container.Environment, cty.GetAttrPath("ecs_properties"))
+				}
+			}
+			input.EcsProperties = props
+		}
+
 		if v, ok := d.GetOk("eks_properties"); ok && len(v.([]interface{})) > 0 {
 			eksProps := v.([]interface{})[0].(map[string]interface{})
 			if podProps, ok := eksProps["pod_properties"].([]interface{}); ok && len(podProps) > 0 {
@@ -621,6 +689,9 @@ func resourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, me
 		if v, ok := d.GetOk("container_properties"); ok && v != nil {
 			return sdkdiag.AppendErrorf(diags, "No `container_properties` can be specified when `type` is %q", jobDefinitionType)
 		}
+		if v, ok := d.GetOk("ecs_properties"); ok && v != nil {
+			return sdkdiag.AppendErrorf(diags, "No `ecs_properties` can be specified when `type` is %q", jobDefinitionType)
+		}
 		if v, ok := d.GetOk("eks_properties"); ok && v != nil {
 			return sdkdiag.AppendErrorf(diags, "No `eks_properties` can be specified when `type` is %q", jobDefinitionType)
 		}
@@ -699,6 +770,16 @@ func resourceJobDefinitionRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting container_properties: %s", err)
 	}
 
+	ecsProperties, err := flattenECSProperties(jobDefinition.EcsProperties)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting Batch ECS Properties to JSON: %s", err)
+	}
+
+	if err := d.Set("ecs_properties", ecsProperties); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ecs_properties: %s", err)
+	}
+
 	if err := d.Set("eks_properties", flattenEKSProperties(jobDefinition.EksProperties)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting eks_properties: %s", err)
 	}
@@ -768,6 +849,22 @@ func resourceJobDefinitionUpdate(ctx context.Context, d *schema.ResourceData, me
 			}
 		}
 
+		if v, ok := d.GetOk("ecs_properties"); ok {
+			props, err := expandJobECSProperties(v.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Batch Job Definition (%s): %s", name, err)
+			}
+
+			if aws.StringValue(input.Type) == batch.JobDefinitionTypeContainer {
+				for _, taskProps := range props.TaskProperties {
+					for _, container := range taskProps.Containers {
+						removeEmptyEnvironmentVariables(&diags, container.Environment, cty.GetAttrPath("ecs_properties"))
+					}
+				}
+				input.EcsProperties = props
+			}
+		}
+
 		if v, ok := d.GetOk("eks_properties"); ok {
 			eksProps := v.([]interface{})[0].(map[string]interface{})
 			if podProps, ok := eksProps["pod_properties"].([]interface{}); ok && len(podProps) > 0 {
@@ -954,6 +1051,37 @@ func flattenContainerProperties(containerProperties *batch.ContainerProperties)
 	return string(b), nil
 }
 
+func validJobECSProperties(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := expandJobECSProperties(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("AWS Batch Job ecs_properties is invalid: %s", err))
+	}
+	return
+}
+
+func expandJobECSProperties(rawProps string) (*batch.EcsProperties, error) {
+	var props *batch.EcsProperties
+
+	err := json.Unmarshal([]byte(rawProps), &props)
+	if err != nil {
+		return nil, fmt.Errorf("decoding JSON: %s", err)
+	}
+
+	return props, nil
+}
+
+// Convert batch.EcsProperties object into its JSON representation
+func flattenECSProperties(ecsProperties *batch.EcsProperties) (string, error) {
+	b, err := jsonutil.BuildJSON(ecsProperties)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
 func validJobNodeProperties(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	_, err := expandJobNodeProperties(value)
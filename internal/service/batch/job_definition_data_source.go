@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -139,7 +140,7 @@ func (d *jobDefinitionDataSource) Read(ctx context.Context, request datasource.R
 		output, err := findJobDefinitionV2(ctx, conn, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading Batch Job Definition (%s)", arn), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Batch Job Definition (%s)", arn), err))
 
 			return
 		}
@@ -163,7 +164,7 @@ func (d *jobDefinitionDataSource) Read(ctx context.Context, request datasource.R
 		}
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading Batch Job Definitions (%s/%s)", name, status), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Batch Job Definitions (%s/%s)", name, status), err))
 
 			return
 		}
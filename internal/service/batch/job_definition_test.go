@@ -842,6 +842,91 @@ func TestAccBatchJobDefinition_EKSProperties_update(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_EKSProperties_multiContainers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_EKSProperties_multiContainers(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.name", "app"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.init_containers.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.init_containers.0.name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.share_process_namespace", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"deregister_on_new_revision",
+				},
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_ECSProperties_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_ECSProperties(rName, "sleep"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					acctest.CheckResourceAttrJMES(resourceName, "ecs_properties", "length(taskProperties)", acctest.Ct1),
+					acctest.CheckResourceAttrJMES(resourceName, "ecs_properties", "length(taskProperties[0].containers)", acctest.Ct2),
+					acctest.CheckResourceAttrJMES(resourceName, "ecs_properties", "taskProperties[0].containers[0].name", "sleep"),
+					acctest.CheckResourceAttrJMES(resourceName, "ecs_properties", "taskProperties[0].containers[1].name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"deregister_on_new_revision",
+				},
+			},
+			{
+				Config: testAccJobDefinitionConfig_ECSProperties(rName, "sleeper"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					testAccCheckJobDefinitionPreviousDeregistered(ctx, resourceName),
+					acctest.CheckResourceAttrJMES(resourceName, "ecs_properties", "taskProperties[0].containers[0].name", "sleeper"),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBatchJobDefinition_createTypeContainerWithNodeProperties(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1680,6 +1765,149 @@ resource "aws_batch_job_definition" "test" {
 `, rName)
 }
 
+func testAccJobDefinitionConfig_EKSProperties_multiContainers(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+  eks_properties {
+    pod_properties {
+      host_network            = true
+      share_process_namespace = true
+      init_containers {
+        image   = "public.ecr.aws/amazonlinux/amazonlinux:2"
+        name    = "init"
+        command = ["echo", "init"]
+        resources {
+          limits = {
+            cpu    = "0.5"
+            memory = "512Mi"
+          }
+        }
+      }
+      containers {
+        image   = "public.ecr.aws/amazonlinux/amazonlinux:2"
+        name    = "app"
+        command = ["sleep", "60"]
+        resources {
+          limits = {
+            cpu    = "1"
+            memory = "1024Mi"
+          }
+        }
+      }
+      containers {
+        image   = "public.ecr.aws/amazonlinux/amazonlinux:2"
+        name    = "sidecar"
+        command = ["sleep", "60"]
+        resources {
+          limits = {
+            cpu    = "0.5"
+            memory = "512Mi"
+          }
+        }
+      }
+      metadata {
+        labels = {
+          environment = "test"
+          name        = %[1]q
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobDefinitionConfig_ECSProperties(rName, containerName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "ecs_task_execution_role" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role_policy.json
+}
+
+data "aws_iam_policy_document" "assume_role_policy" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ecs-tasks.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_task_execution_role_policy" {
+  role       = aws_iam_role.ecs_task_execution_role.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = [
+    "FARGATE",
+  ]
+
+  ecs_properties = jsonencode({
+    taskProperties = [
+      {
+        executionRoleArn = aws_iam_role.ecs_task_execution_role.arn
+        containers = [
+          {
+            image   = "public.ecr.aws/amazonlinux/amazonlinux:2"
+            name    = %[2]q
+            command = ["sleep", "60"]
+            dependsOn = [
+              {
+                containerName = "sidecar"
+                condition     = "START"
+              }
+            ]
+            environment = [
+              {
+                name  = "TEST"
+                value = "value"
+              }
+            ]
+            resourceRequirements = [
+              {
+                type  = "VCPU"
+                value = "1"
+              },
+              {
+                type  = "MEMORY"
+                value = "2048"
+              }
+            ]
+          },
+          {
+            image     = "public.ecr.aws/amazonlinux/amazonlinux:2"
+            name      = "sidecar"
+            command   = ["sleep", "60"]
+            essential = false
+            resourceRequirements = [
+              {
+                type  = "VCPU"
+                value = "1"
+              },
+              {
+                type  = "MEMORY"
+                value = "2048"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  })
+}
+`, rName, containerName)
+}
+
 func testAccJobDefinitionConfig_createTypeContainerWithNodeProperties(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_batch_job_definition" "test" {
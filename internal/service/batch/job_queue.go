@@ -167,10 +167,10 @@ func (r *resourceJobQueue) Create(ctx context.Context, request resource.CreateRe
 	output, err := conn.CreateJobQueueWithContext(ctx, &input)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Batch, create.ErrActionCreating, ResNameJobQueue, data.JobQueueName.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -181,10 +181,10 @@ func (r *resourceJobQueue) Create(ctx context.Context, request resource.CreateRe
 	out, err := waitJobQueueCreated(ctx, conn, data.JobQueueName.ValueString(), createTimeout)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Batch, create.ErrActionWaitingForCreation, ResNameJobQueue, data.JobQueueName.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -210,10 +210,10 @@ func (r *resourceJobQueue) Read(ctx context.Context, request resource.ReadReques
 	out, err := findJobQueueByName(ctx, conn, data.ID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Batch, create.ErrActionUpdating, ResNameJobQueue, data.JobQueueName.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -294,10 +294,10 @@ func (r *resourceJobQueue) Update(ctx context.Context, request resource.UpdateRe
 		_, err := conn.UpdateJobQueueWithContext(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.Batch, create.ErrActionUpdating, ResNameJobQueue, plan.JobQueueName.ValueString(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -305,10 +305,10 @@ func (r *resourceJobQueue) Update(ctx context.Context, request resource.UpdateRe
 		out, err := waitJobQueueUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
 
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.Batch, create.ErrActionWaitingForCreation, ResNameJobQueue, plan.JobQueueName.ValueString(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -336,10 +336,10 @@ func (r *resourceJobQueue) Delete(ctx context.Context, request resource.DeleteRe
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Batch, create.ErrActionDeleting, ResNameJobQueue, data.JobQueueName.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -348,20 +348,20 @@ func (r *resourceJobQueue) Delete(ctx context.Context, request resource.DeleteRe
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Batch, create.ErrActionDeleting, ResNameJobQueue, data.JobQueueName.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	_, err = waitJobQueueDeleted(ctx, conn, data.ID.ValueString(), deleteTimeout)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Batch, create.ErrActionWaitingForDeletion, ResNameJobQueue, data.JobQueueName.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -6,13 +6,17 @@ package bcmdataexports
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -24,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -231,10 +236,10 @@ func (r *resourceExport) Create(ctx context.Context, req resource.CreateRequest,
 
 	out, err := conn.CreateExport(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionCreating, ResNameExport, "", err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -251,10 +256,10 @@ func (r *resourceExport) Create(ctx context.Context, req resource.CreateRequest,
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	outputRaw, err := waitExportCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionWaitingForCreation, ResNameExport, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -282,10 +287,10 @@ func (r *resourceExport) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionSetting, ResNameExport, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -321,10 +326,10 @@ func (r *resourceExport) Update(ctx context.Context, req resource.UpdateRequest,
 
 		out, err := conn.UpdateExport(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionUpdating, ResNameExport, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -341,10 +346,10 @@ func (r *resourceExport) Update(ctx context.Context, req resource.UpdateRequest,
 	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
 	_, err := waitExportUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionWaitingForUpdate, ResNameExport, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -371,10 +376,10 @@ func (r *resourceExport) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionDeleting, ResNameExport, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -458,6 +463,71 @@ func findExportByID(ctx context.Context, conn *bcmdataexports.Client, exportArn
 	return out, nil
 }
 
+func (r *resourceExport) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resourceExportData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exports, diags := data.Export.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, export := range exports {
+		dataQueries, diags := export.DataQuery.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for j, dataQuery := range dataQueries {
+			if dataQuery.QueryStatement.IsUnknown() || dataQuery.TableConfigurations.IsNull() || dataQuery.TableConfigurations.IsUnknown() {
+				continue
+			}
+
+			// Identifiers referenced by the query statement.
+			identifiers := make(map[string]struct{})
+			for _, v := range strings.FieldsFunc(strings.ToUpper(dataQuery.QueryStatement.ValueString()), func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+			}) {
+				identifiers[v] = struct{}{}
+			}
+			basePath := path.Root("export").AtListIndex(i).AtName("data_query").AtListIndex(j).AtName("table_configurations")
+
+			for table, v := range dataQuery.TableConfigurations.Elements() {
+				tablePath := basePath.AtMapKey(table)
+
+				// Table configurations only apply to tables that are queried.
+				if _, ok := identifiers[strings.ToUpper(table)]; !ok {
+					resp.Diagnostics.AddAttributeError(
+						tablePath,
+						"Invalid Attribute Configuration",
+						fmt.Sprintf("table %q is not referenced by query_statement", table),
+					)
+				}
+
+				properties, ok := v.(fwtypes.MapValueOf[types.String])
+				if !ok || properties.IsNull() || properties.IsUnknown() {
+					continue
+				}
+
+				for property, v := range properties.Elements() {
+					if v, ok := v.(types.String); ok && !v.IsUnknown() && v.ValueString() == "" {
+						resp.Diagnostics.AddAttributeError(
+							tablePath,
+							"Invalid Attribute Configuration",
+							fmt.Sprintf("table %q property %q must not be empty", table, property),
+						)
+					}
+				}
+			}
+		}
+	}
+}
+
 type resourceExportData struct {
 	Export   fwtypes.ListNestedObjectValueOf[exportData] `tfsdk:"export"`
 	ID       types.String                                `tfsdk:"id"`
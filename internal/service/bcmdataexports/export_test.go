@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
 	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -425,6 +426,27 @@ func TestAccBCMDataExportsExport_updateTable(t *testing.T) {
 	})
 }
 
+func TestAccBCMDataExportsExport_tableConfigurationsNotQueried(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExportConfig_updateTableConfigs(rName, "SELECT identity_line_item_id FROM COST_AND_USAGE_DASHBOARD"),
+				ExpectError: regexache.MustCompile(`table "COST_AND_USAGE_REPORT" is not referenced by query_statement`),
+			},
+		},
+	})
+}
+
 func testAccCheckExportDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BCMDataExportsClient(ctx)
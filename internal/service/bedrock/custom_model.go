@@ -304,7 +304,7 @@ func (r *customModelResource) Create(ctx context.Context, request resource.Creat
 	}, errCodeValidationException, "Could not assume provided IAM role")
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Bedrock Custom Model customization job", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Bedrock Custom Model customization job", err))
 
 		return
 	}
@@ -313,7 +313,7 @@ func (r *customModelResource) Create(ctx context.Context, request resource.Creat
 	job, err := findModelCustomizationJobByID(ctx, conn, jobARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err))
 
 		return
 	}
@@ -338,7 +338,7 @@ func (r *customModelResource) Read(ctx context.Context, request resource.ReadReq
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -356,7 +356,7 @@ func (r *customModelResource) Read(ctx context.Context, request resource.ReadReq
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err))
 
 		return
 	}
@@ -397,7 +397,7 @@ func (r *customModelResource) Read(ctx context.Context, request resource.ReadReq
 		}
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", customModelARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model (%s)", customModelARN), err))
 
 			return
 		}
@@ -459,13 +459,13 @@ func (r *customModelResource) Delete(ctx context.Context, request resource.Delet
 		}
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("stopping Bedrock Custom Model customization job (%s)", jobARN), err))
 
 			return
 		}
 
 		if _, err := waitModelCustomizationJobStopped(ctx, conn, jobARN, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) stop", jobARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) stop", jobARN), err))
 
 			return
 		}
@@ -481,7 +481,7 @@ func (r *customModelResource) Delete(ctx context.Context, request resource.Delet
 		}
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Custom Model (%s)", data.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Custom Model (%s)", data.ID.ValueString()), err))
 
 			return
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -131,7 +132,7 @@ func (d *customModelDataSource) Read(ctx context.Context, request datasource.Rea
 	outputGM, err := findCustomModelByID(ctx, conn, modelID)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", modelID), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model (%s)", modelID), err))
 
 		return
 	}
@@ -140,7 +141,7 @@ func (d *customModelDataSource) Read(ctx context.Context, request datasource.Rea
 	outputGJ, err := findModelCustomizationJobByID(ctx, conn, jobARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err))
 
 		return
 	}
@@ -164,7 +165,7 @@ func (d *customModelDataSource) Read(ctx context.Context, request datasource.Rea
 	jobTags, err := listTags(ctx, conn, jobARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s) tags", jobARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model customization job (%s) tags", jobARN), err))
 
 		return
 	}
@@ -175,7 +176,7 @@ func (d *customModelDataSource) Read(ctx context.Context, request datasource.Rea
 	modelTags, err := listTags(ctx, conn, modelARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s) tags", modelARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Custom Model (%s) tags", modelARN), err))
 
 		return
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -68,7 +69,7 @@ func (d *customModelsDataSource) Read(ctx context.Context, request datasource.Re
 	output, err := conn.ListCustomModels(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("listing Bedrock Custom Models", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing Bedrock Custom Models", err))
 
 		return
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -95,7 +96,7 @@ func (d *foundationModelsDataSource) Read(ctx context.Context, request datasourc
 	output, err := conn.ListFoundationModels(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("listing Bedrock Foundation Models", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing Bedrock Foundation Models", err))
 
 		return
 	}
@@ -142,7 +142,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Read(ctx context.Context,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Model Invocation Logging Configuration (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Model Invocation Logging Configuration (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -182,7 +182,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Delete(ctx context.Context
 	_, err := conn.DeleteModelInvocationLoggingConfiguration(ctx, &bedrock.DeleteModelInvocationLoggingConfigurationInput{})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Model Invocation Logging Configuration (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Model Invocation Logging Configuration (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -209,7 +209,7 @@ func (r *resourceModelInvocationLoggingConfiguration) putModelInvocationLoggingC
 	)
 
 	if err != nil {
-		diags.AddError("putting Bedrock Model Invocation Logging Configuration", err.Error())
+		diags.Append(fwdiag.NewErrorDiagnostic("putting Bedrock Model Invocation Logging Configuration", err))
 
 		return diags
 	}
@@ -119,7 +119,7 @@ func (r *resourceProvisionedModelThroughput) Create(ctx context.Context, request
 	output, err := conn.CreateProvisionedModelThroughput(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Provisioned Model Throughput (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Bedrock Provisioned Model Throughput (%s)", name), err))
 
 		return
 	}
@@ -129,7 +129,7 @@ func (r *resourceProvisionedModelThroughput) Create(ctx context.Context, request
 	data.setID()
 
 	if _, err := waitProvisionedModelThroughputCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -145,7 +145,7 @@ func (r *resourceProvisionedModelThroughput) Read(ctx context.Context, request r
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -162,7 +162,7 @@ func (r *resourceProvisionedModelThroughput) Read(ctx context.Context, request r
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -193,7 +193,7 @@ func (r *resourceProvisionedModelThroughput) Delete(ctx context.Context, request
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err))
 
 		return
 	}
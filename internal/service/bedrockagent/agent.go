@@ -177,7 +177,7 @@ func (r *agentResource) Create(ctx context.Context, request resource.CreateReque
 	output, err := conn.CreateAgent(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Bedrock Agent", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Bedrock Agent", err))
 
 		return
 	}
@@ -189,7 +189,7 @@ func (r *agentResource) Create(ctx context.Context, request resource.CreateReque
 	agent, err := waitAgentCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Agent (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -198,7 +198,7 @@ func (r *agentResource) Create(ctx context.Context, request resource.CreateReque
 		agent, err = prepareAgent(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 		if err != nil {
-			response.Diagnostics.AddError("creating Agent", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Agent", err))
 
 			return
 		}
@@ -221,7 +221,7 @@ func (r *agentResource) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -239,7 +239,7 @@ func (r *agentResource) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent (%s)", agentID), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Agent (%s)", agentID), err))
 
 		return
 	}
@@ -298,7 +298,7 @@ func (r *agentResource) Update(ctx context.Context, request resource.UpdateReque
 		_, err := conn.UpdateAgent(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Bedrock Agent (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -306,7 +306,7 @@ func (r *agentResource) Update(ctx context.Context, request resource.UpdateReque
 		agent, err := waitAgentUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Agent (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -315,7 +315,7 @@ func (r *agentResource) Update(ctx context.Context, request resource.UpdateReque
 			agent, err = prepareAgent(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
 
 			if err != nil {
-				response.Diagnostics.AddError("updating Agent", err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("updating Agent", err))
 
 				return
 			}
@@ -352,13 +352,13 @@ func (r *agentResource) Delete(ctx context.Context, request resource.DeleteReque
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent (%s)", agentID), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Agent (%s)", agentID), err))
 
 		return
 	}
 
 	if _, err := waitAgentDeleted(ctx, conn, agentID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) delete", agentID), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Agent (%s) delete", agentID), err))
 
 		return
 	}
@@ -193,7 +193,7 @@ func (r *agentActionGroupResource) Create(ctx context.Context, request resource.
 	output, err := conn.CreateAgentActionGroup(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Bedrock Agent Action Group", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Bedrock Agent Action Group", err))
 
 		return
 	}
@@ -214,7 +214,7 @@ func (r *agentActionGroupResource) Read(ctx context.Context, request resource.Re
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -231,7 +231,7 @@ func (r *agentActionGroupResource) Read(ctx context.Context, request resource.Re
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Action Group (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Agent Action Group (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -297,7 +297,7 @@ func (r *agentActionGroupResource) Update(ctx context.Context, request resource.
 		_, err := conn.UpdateAgentActionGroup(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Action Group (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Bedrock Agent Action Group (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -305,7 +305,7 @@ func (r *agentActionGroupResource) Update(ctx context.Context, request resource.
 
 	output, err := findAgentActionGroupByThreePartKey(ctx, conn, new.ActionGroupID.ValueString(), new.AgentID.ValueString(), new.AgentVersion.ValueString())
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Action Group (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Agent Action Group (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -336,7 +336,7 @@ func (r *agentActionGroupResource) Delete(ctx context.Context, request resource.
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Action Group (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Agent Action Group (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -130,7 +130,7 @@ func (r *agentAliasResource) Create(ctx context.Context, request resource.Create
 	output, err := conn.CreateAgentAlias(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Bedrock Agent Alias", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Bedrock Agent Alias", err))
 
 		return
 	}
@@ -142,13 +142,13 @@ func (r *agentAliasResource) Create(ctx context.Context, request resource.Create
 	alias, err := waitAgentAliasCreated(ctx, conn, data.AgentAliasID.ValueString(), data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Alias (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Agent Alias (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err := waitAgentVersioned(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) version", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Agent (%s) version", data.ID.ValueString()), err))
 
 		return
 	}
@@ -170,7 +170,7 @@ func (r *agentAliasResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -187,7 +187,7 @@ func (r *agentAliasResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Alias (%s)", data.ID.String()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Agent Alias (%s)", data.ID.String()), err))
 
 		return
 	}
@@ -225,13 +225,13 @@ func (r *agentAliasResource) Update(ctx context.Context, request resource.Update
 		_, err := conn.UpdateAgentAlias(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Alias (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Agent Alias (%s)", new.ID.String()), err))
 
 			return
 		}
 
 		if _, err := waitAgentAliasUpdated(ctx, conn, new.AgentAliasID.ValueString(), new.AgentID.ValueString(), r.CreateTimeout(ctx, new.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Alias (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Bedrock Agent Alias (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -259,7 +259,7 @@ func (r *agentAliasResource) Delete(ctx context.Context, request resource.Delete
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Alias (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Agent Alias (%s)", data.ID.ValueString()), err))
 
 		return
 	}
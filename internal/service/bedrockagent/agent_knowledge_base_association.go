@@ -104,7 +104,7 @@ func (r *agentKnowledgeBaseAssociationResource) Create(ctx context.Context, requ
 	_, err := conn.AssociateAgentKnowledgeBase(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Bedrock Agent Knowledge Base Association", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Bedrock Agent Knowledge Base Association", err))
 		return
 	}
 
@@ -122,7 +122,7 @@ func (r *agentKnowledgeBaseAssociationResource) Read(ctx context.Context, reques
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -139,7 +139,7 @@ func (r *agentKnowledgeBaseAssociationResource) Read(ctx context.Context, reques
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Knowledge Base Association (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Bedrock Agent Knowledge Base Association (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -174,7 +174,7 @@ func (r *agentKnowledgeBaseAssociationResource) Update(ctx context.Context, requ
 	_, err := conn.UpdateAgentKnowledgeBase(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Knowledge Base Association (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Bedrock Agent Knowledge Base Association (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -202,7 +202,7 @@ func (r *agentKnowledgeBaseAssociationResource) Delete(ctx context.Context, requ
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Knowledge Base Association (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Bedrock Agent Knowledge Base Association (%s)", data.ID.ValueString()), err))
 
 		return
 	}
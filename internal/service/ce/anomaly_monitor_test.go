@@ -190,6 +190,24 @@ func TestAccCEAnomalyMonitor_Dimensional(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalyMonitor_dimensionalMissingDimension(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorConfig_dimensionalMissingDimension(rName),
+				ExpectError: regexache.MustCompile(`"monitor_dimension" is required when "monitor_type" is DIMENSIONAL`),
+			},
+		},
+	})
+}
+
 func testAccCheckAnomalyMonitorExists(ctx context.Context, n string, v *awstypes.AnomalyMonitor) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CEClient(ctx)
@@ -333,3 +351,12 @@ resource "aws_ce_anomaly_monitor" "test" {
 }
 `, rName)
 }
+
+func testAccAnomalyMonitorConfig_dimensionalMissingDimension(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "DIMENSIONAL"
+}
+`, rName)
+}
@@ -5,13 +5,18 @@ package ce
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strconv"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -95,16 +100,43 @@ func resourceAnomalySubscription() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"threshold": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"threshold_expression"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"total_impact_absolute": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							AtLeastOneOf: []string{"threshold.0.total_impact_absolute", "threshold.0.total_impact_percentage"},
+						},
+						"total_impact_percentage": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							AtLeastOneOf: []string{"threshold.0.total_impact_absolute", "threshold.0.total_impact_percentage"},
+						},
+					},
+				},
+			},
 			"threshold_expression": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Computed: true,
-				Optional: true,
-				Elem:     expressionElem(anomalySubscriptionRootElementSchemaLevel),
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"threshold"},
+				Elem:          expressionElem(anomalySubscriptionRootElementSchemaLevel),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAnomalySubscriptionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -127,7 +159,9 @@ func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 		input.AnomalySubscription.AccountId = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("threshold_expression"); ok {
+	if v, ok := d.GetOk("threshold"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnomalySubscription.ThresholdExpression = expandAnomalySubscriptionThreshold(v.([]interface{})[0].(map[string]interface{}))
+	} else if v, ok := d.GetOk("threshold_expression"); ok {
 		input.AnomalySubscription.ThresholdExpression = expandExpression(v.([]interface{})[0].(map[string]interface{}))
 	}
 
@@ -164,6 +198,9 @@ func resourceAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData
 	d.Set("monitor_arn_list", subscription.MonitorArnList)
 	d.Set(names.AttrName, subscription.SubscriptionName)
 	d.Set("subscriber", flattenSubscribers(subscription.Subscribers))
+	if err := d.Set("threshold", flattenAnomalySubscriptionThreshold(subscription.ThresholdExpression)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting threshold: %s", err)
+	}
 	if err := d.Set("threshold_expression", []interface{}{flattenExpression(subscription.ThresholdExpression)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting threshold_expression: %s", err)
 	}
@@ -193,7 +230,9 @@ func resourceAnomalySubscriptionUpdate(ctx context.Context, d *schema.ResourceDa
 			input.Subscribers = expandSubscribers(d.Get("subscriber").(*schema.Set).List())
 		}
 
-		if d.HasChange("threshold_expression") {
+		if v, ok := d.GetOk("threshold"); ok && d.HasChange("threshold") && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThresholdExpression = expandAnomalySubscriptionThreshold(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("threshold_expression") {
 			input.ThresholdExpression = expandExpression(d.Get("threshold_expression").([]interface{})[0].(map[string]interface{}))
 		}
 
@@ -227,6 +266,85 @@ func resourceAnomalySubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourceAnomalySubscriptionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Keep the other threshold representation in sync with the one that is configured.
+	if v := d.GetRawConfig().GetAttr("threshold"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v, ok := d.GetOk("threshold"); ok && d.NewValueKnown("threshold") && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if expandAnomalySubscriptionThreshold(v.([]interface{})[0].(map[string]interface{})) == nil {
+				return errors.New("threshold: at least one of total_impact_absolute or total_impact_percentage must be greater than 0")
+			}
+		}
+
+		if d.HasChange("threshold") {
+			if err := d.SetNewComputed("threshold_expression"); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("threshold_expression"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if !d.NewValueKnown("threshold_expression") {
+			return nil
+		}
+
+		if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := validateAnomalySubscriptionThresholdExpression(expandExpression(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+				return fmt.Errorf("threshold_expression: %w", err)
+			}
+		}
+
+		if d.HasChange("threshold_expression") {
+			if err := d.SetNewComputed("threshold"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAnomalySubscriptionThresholdExpression checks that every leaf of a threshold expression is a
+// total impact dimension with a single numeric value, the only form accepted by the API.
+func validateAnomalySubscriptionThresholdExpression(apiObject *awstypes.Expression) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	if apiObject.CostCategories != nil || apiObject.Tags != nil {
+		return errors.New("only dimension expressions are supported")
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		switch key := v.Key; key {
+		case awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage:
+		default:
+			return fmt.Errorf("dimension key %q is not supported, must be one of %s or %s", key, awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage)
+		}
+
+		if len(v.MatchOptions) != 1 || v.MatchOptions[0] != awstypes.MatchOptionGreaterThanOrEqual {
+			return fmt.Errorf("dimension %s match_options must be [%s]", v.Key, awstypes.MatchOptionGreaterThanOrEqual)
+		}
+
+		if len(v.Values) != 1 {
+			return fmt.Errorf("dimension %s must have exactly one value", v.Key)
+		}
+
+		if _, err := strconv.ParseFloat(v.Values[0], 64); err != nil {
+			return fmt.Errorf("dimension %s value %q is not a number", v.Key, v.Values[0])
+		}
+	}
+
+	for _, v := range slices.Concat(apiObject.And, apiObject.Or) {
+		if err := validateAnomalySubscriptionThresholdExpression(&v); err != nil {
+			return err
+		}
+	}
+
+	return validateAnomalySubscriptionThresholdExpression(apiObject.Not)
+}
+
 func findAnomalySubscriptionByARN(ctx context.Context, conn *costexplorer.Client, arn string) (*awstypes.AnomalySubscription, error) {
 	input := &costexplorer.GetAnomalySubscriptionsInput{
 		SubscriptionArnList: []string{arn},
@@ -291,3 +409,82 @@ func flattenSubscribers(apiObjects []awstypes.Subscriber) []interface{} {
 
 	return tfList
 }
+
+func expandAnomalySubscriptionThreshold(tfMap map[string]interface{}) *awstypes.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	var apiObjects []awstypes.Expression
+
+	for _, v := range []struct {
+		key       string
+		dimension awstypes.Dimension
+	}{
+		{"total_impact_absolute", awstypes.DimensionAnomalyTotalImpactAbsolute},
+		{"total_impact_percentage", awstypes.DimensionAnomalyTotalImpactPercentage},
+	} {
+		if value, ok := tfMap[v.key].(float64); ok && value > 0 {
+			apiObjects = append(apiObjects, awstypes.Expression{
+				Dimensions: &awstypes.DimensionValues{
+					Key:          v.dimension,
+					MatchOptions: []awstypes.MatchOption{awstypes.MatchOptionGreaterThanOrEqual},
+					Values:       []string{strconv.FormatFloat(value, 'f', -1, 64)},
+				},
+			})
+		}
+	}
+
+	switch len(apiObjects) {
+	case 0:
+		return nil
+	case 1:
+		return &apiObjects[0]
+	default:
+		return &awstypes.Expression{
+			And: apiObjects,
+		}
+	}
+}
+
+// flattenAnomalySubscriptionThreshold returns the typed representation of a threshold expression,
+// or nil if the expression can't be represented that way.
+func flattenAnomalySubscriptionThreshold(apiObject *awstypes.Expression) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	apiObjects := apiObject.And
+	if len(apiObjects) == 0 {
+		apiObjects = []awstypes.Expression{*apiObject}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for _, v := range apiObjects {
+		dimension := v.Dimensions
+		if dimension == nil || v.And != nil || v.Or != nil || v.Not != nil || v.CostCategories != nil || v.Tags != nil {
+			return nil
+		}
+
+		if len(dimension.MatchOptions) != 1 || dimension.MatchOptions[0] != awstypes.MatchOptionGreaterThanOrEqual || len(dimension.Values) != 1 {
+			return nil
+		}
+
+		value, err := strconv.ParseFloat(dimension.Values[0], 64)
+		if err != nil {
+			return nil
+		}
+
+		switch dimension.Key {
+		case awstypes.DimensionAnomalyTotalImpactAbsolute:
+			tfMap["total_impact_absolute"] = value
+		case awstypes.DimensionAnomalyTotalImpactPercentage:
+			tfMap["total_impact_percentage"] = value
+		default:
+			return nil
+		}
+	}
+
+	return []interface{}{tfMap}
+}
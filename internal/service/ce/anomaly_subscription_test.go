@@ -274,6 +274,69 @@ func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_Threshold(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription awstypes.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig_threshold1(rName, address, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "threshold.0.total_impact_absolute", "100"),
+					resource.TestCheckResourceAttr(resourceName, "threshold.0.total_impact_percentage", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", "ANOMALY_TOTAL_IMPACT_ABSOLUTE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_threshold2(rName, address, 200, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "threshold.0.total_impact_absolute", "200"),
+					resource.TestCheckResourceAttr(resourceName, "threshold.0.total_impact_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_thresholdExpressionInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpressionInvalid(rName, address),
+				ExpectError: regexache.MustCompile(`dimension key "SERVICE" is not supported`),
+			},
+		},
+	})
+}
+
 func testAccCheckAnomalySubscriptionExists(ctx context.Context, n string, v *awstypes.AnomalySubscription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -640,3 +703,74 @@ resource "aws_ce_anomaly_subscription" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2, address))
 }
+
+func testAccAnomalySubscriptionConfig_threshold1(rName, address string, absolute int) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold {
+    total_impact_absolute = %[3]d
+  }
+}
+`, rName, address, absolute))
+}
+
+func testAccAnomalySubscriptionConfig_threshold2(rName, address string, absolute, percentage int) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold {
+    total_impact_absolute   = %[3]d
+    total_impact_percentage = %[4]d
+  }
+}
+`, rName, address, absolute, percentage))
+}
+
+func testAccAnomalySubscriptionConfig_thresholdExpressionInvalid(rName, address string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "SERVICE"
+      values        = ["100.0"]
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+    }
+  }
+}
+`, rName, address))
+}
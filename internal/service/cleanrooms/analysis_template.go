@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_analysis_template")
+// @Tags(identifierAttribute="arn")
+func ResourceAnalysisTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnalysisTemplateCreate,
+		ReadWithoutTimeout:   resourceAnalysisTemplateRead,
+		UpdateWithoutTimeout: resourceAnalysisTemplateUpdate,
+		DeleteWithoutTimeout: resourceAnalysisTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDefaultValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.ParameterType](),
+						},
+					},
+				},
+			},
+			"analysis_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AnalysisFormat](),
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrSchema: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"referenced_tables": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameAnalysisTemplate = "Analysis Template"
+)
+
+func resourceAnalysisTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateAnalysisTemplateInput{
+		Format:               types.AnalysisFormat(d.Get(names.AttrFormat).(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		Name:                 aws.String(name),
+		Source:               expandAnalysisSource(d.Get(names.AttrSource).([]interface{})),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("analysis_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.AnalysisParameters = expandAnalysisParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateAnalysisTemplate(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, name, err)
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, name, errors.New("empty output"))
+	}
+	d.SetId(membershipResourceCreateResourceID(membershipID, aws.ToString(out.AnalysisTemplate.Id)))
+
+	return append(diags, resourceAnalysisTemplateRead(ctx, d, meta)...)
+}
+
+func resourceAnalysisTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, analysisTemplateID, err := membershipResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := findAnalysisTemplateByTwoPartKey(ctx, conn, membershipID, analysisTemplateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Analysis Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	analysisTemplate := out.AnalysisTemplate
+	if err := d.Set("analysis_parameters", flattenAnalysisParameters(analysisTemplate.AnalysisParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_parameters: %s", err)
+	}
+	d.Set("analysis_template_id", analysisTemplate.Id)
+	d.Set(names.AttrARN, analysisTemplate.Arn)
+	d.Set("collaboration_arn", analysisTemplate.CollaborationArn)
+	d.Set("collaboration_id", analysisTemplate.CollaborationId)
+	d.Set(names.AttrCreateTime, analysisTemplate.CreateTime.String())
+	d.Set(names.AttrDescription, analysisTemplate.Description)
+	d.Set(names.AttrFormat, analysisTemplate.Format)
+	d.Set("membership_arn", analysisTemplate.MembershipArn)
+	d.Set("membership_id", analysisTemplate.MembershipId)
+	d.Set(names.AttrName, analysisTemplate.Name)
+	if err := d.Set(names.AttrSchema, flattenAnalysisSchema(analysisTemplate.Schema)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schema: %s", err)
+	}
+	if err := d.Set(names.AttrSource, flattenAnalysisSource(analysisTemplate.Source)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	d.Set("update_time", analysisTemplate.UpdateTime.String())
+
+	return diags
+}
+
+func resourceAnalysisTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		membershipID, analysisTemplateID, err := membershipResourceParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: aws.String(analysisTemplateID),
+			Description:                aws.String(d.Get(names.AttrDescription).(string)),
+			MembershipIdentifier:       aws.String(membershipID),
+		}
+
+		_, err = conn.UpdateAnalysisTemplate(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnalysisTemplateRead(ctx, d, meta)...)
+}
+
+func resourceAnalysisTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, analysisTemplateID, err := membershipResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Analysis Template %s", d.Id())
+	_, err = conn.DeleteAnalysisTemplate(ctx, &cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(analysisTemplateID),
+		MembershipIdentifier:       aws.String(membershipID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, analysisTemplateID string) (*cleanrooms.GetAnalysisTemplateOutput, error) {
+	in := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(analysisTemplateID),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	out, err := conn.GetAnalysisTemplate(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+const membershipResourceIDPartCount = 2
+
+// membershipResourceCreateResourceID builds the ID of a resource that is scoped to a collaboration membership.
+func membershipResourceCreateResourceID(membershipID, resourceID string) string {
+	parts := []string{membershipID, resourceID}
+	id := errs.Must(flex.FlattenResourceId(parts, membershipResourceIDPartCount, false))
+
+	return id
+}
+
+func membershipResourceParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, membershipResourceIDPartCount, false)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandAnalysisSource(tfList []interface{}) types.AnalysisSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.AnalysisSourceMemberText{
+		Value: tfMap["text"].(string),
+	}
+}
+
+func flattenAnalysisSource(apiObject types.AnalysisSource) []interface{} {
+	switch v := apiObject.(type) {
+	case *types.AnalysisSourceMemberText:
+		m := map[string]interface{}{
+			"text": v.Value,
+		}
+		return []interface{}{m}
+	default:
+		return nil
+	}
+}
+
+func expandAnalysisParameters(tfList []interface{}) []types.AnalysisParameter {
+	var apiObjects []types.AnalysisParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AnalysisParameter{
+			Name: aws.String(tfMap[names.AttrName].(string)),
+			Type: types.ParameterType(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAnalysisParameters(apiObjects []types.AnalysisParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDefaultValue: aws.ToString(apiObject.DefaultValue),
+			names.AttrName:         aws.ToString(apiObject.Name),
+			names.AttrType:         apiObject.Type,
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisSchema(apiObject *types.AnalysisSchema) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"referenced_tables": apiObject.ReferencedTables,
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_audience_model_association")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredAudienceModelAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredAudienceModelAssociationCreate,
+		ReadWithoutTimeout:   resourceConfiguredAudienceModelAssociationRead,
+		UpdateWithoutTimeout: resourceConfiguredAudienceModelAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfiguredAudienceModelAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_audience_model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"configured_audience_model_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"manage_resource_policies": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameConfiguredAudienceModelAssociation = "Configured Audience Model Association"
+)
+
+func resourceConfiguredAudienceModelAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateConfiguredAudienceModelAssociationInput{
+		ConfiguredAudienceModelArn:             aws.String(d.Get("configured_audience_model_arn").(string)),
+		ConfiguredAudienceModelAssociationName: aws.String(name),
+		ManageResourcePolicies:                 aws.Bool(d.Get("manage_resource_policies").(bool)),
+		MembershipIdentifier:                   aws.String(membershipID),
+		Tags:                                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateConfiguredAudienceModelAssociation(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredAudienceModelAssociation, name, err)
+	}
+
+	if out == nil || out.ConfiguredAudienceModelAssociation == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredAudienceModelAssociation, name, errors.New("empty output"))
+	}
+	d.SetId(membershipResourceCreateResourceID(membershipID, aws.ToString(out.ConfiguredAudienceModelAssociation.Id)))
+
+	return append(diags, resourceConfiguredAudienceModelAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredAudienceModelAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, associationID, err := membershipResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := findConfiguredAudienceModelAssociationByTwoPartKey(ctx, conn, membershipID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Audience Model Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredAudienceModelAssociation, d.Id(), err)
+	}
+
+	association := out.ConfiguredAudienceModelAssociation
+	d.Set(names.AttrARN, association.Arn)
+	d.Set("collaboration_arn", association.CollaborationArn)
+	d.Set("collaboration_id", association.CollaborationId)
+	d.Set("configured_audience_model_arn", association.ConfiguredAudienceModelArn)
+	d.Set("configured_audience_model_association_id", association.Id)
+	d.Set(names.AttrCreateTime, association.CreateTime.String())
+	d.Set(names.AttrDescription, association.Description)
+	d.Set("manage_resource_policies", association.ManageResourcePolicies)
+	d.Set("membership_arn", association.MembershipArn)
+	d.Set("membership_id", association.MembershipId)
+	d.Set(names.AttrName, association.Name)
+	d.Set("update_time", association.UpdateTime.String())
+
+	return diags
+}
+
+func resourceConfiguredAudienceModelAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		membershipID, associationID, err := membershipResourceParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &cleanrooms.UpdateConfiguredAudienceModelAssociationInput{
+			ConfiguredAudienceModelAssociationIdentifier: aws.String(associationID),
+			MembershipIdentifier:                         aws.String(membershipID),
+		}
+
+		if d.HasChanges(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err = conn.UpdateConfiguredAudienceModelAssociation(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredAudienceModelAssociation, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfiguredAudienceModelAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredAudienceModelAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, associationID, err := membershipResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Audience Model Association %s", d.Id())
+	_, err = conn.DeleteConfiguredAudienceModelAssociation(ctx, &cleanrooms.DeleteConfiguredAudienceModelAssociationInput{
+		ConfiguredAudienceModelAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                         aws.String(membershipID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredAudienceModelAssociation, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredAudienceModelAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, associationID string) (*cleanrooms.GetConfiguredAudienceModelAssociationOutput, error) {
+	in := &cleanrooms.GetConfiguredAudienceModelAssociationInput{
+		ConfiguredAudienceModelAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                         aws.String(membershipID),
+	}
+
+	out, err := conn.GetConfiguredAudienceModelAssociation(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredAudienceModelAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
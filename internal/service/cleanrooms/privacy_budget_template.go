@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_privacy_budget_template")
+// @Tags(identifierAttribute="arn")
+func ResourcePrivacyBudgetTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePrivacyBudgetTemplateCreate,
+		ReadWithoutTimeout:   resourcePrivacyBudgetTemplateRead,
+		UpdateWithoutTimeout: resourcePrivacyBudgetTemplateUpdate,
+		DeleteWithoutTimeout: resourcePrivacyBudgetTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_refresh": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PrivacyBudgetTemplateAutoRefresh](),
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"epsilon": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 20),
+						},
+						"users_noise_per_query": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(10, 100),
+						},
+					},
+				},
+			},
+			"privacy_budget_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"privacy_budget_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PrivacyBudgetType](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNamePrivacyBudgetTemplate = "Privacy Budget Template"
+)
+
+func resourcePrivacyBudgetTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	input := &cleanrooms.CreatePrivacyBudgetTemplateInput{
+		AutoRefresh:          types.PrivacyBudgetTemplateAutoRefresh(d.Get("auto_refresh").(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		Parameters:           expandPrivacyBudgetTemplateParametersInput(d.Get(names.AttrParameters).([]interface{})),
+		PrivacyBudgetType:    types.PrivacyBudgetType(d.Get("privacy_budget_type").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	out, err := conn.CreatePrivacyBudgetTemplate(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, membershipID, err)
+	}
+
+	if out == nil || out.PrivacyBudgetTemplate == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, membershipID, errors.New("empty output"))
+	}
+	d.SetId(membershipResourceCreateResourceID(membershipID, aws.ToString(out.PrivacyBudgetTemplate.Id)))
+
+	return append(diags, resourcePrivacyBudgetTemplateRead(ctx, d, meta)...)
+}
+
+func resourcePrivacyBudgetTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, privacyBudgetTemplateID, err := membershipResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := findPrivacyBudgetTemplateByTwoPartKey(ctx, conn, membershipID, privacyBudgetTemplateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Privacy Budget Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNamePrivacyBudgetTemplate, d.Id(), err)
+	}
+
+	privacyBudgetTemplate := out.PrivacyBudgetTemplate
+	d.Set(names.AttrARN, privacyBudgetTemplate.Arn)
+	d.Set("auto_refresh", privacyBudgetTemplate.AutoRefresh)
+	d.Set("collaboration_arn", privacyBudgetTemplate.CollaborationArn)
+	d.Set("collaboration_id", privacyBudgetTemplate.CollaborationId)
+	d.Set(names.AttrCreateTime, privacyBudgetTemplate.CreateTime.String())
+	d.Set("membership_arn", privacyBudgetTemplate.MembershipArn)
+	d.Set("membership_id", privacyBudgetTemplate.MembershipId)
+	if err := d.Set(names.AttrParameters, flattenPrivacyBudgetTemplateParametersOutput(privacyBudgetTemplate.Parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+	d.Set("privacy_budget_template_id", privacyBudgetTemplate.Id)
+	d.Set("privacy_budget_type", privacyBudgetTemplate.PrivacyBudgetType)
+	d.Set("update_time", privacyBudgetTemplate.UpdateTime.String())
+
+	return diags
+}
+
+func resourcePrivacyBudgetTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		membershipID, privacyBudgetTemplateID, err := membershipResourceParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			MembershipIdentifier:            aws.String(membershipID),
+			Parameters:                      expandPrivacyBudgetTemplateUpdateParameters(d.Get(names.AttrParameters).([]interface{})),
+			PrivacyBudgetTemplateIdentifier: aws.String(privacyBudgetTemplateID),
+			PrivacyBudgetType:               types.PrivacyBudgetType(d.Get("privacy_budget_type").(string)),
+		}
+
+		_, err = conn.UpdatePrivacyBudgetTemplate(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNamePrivacyBudgetTemplate, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePrivacyBudgetTemplateRead(ctx, d, meta)...)
+}
+
+func resourcePrivacyBudgetTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, privacyBudgetTemplateID, err := membershipResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Privacy Budget Template %s", d.Id())
+	_, err = conn.DeletePrivacyBudgetTemplate(ctx, &cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(privacyBudgetTemplateID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNamePrivacyBudgetTemplate, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, privacyBudgetTemplateID string) (*cleanrooms.GetPrivacyBudgetTemplateOutput, error) {
+	in := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(privacyBudgetTemplateID),
+	}
+
+	out, err := conn.GetPrivacyBudgetTemplate(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandPrivacyBudgetTemplateParametersInput(tfList []interface{}) types.PrivacyBudgetTemplateParametersInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.PrivacyBudgetTemplateParametersInputMemberDifferentialPrivacy{
+		Value: types.DifferentialPrivacyTemplateParametersInput{
+			Epsilon:            aws.Int32(int32(tfMap["epsilon"].(int))),
+			UsersNoisePerQuery: aws.Int32(int32(tfMap["users_noise_per_query"].(int))),
+		},
+	}
+}
+
+func expandPrivacyBudgetTemplateUpdateParameters(tfList []interface{}) types.PrivacyBudgetTemplateUpdateParameters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.PrivacyBudgetTemplateUpdateParametersMemberDifferentialPrivacy{
+		Value: types.DifferentialPrivacyTemplateUpdateParameters{
+			Epsilon:            aws.Int32(int32(tfMap["epsilon"].(int))),
+			UsersNoisePerQuery: aws.Int32(int32(tfMap["users_noise_per_query"].(int))),
+		},
+	}
+}
+
+func flattenPrivacyBudgetTemplateParametersOutput(apiObject types.PrivacyBudgetTemplateParametersOutput) []interface{} {
+	switch v := apiObject.(type) {
+	case *types.PrivacyBudgetTemplateParametersOutputMemberDifferentialPrivacy:
+		m := map[string]interface{}{
+			"epsilon":               aws.ToInt32(v.Value.Epsilon),
+			"users_noise_per_query": aws.ToInt32(v.Value.UsersNoisePerQuery),
+		}
+		return []interface{}{m}
+	default:
+		return nil
+	}
+}
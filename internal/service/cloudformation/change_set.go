@@ -6,6 +6,9 @@ package cloudformation
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -82,3 +85,68 @@ func waitChangeSetCreated(ctx context.Context, conn *cloudformation.Client, stac
 
 	return nil, err
 }
+
+// previewChangeSet creates the specified change set, returns the resource changes it contains and deletes it.
+// Creating and reading the change set must complete within timeout.
+// If the change set can't be deleted it is left on the stack and only a warning is logged.
+func previewChangeSet(ctx context.Context, conn *cloudformation.Client, input *cloudformation.CreateChangeSetInput, timeout time.Duration) ([]awstypes.ResourceChange, error) {
+	// The deferred delete uses the parent context so that it still runs after a timeout.
+	previewCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output, err := conn.CreateChangeSet(previewCtx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating change set: %w", err)
+	}
+
+	stackID, changeSetID := aws.ToString(output.StackId), aws.ToString(output.Id)
+
+	defer func() {
+		_, err := conn.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+			ChangeSetName: aws.String(changeSetID),
+			StackName:     aws.String(stackID),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] deleting CloudFormation Change Set (%s): %s", changeSetID, err)
+		}
+	}()
+
+	changeSet, err := waitChangeSetCreated(previewCtx, conn, stackID, changeSetID)
+
+	// A change set that contains no changes fails to create.
+	if changeSet != nil && changeSet.Status == awstypes.ChangeSetStatusFailed && strings.Contains(aws.ToString(changeSet.StatusReason), "didn't contain changes") {
+		return []awstypes.ResourceChange{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for change set (%s) create: %w", changeSetID, err)
+	}
+
+	var changes []awstypes.ResourceChange
+
+	for {
+		for _, v := range changeSet.Changes {
+			if v.ResourceChange != nil {
+				changes = append(changes, *v.ResourceChange)
+			}
+		}
+
+		if aws.ToString(changeSet.NextToken) == "" {
+			break
+		}
+
+		changeSet, err = conn.DescribeChangeSet(previewCtx, &cloudformation.DescribeChangeSetInput{
+			ChangeSetName: aws.String(changeSetID),
+			NextToken:     changeSet.NextToken,
+			StackName:     aws.String(stackID),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading change set (%s): %w", changeSetID, err)
+		}
+	}
+
+	return changes, nil
+}
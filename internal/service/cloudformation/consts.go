@@ -9,4 +9,7 @@ import (
 
 const (
 	propagationTimeout = 2 * time.Minute
+
+	// stackPreviewChangesTimeout bounds the change set created during plan when preview_changes is enabled.
+	stackPreviewChangesTimeout = 5 * time.Minute
 )
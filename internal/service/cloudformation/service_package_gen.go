@@ -55,6 +55,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Stack Set",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  resourceStackSetDriftDetection,
+			TypeName: "aws_cloudformation_stack_set_drift_detection",
+			Name:     "Stack Set Drift Detection",
+		},
 		{
 			Factory:  resourceStackSetInstance,
 			TypeName: "aws_cloudformation_stack_set_instance",
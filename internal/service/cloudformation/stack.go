@@ -97,6 +97,34 @@ func resourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"policy_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -113,6 +141,11 @@ func resourceStack() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"preview_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"template_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -134,9 +167,10 @@ func resourceStack() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: customdiff.All(
+		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			stackPreviewChangesCustomizeDiff,
 		),
 	}
 }
@@ -268,6 +302,7 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set(names.AttrParameters, flattenParameters(stack.Parameters, d.Get(names.AttrParameters).(map[string]interface{}))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
+	// planned_changes is only set during plan and is kept as-is in state.
 	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
 
 	setTagsOut(ctx, stack.Tags)
@@ -672,6 +707,10 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.ForceNew {
 			continue
 		}
+		// Toggling the change set preview does not change the stack.
+		if k == "preview_changes" {
+			continue
+		}
 		if attr.Computed && !attr.Optional {
 			continue
 		}
@@ -690,3 +729,85 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// stackPreviewChangesCustomizeDiff creates a change set for a pending stack update,
+// records the resource-level changes it contains as the planned_changes attribute
+// and then deletes the change set.
+// planned_changes is plan-only data: the value from the most recent preview stays in
+// state until the next stack update, so that it agrees with the applied plan and
+// doesn't cause a diff on its own.
+func stackPreviewChangesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	if !d.Get("preview_changes").(bool) {
+		// Don't carry a previous preview forward into an update that isn't previewed.
+		if v, ok := d.Get("planned_changes").([]interface{}); ok && len(v) > 0 {
+			return d.SetNew("planned_changes", []interface{}{})
+		}
+
+		return nil
+	}
+
+	for _, k := range []string{"capabilities", names.AttrIAMRoleARN, names.AttrParameters, "template_body", "template_url"} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(id.PrefixedUniqueId("terraform-preview-")),
+		ChangeSetType: awstypes.ChangeSetTypeUpdate,
+		StackName:     aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+	}
+	if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if input.TemplateBody == nil && input.TemplateURL == nil {
+		input.UsePreviousTemplate = aws.Bool(true)
+	}
+
+	changes, err := previewChangeSet(ctx, conn, input, stackPreviewChangesTimeout)
+
+	if err != nil {
+		return fmt.Errorf("previewing CloudFormation Stack (%s) changes: %w", d.Id(), err)
+	}
+
+	return d.SetNew("planned_changes", flattenResourceChanges(changes))
+}
+
+func flattenResourceChanges(apiObjects []awstypes.ResourceChange) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:       apiObject.Action,
+			"logical_resource_id":  aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id": aws.ToString(apiObject.PhysicalResourceId),
+			"replacement":          apiObject.Replacement,
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
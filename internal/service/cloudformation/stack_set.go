@@ -65,7 +65,6 @@ func resourceStackSet() *schema.Resource {
 				MinItems: 1,
 				MaxItems: 1,
 				Optional: true,
-				ForceNew: true,
 				ConflictsWith: []string{
 					"administration_role_arn",
 					"execution_role_name",
@@ -139,6 +138,11 @@ func resourceStackSet() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"concurrency_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ConcurrencyMode](),
+						},
 						"failure_tolerance_count": {
 							Type:          schema.TypeInt,
 							Optional:      true,
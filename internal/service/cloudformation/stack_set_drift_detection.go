@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	stackSetDriftDetectionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_cloudformation_stack_set_drift_detection", name="Stack Set Drift Detection")
+func resourceStackSetDriftDetection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStackSetDriftDetectionCreate,
+		ReadWithoutTimeout:   resourceStackSetDriftDetectionRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"call_as": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.CallAsSelf,
+				ValidateDiagFunc: enum.Validate[awstypes.CallAs](),
+			},
+			"drift_detection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"in_progress_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"in_sync_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_drift_check_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"total_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceStackSetDriftDetectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	stackSetName := d.Get("stack_set_name").(string)
+	callAs := d.Get("call_as").(string)
+	input := &cloudformation.DetectStackSetDriftInput{
+		CallAs:       awstypes.CallAs(callAs),
+		OperationId:  aws.String(sdkid.UniqueId()),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	output, err := conn.DetectStackSetDrift(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet (%s) drift: %s", stackSetName, err)
+	}
+
+	operationID := aws.ToString(output.OperationId)
+	id := errs.Must(flex.FlattenResourceId([]string{stackSetName, operationID}, stackSetDriftDetectionResourceIDPartCount, false))
+	d.SetId(id)
+
+	if _, err := waitStackSetOperationSucceeded(ctx, conn, stackSetName, operationID, callAs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) drift detection (%s): %s", stackSetName, operationID, err)
+	}
+
+	return append(diags, resourceStackSetDriftDetectionRead(ctx, d, meta)...)
+}
+
+func resourceStackSetDriftDetectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), stackSetDriftDetectionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	stackSetName, operationID := parts[0], parts[1]
+	operation, err := findStackSetOperationByThreePartKey(ctx, conn, stackSetName, operationID, d.Get("call_as").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation StackSet Drift Detection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet Drift Detection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("operation_id", operation.OperationId)
+	d.Set("stack_set_name", stackSetName)
+
+	if v := operation.StackSetDriftDetectionDetails; v != nil {
+		d.Set("drift_detection_status", v.DriftDetectionStatus)
+		d.Set("drift_status", v.DriftStatus)
+		d.Set("drifted_stack_instances_count", v.DriftedStackInstancesCount)
+		d.Set("failed_stack_instances_count", v.FailedStackInstancesCount)
+		d.Set("in_progress_stack_instances_count", v.InProgressStackInstancesCount)
+		d.Set("in_sync_stack_instances_count", v.InSyncStackInstancesCount)
+		if v.LastDriftCheckTimestamp != nil {
+			d.Set("last_drift_check_timestamp", aws.ToTime(v.LastDriftCheckTimestamp).Format(time.RFC3339))
+		} else {
+			d.Set("last_drift_check_timestamp", nil)
+		}
+		d.Set("total_stack_instances_count", v.TotalStackInstancesCount)
+	}
+
+	return diags
+}
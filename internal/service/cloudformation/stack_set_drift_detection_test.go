@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationStackSetDriftDetection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set_drift_detection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetDriftDetectionConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "call_as", "SELF"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", "IN_SYNC"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_stack_instances_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "in_sync_stack_instances_count", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "last_drift_check_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", "aws_cloudformation_stack_set.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "total_stack_instances_count", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTriggers},
+			},
+			{
+				Config: testAccStackSetDriftDetectionConfig_basic(rName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", "IN_SYNC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackSetDriftDetectionConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_drift_detection" "test" {
+  stack_set_name = aws_cloudformation_stack_set_instance.test.stack_set_name
+
+  triggers = {
+    run = %[1]q
+  }
+}
+`, trigger))
+}
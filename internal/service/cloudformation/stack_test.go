@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -572,6 +573,56 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_previewChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_previewChanges(rName, "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "preview_changes", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, "12.0.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes").AtSliceIndex(0).AtMapKey(names.AttrAction), knownvalue.StringExact("Modify")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes").AtSliceIndex(0).AtMapKey("logical_resource_id"), knownvalue.StringExact("MyVPC")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes").AtSliceIndex(0).AtMapKey("replacement"), knownvalue.StringExact("True")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes").AtSliceIndex(0).AtMapKey(names.AttrResourceType), knownvalue.StringExact("AWS::EC2::VPC")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", "12.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.logical_resource_id", "MyVPC"),
+				),
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, "12.0.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1210,3 +1261,36 @@ output "stack_output" {
 }
 `, rName, name, value)
 }
+
+func testAccStackConfig_previewChanges(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  preview_changes = true
+
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"}
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidr)
+}
@@ -430,6 +430,9 @@ func expandOperationPreferences(tfMap map[string]interface{}) *awstypes.StackSet
 
 	apiObject := &awstypes.StackSetOperationPreferences{}
 
+	if v, ok := tfMap["concurrency_mode"].(string); ok && v != "" {
+		apiObject.ConcurrencyMode = awstypes.ConcurrencyMode(v)
+	}
 	if v, ok := tfMap["failure_tolerance_count"].(int); ok {
 		apiObject.FailureToleranceCount = aws.Int32(int32(v))
 	}
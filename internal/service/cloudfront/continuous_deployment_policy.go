@@ -163,7 +163,7 @@ func (r *continuousDeploymentPolicyResource) Create(ctx context.Context, request
 	output, err := conn.CreateContinuousDeploymentPolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating CloudFront Continuous Deployment Policy", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating CloudFront Continuous Deployment Policy", err))
 
 		return
 	}
@@ -195,7 +195,7 @@ func (r *continuousDeploymentPolicyResource) Read(ctx context.Context, request r
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Continuous Deployment Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading CloudFront Continuous Deployment Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -242,7 +242,7 @@ func (r *continuousDeploymentPolicyResource) Update(ctx context.Context, request
 		output, err := conn.UpdateContinuousDeploymentPolicy(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Continuous Deployment Policy (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating CloudFront Continuous Deployment Policy (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -271,7 +271,7 @@ func (r *continuousDeploymentPolicyResource) Delete(ctx context.Context, request
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError("deleting CloudFront Continuous Deployment Policy", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("deleting CloudFront Continuous Deployment Policy", err))
 	}
 
 	input := &cloudfront.DeleteContinuousDeploymentPolicyInput{
@@ -293,7 +293,7 @@ func (r *continuousDeploymentPolicyResource) Delete(ctx context.Context, request
 		}
 
 		if err != nil {
-			response.Diagnostics.AddError("deleting CloudFront Continuous Deployment Policy", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("deleting CloudFront Continuous Deployment Policy", err))
 		}
 
 		input.IfMatch = aws.String(etag)
@@ -306,7 +306,7 @@ func (r *continuousDeploymentPolicyResource) Delete(ctx context.Context, request
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront Continuous Deployment Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting CloudFront Continuous Deployment Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -106,7 +106,7 @@ func (r *keyValueStoreResource) Create(ctx context.Context, request resource.Cre
 	_, err := conn.CreateKeyValueStore(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Key Value Store (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating CloudFront Key Value Store (%s)", name), err))
 
 		return
 	}
@@ -117,7 +117,7 @@ func (r *keyValueStoreResource) Create(ctx context.Context, request resource.Cre
 	outputDKVS, err := waitKeyValueStoreCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Key Value Store (%s) create", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for CloudFront Key Value Store (%s) create", name), err))
 
 		return
 	}
@@ -142,7 +142,7 @@ func (r *keyValueStoreResource) Read(ctx context.Context, request resource.ReadR
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -159,7 +159,7 @@ func (r *keyValueStoreResource) Read(ctx context.Context, request resource.ReadR
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Key Value Store (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading CloudFront Key Value Store (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -207,7 +207,7 @@ func (r *keyValueStoreResource) Update(ctx context.Context, request resource.Upd
 	output, err := conn.UpdateKeyValueStore(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Key Value Store (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating CloudFront Key Value Store (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -251,7 +251,7 @@ func (r *keyValueStoreResource) Delete(ctx context.Context, request resource.Del
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront Key Value Store (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting CloudFront Key Value Store (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -95,7 +95,7 @@ func (r *keyResource) Create(ctx context.Context, request resource.CreateRequest
 	etag, err := findETagByARN(ctx, conn, kvsARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore ETag (%s)", kvsARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading CloudFront KeyValueStore ETag (%s)", kvsARN), err))
 
 		return
 	}
@@ -112,7 +112,7 @@ func (r *keyResource) Create(ctx context.Context, request resource.CreateRequest
 	output, err := conn.PutKey(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront KeyValueStore (%s) Key (%s)", kvsARN, data.Key.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating CloudFront KeyValueStore (%s) Key (%s)", kvsARN, data.Key.ValueString()), err))
 
 		return
 	}
@@ -132,7 +132,7 @@ func (r *keyResource) Read(ctx context.Context, request resource.ReadRequest, re
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -149,7 +149,7 @@ func (r *keyResource) Read(ctx context.Context, request resource.ReadRequest, re
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -188,7 +188,7 @@ func (r *keyResource) Update(ctx context.Context, request resource.UpdateRequest
 		etag, err := findETagByARN(ctx, conn, kvsARN)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore ETag (%s)", kvsARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading CloudFront KeyValueStore ETag (%s)", kvsARN), err))
 
 			return
 		}
@@ -205,7 +205,7 @@ func (r *keyResource) Update(ctx context.Context, request resource.UpdateRequest
 		output, err := conn.PutKey(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront KeyValueStore (%s) Key (%s)", kvsARN, new.Key.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating CloudFront KeyValueStore (%s) Key (%s)", kvsARN, new.Key.ValueString()), err))
 
 			return
 		}
@@ -237,7 +237,7 @@ func (r *keyResource) Delete(ctx context.Context, request resource.DeleteRequest
 	etag, err := findETagByARN(ctx, conn, kvsARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore ETag (%s)", kvsARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading CloudFront KeyValueStore ETag (%s)", kvsARN), err))
 
 		return
 	}
@@ -253,7 +253,7 @@ func (r *keyResource) Delete(ctx context.Context, request resource.DeleteRequest
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -5,14 +5,17 @@ package cloudtrail
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -261,7 +264,10 @@ func resourceTrail() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceTrailCustomizeDiff,
+		),
 	}
 }
 
@@ -738,6 +744,56 @@ func flattenEventSelectorDataResource(configured []types.DataResource) []map[str
 	return dataResources
 }
 
+func resourceTrailCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("is_multi_region_trail") && d.NewValueKnown("include_global_service_events") {
+		if d.Get("is_multi_region_trail").(bool) && !d.Get("include_global_service_events").(bool) {
+			return errors.New(`"include_global_service_events" must be true for multi-Region trails`)
+		}
+	}
+
+	if v, ok := d.GetOk("advanced_event_selector"); ok {
+		for _, aes := range expandAdvancedEventSelector(v.([]interface{})) {
+			if err := validateAdvancedEventSelector(aes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAdvancedEventSelector checks the field selector combinations that CloudTrail requires for network activity events.
+func validateAdvancedEventSelector(aes types.AdvancedEventSelector) error {
+	var networkActivity, eventSource, errorCode bool
+
+	for _, fs := range aes.FieldSelectors {
+		switch aws.ToString(fs.Field) {
+		case fieldErrorCode:
+			errorCode = true
+
+			for _, v := range fs.Equals {
+				if v != errorCodeVPCEAccessDenied {
+					return fmt.Errorf("advanced event selector (%s): %q field selector only supports %q", aws.ToString(aes.Name), fieldErrorCode, errorCodeVPCEAccessDenied)
+				}
+			}
+		case fieldEventCategory:
+			networkActivity = slices.Contains(fs.Equals, eventCategoryNetworkActivity)
+		case fieldEventSource:
+			eventSource = len(fs.Equals) > 0
+		}
+	}
+
+	if errorCode && !networkActivity {
+		return fmt.Errorf("advanced event selector (%s): %q field selector requires %q to equal %q", aws.ToString(aes.Name), fieldErrorCode, fieldEventCategory, eventCategoryNetworkActivity)
+	}
+
+	if networkActivity && !eventSource {
+		return fmt.Errorf("advanced event selector (%s): network activity events require an %q field selector with \"equals\"", aws.ToString(aes.Name), fieldEventSource)
+	}
+
+	return nil
+}
+
 func setAdvancedEventSelectors(ctx context.Context, conn *cloudtrail.Client, d *schema.ResourceData) error {
 	input := &cloudtrail.PutEventSelectorsInput{
 		AdvancedEventSelectors: expandAdvancedEventSelector(d.Get("advanced_event_selector").([]interface{})),
@@ -38,22 +38,24 @@ func TestAccCloudTrail_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Trail": {
-			acctest.CtBasic:         testAccTrail_basic,
-			"cloudwatch":            testAccTrail_cloudWatch,
-			"enableLogging":         testAccTrail_enableLogging,
-			"globalServiceEvents":   testAccTrail_globalServiceEvents,
-			"multiRegion":           testAccTrail_multiRegion,
-			"organization":          testAccTrail_organization,
-			"logValidation":         testAccTrail_logValidation,
-			"kmsKey":                testAccTrail_kmsKey,
-			"tags":                  testAccTrail_tags,
-			"eventSelector":         testAccTrail_eventSelector,
-			"eventSelectorDynamoDB": testAccTrail_eventSelectorDynamoDB,
-			"eventSelectorExclude":  testAccTrail_eventSelectorExclude,
-			"insightSelector":       testAccTrail_insightSelector,
-			"advancedEventSelector": testAccTrail_advancedEventSelector,
-			acctest.CtDisappears:    testAccTrail_disappears,
-			"migrateV0":             testAccTrail_migrateV0,
+			acctest.CtBasic:                        testAccTrail_basic,
+			"cloudwatch":                           testAccTrail_cloudWatch,
+			"enableLogging":                        testAccTrail_enableLogging,
+			"globalServiceEvents":                  testAccTrail_globalServiceEvents,
+			"globalServiceEventsMultiRegion":       testAccTrail_globalServiceEventsMultiRegion,
+			"multiRegion":                          testAccTrail_multiRegion,
+			"organization":                         testAccTrail_organization,
+			"logValidation":                        testAccTrail_logValidation,
+			"kmsKey":                               testAccTrail_kmsKey,
+			"tags":                                 testAccTrail_tags,
+			"eventSelector":                        testAccTrail_eventSelector,
+			"eventSelectorDynamoDB":                testAccTrail_eventSelectorDynamoDB,
+			"eventSelectorExclude":                 testAccTrail_eventSelectorExclude,
+			"insightSelector":                      testAccTrail_insightSelector,
+			"advancedEventSelector":                testAccTrail_advancedEventSelector,
+			"advancedEventSelectorNetworkActivity": testAccTrail_advancedEventSelectorNetworkActivity,
+			acctest.CtDisappears:                   testAccTrail_disappears,
+			"migrateV0":                            testAccTrail_migrateV0,
 		},
 	}
 
@@ -439,6 +441,24 @@ func testAccTrail_globalServiceEvents(t *testing.T) {
 	})
 }
 
+func testAccTrail_globalServiceEventsMultiRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudTrailConfig_globalServiceEventsMultiRegion(rName),
+				ExpectError: regexache.MustCompile(`"include_global_service_events" must be true for multi-Region trails`),
+			},
+		},
+	})
+}
+
 func testAccTrail_eventSelector(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -735,6 +755,55 @@ func testAccTrail_advancedEventSelector(t *testing.T) {
 	})
 }
 
+func testAccTrail_advancedEventSelectorNetworkActivity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var trail types.Trail
+	resourceName := "aws_cloudtrail.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudTrailConfig_advancedEventSelectorNetworkActivityNoEventSource(rName),
+				ExpectError: regexache.MustCompile(`network activity events require an "eventSource" field selector`),
+			},
+			{
+				Config: testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrailExists(ctx, resourceName, &trail),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.name", "vpceAccessDenied"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.field_selector.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						names.AttrField: "eventCategory",
+						"equals.#":      acctest.Ct1,
+						"equals.0":      "NetworkActivity",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						names.AttrField: "eventSource",
+						"equals.#":      acctest.Ct1,
+						"equals.0":      "s3.amazonaws.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						names.AttrField: "errorCode",
+						"equals.#":      acctest.Ct1,
+						"equals.0":      "VpceAccessDenied",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccTrail_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var trail types.Trail
@@ -1128,6 +1197,20 @@ resource "aws_cloudtrail" "test" {
 `, rName))
 }
 
+func testAccCloudTrailConfig_globalServiceEventsMultiRegion(rName string) string {
+	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name                          = %[1]q
+  s3_bucket_name                = aws_s3_bucket.test.id
+  include_global_service_events = false
+  is_multi_region_trail         = true
+}
+`, rName))
+}
+
 func testAccCloudTrailConfig_organization(rName string) string {
 	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
 resource "aws_cloudtrail" "test" {
@@ -1605,3 +1688,60 @@ resource "aws_s3_bucket" "test2" {
 }
 `, rName))
 }
+
+func testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName string) string {
+	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  advanced_event_selector {
+    name = "vpceAccessDenied"
+
+    field_selector {
+      field  = "eventCategory"
+      equals = ["NetworkActivity"]
+    }
+
+    field_selector {
+      field  = "eventSource"
+      equals = ["s3.amazonaws.com"]
+    }
+
+    field_selector {
+      field  = "errorCode"
+      equals = ["VpceAccessDenied"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCloudTrailConfig_advancedEventSelectorNetworkActivityNoEventSource(rName string) string {
+	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  advanced_event_selector {
+    name = "vpceAccessDenied"
+
+    field_selector {
+      field  = "eventCategory"
+      equals = ["NetworkActivity"]
+    }
+
+    field_selector {
+      field  = "errorCode"
+      equals = ["VpceAccessDenied"]
+    }
+  }
+}
+`, rName))
+}
@@ -22,6 +22,7 @@ func resourceType_Values() []string {
 }
 
 const (
+	fieldErrorCode     = "errorCode"
 	fieldEventCategory = "eventCategory"
 	fieldEventName     = "eventName"
 	fieldEventSource   = "eventSource"
@@ -32,6 +33,7 @@ const (
 
 func field_Values() []string {
 	return []string{
+		fieldErrorCode,
 		fieldEventCategory,
 		fieldEventName,
 		fieldEventSource,
@@ -41,6 +43,14 @@ func field_Values() []string {
 	}
 }
 
+const (
+	eventCategoryNetworkActivity = "NetworkActivity"
+)
+
+const (
+	errorCodeVPCEAccessDenied = "VpceAccessDenied"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudtrail_event_data_store_query", name="Event Data Store Query")
+func resourceEventDataStoreQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventDataStoreQueryCreate,
+		ReadWithoutTimeout:   resourceEventDataStoreQueryRead,
+		DeleteWithoutTimeout: resourceEventDataStoreQueryDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bytes_scanned": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_s3_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"delivery_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"events_matched": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"events_scanned": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"execution_time_in_millis": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+			"query_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceEventDataStoreQueryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	input := &cloudtrail.StartQueryInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+	}
+
+	if v, ok := d.GetOk("delivery_s3_uri"); ok {
+		input.DeliveryS3Uri = aws.String(v.(string))
+	}
+
+	output, err := conn.StartQuery(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CloudTrail Event Data Store Query: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.QueryId))
+
+	if _, err := waitEventDataStoreQueryFinished(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store Query (%s) finish: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEventDataStoreQueryRead(ctx, d, meta)...)
+}
+
+func resourceEventDataStoreQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findEventDataStoreQueryByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Event Data Store Query (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Event Data Store Query (%s): %s", d.Id(), err)
+	}
+
+	d.Set("delivery_s3_uri", output.DeliveryS3Uri)
+	d.Set("delivery_status", output.DeliveryStatus)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("query_statement", output.QueryString)
+	d.Set("query_status", output.QueryStatus)
+	if v := output.QueryStatistics; v != nil {
+		d.Set("bytes_scanned", v.BytesScanned)
+		if v.CreationTime != nil {
+			d.Set(names.AttrCreationTime, aws.ToTime(v.CreationTime).Format(time.RFC3339))
+		} else {
+			d.Set(names.AttrCreationTime, nil)
+		}
+		d.Set("events_matched", v.EventsMatched)
+		d.Set("events_scanned", v.EventsScanned)
+		d.Set("execution_time_in_millis", v.ExecutionTimeInMillis)
+	}
+
+	return diags
+}
+
+func resourceEventDataStoreQueryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findEventDataStoreQueryByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Event Data Store Query (%s): %s", d.Id(), err)
+	}
+
+	// Completed queries are retained by CloudTrail Lake and can't be deleted.
+	switch output.QueryStatus {
+	case types.QueryStatusQueued, types.QueryStatusRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling CloudTrail Event Data Store Query: %s", d.Id())
+	_, err = conn.CancelQuery(ctx, &cloudtrail.CancelQueryInput{
+		QueryId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.QueryIdNotFoundException](err) || errs.IsA[*types.InactiveQueryException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling CloudTrail Event Data Store Query (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEventDataStoreQueryByID(ctx context.Context, conn *cloudtrail.Client, id string) (*cloudtrail.DescribeQueryOutput, error) {
+	input := &cloudtrail.DescribeQueryInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.DescribeQuery(ctx, input)
+
+	if errs.IsA[*types.QueryIdNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEventDataStoreQuery(ctx context.Context, conn *cloudtrail.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEventDataStoreQueryByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.QueryStatus), nil
+	}
+}
+
+func waitEventDataStoreQueryFinished(ctx context.Context, conn *cloudtrail.Client, id string, timeout time.Duration) (*cloudtrail.DescribeQueryOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.QueryStatusQueued, types.QueryStatusRunning),
+		Target:  enum.Slice(types.QueryStatusFinished),
+		Refresh: statusEventDataStoreQuery(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.DescribeQueryOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -5,10 +5,12 @@ package cloudtrail
 
 // Exports for use in tests only.
 var (
-	ResourceEventDataStore = resourceEventDataStore
-	ResourceTrail          = resourceTrail
+	ResourceEventDataStore      = resourceEventDataStore
+	ResourceEventDataStoreQuery = resourceEventDataStoreQuery
+	ResourceTrail               = resourceTrail
 
-	FindEventDataStoreByARN    = findEventDataStoreByARN
-	FindTrailByARN             = findTrailByARN
-	ServiceAccountPerRegionMap = serviceAccountPerRegionMap
+	FindEventDataStoreByARN     = findEventDataStoreByARN
+	FindEventDataStoreQueryByID = findEventDataStoreQueryByID
+	FindTrailByARN              = findTrailByARN
+	ServiceAccountPerRegionMap  = serviceAccountPerRegionMap
 )
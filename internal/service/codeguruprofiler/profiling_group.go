@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -116,10 +117,10 @@ func (r *resourceProfilingGroup) Create(ctx context.Context, req resource.Create
 
 	out, err := conn.CreateProfilingGroup(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameProfilingGroup, plan.Name.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.ProfilingGroup == nil {
@@ -154,10 +155,10 @@ func (r *resourceProfilingGroup) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameProfilingGroup, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -194,10 +195,10 @@ func (r *resourceProfilingGroup) Update(ctx context.Context, req resource.Update
 		in.ProfilingGroupName = flex.StringFromFramework(ctx, state.ID)
 		out, err := conn.UpdateProfilingGroup(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionUpdating, ResNameProfilingGroup, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -233,10 +234,10 @@ func (r *resourceProfilingGroup) Delete(ctx context.Context, req resource.Delete
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionDeleting, ResNameProfilingGroup, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -369,10 +370,10 @@ func (r *managedUserPoolClientResource) Create(ctx context.Context, request reso
 
 	poolClient, err := FindCognitoUserPoolClientByName(ctx, conn, userPoolId, nameMatcher)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			"acquiring Cognito User Pool Client",
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -493,10 +494,10 @@ func (r *managedUserPoolClientResource) Create(ctx context.Context, request reso
 			return conn.UpdateUserPoolClientWithContext(ctx, params)
 		}, cognitoidentityprovider.ErrCodeConcurrentModificationException)
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				fmt.Sprintf("updating Cognito User Pool Client (%s)", plan.ID.ValueString()),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -626,10 +627,10 @@ func (r *managedUserPoolClientResource) Update(ctx context.Context, request reso
 		return conn.UpdateUserPoolClientWithContext(ctx, params)
 	}, cognitoidentityprovider.ErrCodeConcurrentModificationException)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("updating Cognito User Pool Client (%s)", plan.ID.ValueString()),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -73,10 +74,10 @@ func (d *userGroupDataSource) Read(ctx context.Context, request datasource.ReadR
 	partCount := 2
 	id, err := intflex.FlattenResourceId(parts, partCount, false)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionFlatteningResourceId, DSNameUserGroup, data.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	data.ID = types.StringValue(id)
@@ -90,10 +91,10 @@ func (d *userGroupDataSource) Read(ctx context.Context, request datasource.ReadR
 	conn := d.Meta().CognitoIDPConn(ctx)
 	resp, err := conn.GetGroupWithContext(ctx, params)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionReading, DSNameUserGroup, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
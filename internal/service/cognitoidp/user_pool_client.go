@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -354,10 +355,10 @@ func (r *userPoolClientResource) Create(ctx context.Context, request resource.Cr
 
 	resp, err := conn.CreateUserPoolClientWithContext(ctx, params)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("creating Cognito User Pool Client (%s)", plan.Name.ValueString()),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -486,10 +487,10 @@ func (r *userPoolClientResource) Update(ctx context.Context, request resource.Up
 		return conn.UpdateUserPoolClientWithContext(ctx, params)
 	}, cognitoidentityprovider.ErrCodeConcurrentModificationException)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("updating Cognito User Pool Client (%s)", plan.ID.ValueString()),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -553,10 +554,10 @@ func (r *userPoolClientResource) Delete(ctx context.Context, request resource.De
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			fmt.Sprintf("deleting Cognito User Pool Client (%s)", state.ID.ValueString()),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -168,7 +169,11 @@ func resourceOrganizationConformancePackCreate(ctx context.Context, d *schema.Re
 	d.SetId(name)
 
 	if _, err := waitOrganizationConformancePackCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Conformance Pack (%s) create: %s", d.Id(), err)
+		return append(diags, organizationConformancePackDeploymentDiagnostic(ctx, conn, d.Id(), "create", err))
+	}
+
+	if _, err := waitOrganizationConformancePackAccountsDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return append(diags, organizationConformancePackDeploymentDiagnostic(ctx, conn, d.Id(), "create", err))
 	}
 
 	return append(diags, resourceOrganizationConformancePackRead(ctx, d, meta)...)
@@ -218,9 +223,8 @@ func resourceOrganizationConformancePackUpdate(ctx context.Context, d *schema.Re
 		input.DeliveryS3KeyPrefix = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("excluded_accounts"); ok {
-		input.ExcludedAccounts = flex.ExpandStringValueSet(v.(*schema.Set))
-	}
+	// Always send the excluded accounts so that removing all exclusions redeploys the pack to those accounts.
+	input.ExcludedAccounts = flex.ExpandStringValueEmptySet(d.Get("excluded_accounts").(*schema.Set))
 
 	if v, ok := d.GetOk("input_parameter"); ok {
 		input.ConformancePackInputParameters = expandConformancePackInputParameters(v.(*schema.Set).List())
@@ -241,7 +245,11 @@ func resourceOrganizationConformancePackUpdate(ctx context.Context, d *schema.Re
 	}
 
 	if _, err := waitOrganizationConformancePackUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Conformance Pack (%s) update: %s", d.Id(), err)
+		return append(diags, organizationConformancePackDeploymentDiagnostic(ctx, conn, d.Id(), "update", err))
+	}
+
+	if _, err := waitOrganizationConformancePackAccountsDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return append(diags, organizationConformancePackDeploymentDiagnostic(ctx, conn, d.Id(), "update", err))
 	}
 
 	return append(diags, resourceOrganizationConformancePackRead(ctx, d, meta)...)
@@ -396,6 +404,14 @@ func findOrganizationConformancePackDetailedStatusesByTwoPartKey(ctx context.Con
 	return findOrganizationConformancePackDetailedStatuses(ctx, conn, input)
 }
 
+func findOrganizationConformancePackDetailedStatusesByName(ctx context.Context, conn *configservice.Client, name string) ([]types.OrganizationConformancePackDetailedStatus, error) {
+	input := &configservice.GetOrganizationConformancePackDetailedStatusInput{
+		OrganizationConformancePackName: aws.String(name),
+	}
+
+	return findOrganizationConformancePackDetailedStatuses(ctx, conn, input)
+}
+
 func findOrganizationConformancePackDetailedStatuses(ctx context.Context, conn *configservice.Client, input *configservice.GetOrganizationConformancePackDetailedStatusInput) ([]types.OrganizationConformancePackDetailedStatus, error) {
 	var output []types.OrganizationConformancePackDetailedStatus
 
@@ -443,6 +459,35 @@ func statusOrganizationConformancePack(ctx context.Context, conn *configservice.
 	}
 }
 
+const (
+	organizationConformancePackAccountsStatusComplete   = "COMPLETE"
+	organizationConformancePackAccountsStatusInProgress = "IN_PROGRESS"
+)
+
+// statusOrganizationConformancePackAccounts reports whether deployment to every member account has finished.
+func statusOrganizationConformancePackAccounts(ctx context.Context, conn *configservice.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOrganizationConformancePackDetailedStatusesByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output {
+			switch v.Status {
+			case types.OrganizationResourceDetailedStatusCreateInProgress, types.OrganizationResourceDetailedStatusUpdateInProgress:
+				return output, organizationConformancePackAccountsStatusInProgress, nil
+			}
+		}
+
+		return output, organizationConformancePackAccountsStatusComplete, nil
+	}
+}
+
 func waitOrganizationConformancePackCreated(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConformancePackStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        enum.Slice(types.OrganizationResourceStatusCreateInProgress),
@@ -505,6 +550,82 @@ func waitOrganizationConformancePackDeleted(ctx context.Context, conn *configser
 	return nil, err
 }
 
+func waitOrganizationConformancePackAccountsDeployed(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) ([]types.OrganizationConformancePackDetailedStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationConformancePackAccountsStatusInProgress},
+		Target:  []string{organizationConformancePackAccountsStatusComplete},
+		Refresh: statusOrganizationConformancePackAccounts(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]types.OrganizationConformancePackDetailedStatus); ok {
+		if err == nil {
+			if failed := organizationConformancePackFailedAccounts(output); len(failed) > 0 {
+				err = &organizationConformancePackDeploymentError{accounts: failed}
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func organizationConformancePackFailedAccounts(apiObjects []types.OrganizationConformancePackDetailedStatus) []types.OrganizationConformancePackDetailedStatus {
+	var failed []types.OrganizationConformancePackDetailedStatus
+
+	for _, v := range apiObjects {
+		switch v.Status {
+		case types.OrganizationResourceDetailedStatusCreateFailed, types.OrganizationResourceDetailedStatusUpdateFailed, types.OrganizationResourceDetailedStatusDeleteFailed:
+			failed = append(failed, v)
+		}
+	}
+
+	return failed
+}
+
+// organizationConformancePackDeploymentError reports the member accounts a conformance pack failed to deploy to.
+type organizationConformancePackDeploymentError struct {
+	accounts []types.OrganizationConformancePackDetailedStatus
+}
+
+func (e *organizationConformancePackDeploymentError) Error() string {
+	return fmt.Sprintf("deployment failed in %d member account(s)", len(e.accounts))
+}
+
+// organizationConformancePackDeploymentDiagnostic builds an error diagnostic for a failed create or update.
+// Per-account failures are listed one per line in the diagnostic's detail.
+func organizationConformancePackDeploymentDiagnostic(ctx context.Context, conn *configservice.Client, name, action string, err error) diag.Diagnostic {
+	var accounts []types.OrganizationConformancePackDetailedStatus
+	if deploymentErr, ok := errs.As[*organizationConformancePackDeploymentError](err); ok {
+		accounts = deploymentErr.accounts
+	} else if output, findErr := findOrganizationConformancePackDetailedStatusesByName(ctx, conn, name); findErr == nil {
+		accounts = organizationConformancePackFailedAccounts(output)
+	}
+
+	if len(accounts) == 0 {
+		return diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("waiting for ConfigService Organization Conformance Pack (%s) %s: %s", name, action, err),
+		}
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "The conformance pack failed to deploy to %d member account(s):\n", len(accounts))
+	for _, v := range accounts {
+		fmt.Fprintf(&detail, "\n  - Account ID (%s): %s: %s: %s", aws.ToString(v.AccountId), v.Status, aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("ConfigService Organization Conformance Pack (%s) %s failed in %d member account(s)", name, action, len(accounts)),
+		Detail:   detail.String(),
+	}
+}
+
 func organizationConformancePackStatusError(ctx context.Context, conn *configservice.Client, apiObject *types.OrganizationConformancePackStatus) error {
 	errs := []error{fmt.Errorf("%s: %s", aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage))}
 
@@ -83,7 +83,7 @@ func testAccOrganizationConformancePack_disappears(t *testing.T) {
 
 func testAccOrganizationConformancePack_excludedAccounts(t *testing.T) {
 	ctx := acctest.Context(t)
-	var pack types.OrganizationConformancePack
+	var before, after types.OrganizationConformancePack
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_conformance_pack.test"
 
@@ -96,7 +96,7 @@ func testAccOrganizationConformancePack_excludedAccounts(t *testing.T) {
 			{
 				Config: testAccOrganizationConformancePackConfig_excludedAccounts1(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckOrganizationConformancePackExists(ctx, resourceName, &pack),
+					testAccCheckOrganizationConformancePackExists(ctx, resourceName, &before),
 					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", acctest.Ct1),
 				),
 			},
@@ -109,7 +109,8 @@ func testAccOrganizationConformancePack_excludedAccounts(t *testing.T) {
 			{
 				Config: testAccOrganizationConformancePackConfig_excludedAccounts2(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckOrganizationConformancePackExists(ctx, resourceName, &pack),
+					testAccCheckOrganizationConformancePackExists(ctx, resourceName, &after),
+					testAccCheckOrganizationConformancePackNotRecreated(&before, &after),
 					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", acctest.Ct2),
 				),
 			},
@@ -122,7 +123,8 @@ func testAccOrganizationConformancePack_excludedAccounts(t *testing.T) {
 			{
 				Config: testAccOrganizationConformancePackConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckOrganizationConformancePackExists(ctx, resourceName, &pack),
+					testAccCheckOrganizationConformancePackExists(ctx, resourceName, &after),
+					testAccCheckOrganizationConformancePackNotRecreated(&before, &after),
 					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", acctest.Ct0),
 				),
 			},
@@ -488,6 +490,15 @@ func testAccCheckOrganizationConformancePackRecreated(before, after *types.Organ
 	}
 }
 
+func testAccCheckOrganizationConformancePackNotRecreated(before, after *types.OrganizationConformancePack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(before.OrganizationConformancePackArn) != aws.ToString(after.OrganizationConformancePackArn) {
+			return errors.New("ConfigService Organization Conformance Pack was recreated")
+		}
+		return nil
+	}
+}
+
 func testAccOrganizationConformancePackBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -76,7 +76,7 @@ func (r *retentionConfigurationResource) Create(ctx context.Context, request res
 	output, err := conn.PutRetentionConfiguration(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating ConfigService Retention Configuration", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating ConfigService Retention Configuration", err))
 
 		return
 	}
@@ -96,7 +96,7 @@ func (r *retentionConfigurationResource) Read(ctx context.Context, request resou
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -114,7 +114,7 @@ func (r *retentionConfigurationResource) Read(ctx context.Context, request resou
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading ConfigService Retention Configuration (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading ConfigService Retention Configuration (%s)", name), err))
 
 		return
 	}
@@ -140,7 +140,7 @@ func (r *retentionConfigurationResource) Update(ctx context.Context, request res
 	_, err := conn.PutRetentionConfiguration(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating ConfigService Retention Configuration (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating ConfigService Retention Configuration (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -167,7 +167,7 @@ func (r *retentionConfigurationResource) Delete(ctx context.Context, request res
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting ConfigService Retention Configuration (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting ConfigService Retention Configuration (%s)", name), err))
 
 		return
 	}
@@ -23,6 +23,7 @@ func TestAccConnect_serial(t *testing.T) {
 			acctest.CtBasic:      testAccContactFlow_basic,
 			acctest.CtDisappears: testAccContactFlow_disappears,
 			"filename":           testAccContactFlow_filename,
+			"invalidContent":     testAccContactFlow_invalidContent,
 			"dataSource_id":      testAccContactFlowDataSource_contactFlowID,
 			"dataSource_name":    testAccContactFlowDataSource_name,
 		},
@@ -33,6 +34,11 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":      testAccContactFlowModuleDataSource_contactFlowModuleID,
 			"dataSource_name":    testAccContactFlowModuleDataSource_name,
 		},
+		"EvaluationForm": {
+			acctest.CtBasic:      testAccEvaluationForm_basic,
+			acctest.CtDisappears: testAccEvaluationForm_disappears,
+			"activate":           testAccEvaluationForm_activate,
+		},
 		"HoursOfOperation": {
 			acctest.CtBasic:      testAccHoursOfOperation_basic,
 			acctest.CtDisappears: testAccHoursOfOperation_disappears,
@@ -58,6 +64,8 @@ func TestAccConnect_serial(t *testing.T) {
 			"S3Config_BucketName":                       testAccInstanceStorageConfig_S3Config_BucketName,
 			"S3Config_BucketPrefix":                     testAccInstanceStorageConfig_S3Config_BucketPrefix,
 			"S3Config_EncryptionConfig":                 testAccInstanceStorageConfig_S3Config_EncryptionConfig,
+			"resourceType":                              testAccInstanceStorageConfig_resourceType,
+			"invalidStorageType":                        testAccInstanceStorageConfig_invalidStorageType,
 			"dataSource_KinesisFirehoseConfig":          testAccInstanceStorageConfigDataSource_KinesisFirehoseConfig,
 			"dataSource_KinesisStreamConfig":            testAccInstanceStorageConfigDataSource_KinesisStreamConfig,
 			"dataSource_KinesisVideoStreamConfig":       testAccInstanceStorageConfigDataSource_KinesisVideoStreamConfig,
@@ -76,6 +84,10 @@ func TestAccConnect_serial(t *testing.T) {
 			"prefix":             testAccPhoneNumber_prefix,
 			"targetARN":          testAccPhoneNumber_targetARN,
 		},
+		"PredefinedAttribute": {
+			acctest.CtBasic:      testAccPredefinedAttribute_basic,
+			acctest.CtDisappears: testAccPredefinedAttribute_disappears,
+		},
 		"Prompt": {
 			"dataSource_name": testAccPromptDataSource_name,
 		},
@@ -143,6 +155,11 @@ func TestAccConnect_serial(t *testing.T) {
 			acctest.CtDisappears: testAccUserHierarchyStructure_disappears,
 			"dataSource_id":      testAccUserHierarchyStructureDataSource_instanceID,
 		},
+		"View": {
+			acctest.CtBasic:      testAccView_basic,
+			acctest.CtDisappears: testAccView_disappears,
+			"tags":               testAccView_updateTags,
+		},
 		"Vocabulary": {
 			acctest.CtBasic:      testAccVocabulary_basic,
 			acctest.CtDisappears: testAccVocabulary_disappears,
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +39,10 @@ func ResourceContactFlow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			contactFlowContentCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -293,3 +297,24 @@ func resourceContactFlowLoadFileContent(filename string) (string, error) {
 	}
 	return string(fileContent), nil
 }
+
+// contactFlowContentCustomizeDiff validates inline flow content at plan time so that
+// structural errors, such as transitions to unknown actions or module invocations
+// without a module ID, surface before the API rejects the flow.
+func contactFlowContentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange(names.AttrContent) || !d.NewValueKnown(names.AttrContent) {
+		return nil
+	}
+
+	if v, ok := d.GetOk("filename"); ok && v.(string) != "" {
+		return nil
+	}
+
+	if v, ok := d.GetOk(names.AttrContent); ok {
+		if err := validContactFlowContent(v.(string)); err != nil {
+			return fmt.Errorf("invalid %s: %w", names.AttrContent, err)
+		}
+	}
+
+	return nil
+}
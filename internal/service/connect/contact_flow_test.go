@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -148,6 +149,25 @@ func testAccContactFlow_disappears(t *testing.T) {
 	})
 }
 
+func testAccContactFlow_invalidContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContactFlowConfig_invalidContent(rName, rName2),
+				ExpectError: regexache.MustCompile(`transition to missing-action does not reference an action`),
+			},
+		},
+	})
+}
+
 func testAccCheckContactFlowExists(ctx context.Context, resourceName string, function *connect.DescribeContactFlowOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -289,3 +309,31 @@ resource "aws_connect_contact_flow" "test" {
 }
 `, rName2, label, filepath))
 }
+
+func testAccContactFlowConfig_invalidContent(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  type        = "CONTACT_FLOW"
+  content = jsonencode({
+    Version     = "2019-10-30"
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Actions = [
+      {
+        Identifier = "12345678-1234-1234-1234-123456789012"
+        Type       = "MessageParticipant"
+        Parameters = {
+          Text = "Hello"
+        }
+        Transitions = {
+          NextAction = "missing-action"
+        }
+      },
+    ]
+  })
+}
+`, rName2))
+}
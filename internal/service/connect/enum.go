@@ -71,3 +71,32 @@ func InstanceAttributeMapping() map[string]string {
 		//connect.InstanceAttributeTypeUseCustomTtsVoices:    "use_custom_tts_voices_enabled",
 	}
 }
+
+// Instance storage resource types missing from AWS Go SDK.
+const (
+	instanceStorageResourceTypeEmailMessages = "EMAIL_MESSAGES"
+)
+
+func instanceStorageResourceType_Values() []string {
+	return append(connect.InstanceStorageResourceType_Values(), instanceStorageResourceTypeEmailMessages)
+}
+
+// InstanceStorageResourceTypeStorageTypes returns the storage types that each instance storage resource type can be associated with.
+// See https://docs.aws.amazon.com/connect/latest/APIReference/API_AssociateInstanceStorageConfig.html.
+func InstanceStorageResourceTypeStorageTypes() map[string][]string {
+	return map[string][]string{
+		connect.InstanceStorageResourceTypeAgentEvents:                          {connect.StorageTypeKinesisStream, connect.StorageTypeKinesisFirehose},
+		connect.InstanceStorageResourceTypeAttachments:                          {connect.StorageTypeS3},
+		connect.InstanceStorageResourceTypeCallRecordings:                       {connect.StorageTypeS3},
+		connect.InstanceStorageResourceTypeChatTranscripts:                      {connect.StorageTypeS3},
+		connect.InstanceStorageResourceTypeContactEvaluations:                   {connect.StorageTypeS3},
+		connect.InstanceStorageResourceTypeContactTraceRecords:                  {connect.StorageTypeKinesisStream, connect.StorageTypeKinesisFirehose},
+		connect.InstanceStorageResourceTypeMediaStreams:                         {connect.StorageTypeKinesisVideoStream},
+		connect.InstanceStorageResourceTypeRealTimeContactAnalysisChatSegments:  {connect.StorageTypeKinesisStream},
+		connect.InstanceStorageResourceTypeRealTimeContactAnalysisSegments:      {connect.StorageTypeKinesisStream},
+		connect.InstanceStorageResourceTypeRealTimeContactAnalysisVoiceSegments: {connect.StorageTypeKinesisStream},
+		connect.InstanceStorageResourceTypeScheduledReports:                     {connect.StorageTypeS3},
+		connect.InstanceStorageResourceTypeScreenRecordings:                     {connect.StorageTypeS3},
+		instanceStorageResourceTypeEmailMessages:                                {connect.StorageTypeS3},
+	}
+}
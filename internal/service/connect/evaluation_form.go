@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_evaluation_form", name="Evaluation Form")
+// @Tags(identifierAttribute="arn")
+func ResourceEvaluationForm() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEvaluationFormCreate,
+		ReadWithoutTimeout:   resourceEvaluationFormRead,
+		UpdateWithoutTimeout: resourceEvaluationFormUpdate,
+		DeleteWithoutTimeout: resourceEvaluationFormDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"evaluation_form_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_form_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"scoring_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.EvaluationFormScoringMode_Values(), false),
+						},
+						names.AttrStatus: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.EvaluationFormScoringStatus_Values(), false),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connect.EvaluationFormVersionStatusDraft,
+				ValidateFunc: validation.StringInSlice(connect.EvaluationFormVersionStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceEvaluationFormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	title := d.Get("title").(string)
+
+	items, err := expandEvaluationFormItems(d.Get("items").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &connect.CreateEvaluationFormInput{
+		InstanceId:      aws.String(instanceID),
+		Items:           items,
+		ScoringStrategy: expandEvaluationFormScoringStrategy(d.Get("scoring_strategy").([]interface{})),
+		Title:           aws.String(title),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateEvaluationFormWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Evaluation Form (%s): %s", title, err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Evaluation Form (%s): empty output", title)
+	}
+
+	formID := aws.StringValue(output.EvaluationFormId)
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, formID))
+
+	if err := createTags(ctx, conn, aws.StringValue(output.EvaluationFormArn), getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Connect Evaluation Form (%s) tags: %s", d.Id(), err)
+	}
+
+	if d.Get(names.AttrStatus).(string) == connect.EvaluationFormVersionStatusActive {
+		// A new evaluation form always starts as a draft of version 1.
+		if err := activateEvaluationForm(ctx, conn, instanceID, formID, 1); err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEvaluationFormRead(ctx, d, meta)...)
+}
+
+func resourceEvaluationFormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, formID, err := EvaluationFormParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resp, err := conn.DescribeEvaluationFormWithContext(ctx, &connect.DescribeEvaluationFormInput{
+		EvaluationFormId: aws.String(formID),
+		InstanceId:       aws.String(instanceID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Evaluation Form (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Connect Evaluation Form (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.EvaluationForm == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Connect Evaluation Form (%s): empty response", d.Id())
+	}
+
+	form := resp.EvaluationForm
+	d.Set(names.AttrARN, form.EvaluationFormArn)
+	d.Set(names.AttrDescription, form.Description)
+	d.Set("evaluation_form_id", form.EvaluationFormId)
+	d.Set("evaluation_form_version", form.EvaluationFormVersion)
+	d.Set(names.AttrInstanceID, instanceID)
+	items, err := flattenEvaluationFormItems(form.Items)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "flattening items: %s", err)
+	}
+	d.Set("items", items)
+	d.Set("locked", form.Locked)
+	if err := d.Set("scoring_strategy", flattenEvaluationFormScoringStrategy(form.ScoringStrategy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scoring_strategy: %s", err)
+	}
+	d.Set(names.AttrStatus, form.Status)
+	d.Set("title", form.Title)
+
+	setTagsOut(ctx, form.Tags)
+
+	return diags
+}
+
+func resourceEvaluationFormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, formID, err := EvaluationFormParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	version := int64(d.Get("evaluation_form_version").(int))
+	status := d.Get(names.AttrStatus).(string)
+	newVersion := false
+
+	if d.HasChanges(names.AttrDescription, "items", "scoring_strategy", "title") {
+		items, err := expandEvaluationFormItems(d.Get("items").(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Activated versions are locked and changes must be made to a new draft version.
+		newVersion = d.Get("locked").(bool)
+
+		input := &connect.UpdateEvaluationFormInput{
+			CreateNewVersion:      aws.Bool(newVersion),
+			Description:           aws.String(d.Get(names.AttrDescription).(string)),
+			EvaluationFormId:      aws.String(formID),
+			EvaluationFormVersion: aws.Int64(version),
+			InstanceId:            aws.String(instanceID),
+			Items:                 items,
+			ScoringStrategy:       expandEvaluationFormScoringStrategy(d.Get("scoring_strategy").([]interface{})),
+			Title:                 aws.String(d.Get("title").(string)),
+		}
+
+		output, err := conn.UpdateEvaluationFormWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+
+		version = aws.Int64Value(output.EvaluationFormVersion)
+	}
+
+	switch {
+	case status == connect.EvaluationFormVersionStatusActive && (newVersion || d.HasChange(names.AttrStatus)):
+		if err := activateEvaluationForm(ctx, conn, instanceID, formID, version); err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+	case status == connect.EvaluationFormVersionStatusDraft && d.HasChange(names.AttrStatus):
+		if err := deactivateEvaluationForm(ctx, conn, instanceID, formID, version); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deactivating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEvaluationFormRead(ctx, d, meta)...)
+}
+
+func resourceEvaluationFormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, formID, err := EvaluationFormParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Active evaluation forms cannot be deleted.
+	if d.Get(names.AttrStatus).(string) == connect.EvaluationFormVersionStatusActive {
+		err := deactivateEvaluationForm(ctx, conn, instanceID, formID, int64(d.Get("evaluation_form_version").(int)))
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deactivating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Evaluation Form: %s", d.Id())
+	// Omitting the version deletes all versions of the evaluation form.
+	_, err = conn.DeleteEvaluationFormWithContext(ctx, &connect.DeleteEvaluationFormInput{
+		EvaluationFormId: aws.String(formID),
+		InstanceId:       aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Evaluation Form (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func activateEvaluationForm(ctx context.Context, conn *connect.Connect, instanceID, formID string, version int64) error {
+	_, err := conn.ActivateEvaluationFormWithContext(ctx, &connect.ActivateEvaluationFormInput{
+		EvaluationFormId:      aws.String(formID),
+		EvaluationFormVersion: aws.Int64(version),
+		InstanceId:            aws.String(instanceID),
+	})
+
+	return err
+}
+
+func deactivateEvaluationForm(ctx context.Context, conn *connect.Connect, instanceID, formID string, version int64) error {
+	_, err := conn.DeactivateEvaluationFormWithContext(ctx, &connect.DeactivateEvaluationFormInput{
+		EvaluationFormId:      aws.String(formID),
+		EvaluationFormVersion: aws.Int64(version),
+		InstanceId:            aws.String(instanceID),
+	})
+
+	return err
+}
+
+func EvaluationFormParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:evaluationFormID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandEvaluationFormItems(rawItems string) ([]*connect.EvaluationFormItem, error) {
+	var items []*connect.EvaluationFormItem
+
+	if err := json.Unmarshal([]byte(rawItems), &items); err != nil {
+		return nil, fmt.Errorf("decoding items JSON: %s", err)
+	}
+
+	for i, v := range items {
+		if v == nil {
+			return nil, fmt.Errorf("invalid evaluation form item supplied at index (%d)", i)
+		}
+	}
+
+	return items, nil
+}
+
+func flattenEvaluationFormItems(items []*connect.EvaluationFormItem) (string, error) {
+	b, err := jsonutil.BuildJSON(items)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandEvaluationFormScoringStrategy(tfList []interface{}) *connect.EvaluationFormScoringStrategy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &connect.EvaluationFormScoringStrategy{
+		Mode:   aws.String(tfMap[names.AttrMode].(string)),
+		Status: aws.String(tfMap[names.AttrStatus].(string)),
+	}
+}
+
+func flattenEvaluationFormScoringStrategy(apiObject *connect.EvaluationFormScoringStrategy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrMode:   aws.StringValue(apiObject.Mode),
+		names.AttrStatus: aws.StringValue(apiObject.Status),
+	}
+
+	return []interface{}{tfMap}
+}
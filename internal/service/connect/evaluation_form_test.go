@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEvaluationForm_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeEvaluationFormOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2, "Created", "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Created"),
+					resource.TestCheckResourceAttrSet(resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "items"),
+					resource.TestCheckResourceAttr(resourceName, "locked", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2, "Updated", "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationForm_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeEvaluationFormOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2, "Disappear", "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceEvaluationForm(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEvaluationForm_activate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeEvaluationFormOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2, "Created", "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "locked", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				// Changing an activated form creates and activates a new version.
+				Config: testAccEvaluationFormConfig_basic(rName, rName2, "Updated", "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2, "Updated", "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEvaluationFormExists(ctx context.Context, resourceName string, function *connect.DescribeEvaluationFormOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Evaluation Form not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Evaluation Form ID not set")
+		}
+		instanceID, formID, err := tfconnect.EvaluationFormParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+		params := &connect.DescribeEvaluationFormInput{
+			EvaluationFormId: aws.String(formID),
+			InstanceId:       aws.String(instanceID),
+		}
+
+		getFunction, err := conn.DescribeEvaluationFormWithContext(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		*function = *getFunction
+
+		return nil
+	}
+}
+
+func testAccCheckEvaluationFormDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_evaluation_form" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+			instanceID, formID, err := tfconnect.EvaluationFormParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			params := &connect.DescribeEvaluationFormInput{
+				EvaluationFormId: aws.String(formID),
+				InstanceId:       aws.String(instanceID),
+			}
+
+			_, err = conn.DescribeEvaluationFormWithContext(ctx, params)
+
+			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Evaluation Form (%s) still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccEvaluationFormConfig_basic(rName, rName2, label, status string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_connect_evaluation_form" "test" {
+  instance_id = aws_connect_instance.test.id
+  title       = %[2]q
+  description = %[3]q
+  status      = %[4]q
+
+  items = jsonencode([
+    {
+      Section = {
+        Title = "Section 1"
+        RefId = "section1"
+        Items = [
+          {
+            Question = {
+              Title        = "Was the customer greeted?"
+              RefId        = "question1"
+              QuestionType = "TEXT"
+            }
+          },
+        ]
+      }
+    },
+  ])
+
+  tags = {
+    "Name" = "Test Evaluation Form"
+  }
+}
+`, rName, rName2, label, status)
+}
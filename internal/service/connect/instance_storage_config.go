@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -31,6 +32,7 @@ func ResourceInstanceStorageConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceInstanceStorageConfigCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			names.AttrAssociationID: {
 				Type:     schema.TypeString,
@@ -46,7 +48,7 @@ func ResourceInstanceStorageConfig() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice(connect.InstanceStorageResourceType_Values(), false),
+				ValidateFunc: validation.StringInSlice(instanceStorageResourceType_Values(), false),
 			},
 			"storage_config": {
 				Type:     schema.TypeList,
@@ -539,3 +541,38 @@ func flattenEncryptionConfig(apiObject *connect.EncryptionConfig) []interface{}
 
 	return []interface{}{values}
 }
+
+func resourceInstanceStorageConfigCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrResourceType) || !d.NewValueKnown("storage_config.0.storage_type") {
+		return nil
+	}
+
+	resourceType := d.Get(names.AttrResourceType).(string)
+	storageType := d.Get("storage_config.0.storage_type").(string)
+
+	if storageTypes, ok := InstanceStorageResourceTypeStorageTypes()[resourceType]; ok && !slices.Contains(storageTypes, storageType) {
+		return fmt.Errorf("storage_type %q is not supported for resource_type %q, expected one of: %s", storageType, resourceType, strings.Join(storageTypes, ", "))
+	}
+
+	// Exactly the configuration block matching the storage type must be set.
+	blocks := map[string]string{
+		connect.StorageTypeKinesisFirehose:    "kinesis_firehose_config",
+		connect.StorageTypeKinesisStream:      "kinesis_stream_config",
+		connect.StorageTypeKinesisVideoStream: "kinesis_video_stream_config",
+		connect.StorageTypeS3:                 "s3_config",
+	}
+
+	for t, block := range blocks {
+		n := len(d.Get("storage_config.0." + block).([]interface{}))
+
+		if t == storageType && n == 0 {
+			return fmt.Errorf("storage_config.0.%s is required when storage_type is %q", block, storageType)
+		}
+
+		if t != storageType && n > 0 {
+			return fmt.Errorf("storage_config.0.%s cannot be set when storage_type is %q", block, storageType)
+		}
+	}
+
+	return nil
+}
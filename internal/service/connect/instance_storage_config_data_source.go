@@ -35,7 +35,7 @@ func DataSourceInstanceStorageConfig() *schema.Resource {
 			names.AttrResourceType: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice(connect.InstanceStorageResourceType_Values(), false),
+				ValidateFunc: validation.StringInSlice(instanceStorageResourceType_Values(), false),
 			},
 			"storage_config": {
 				Type:     schema.TypeList,
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -449,6 +450,63 @@ func testAccInstanceStorageConfig_S3Config_EncryptionConfig(t *testing.T) {
 	})
 }
 
+func testAccInstanceStorageConfig_resourceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeInstanceStorageConfigOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_instance_storage_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceStorageConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStorageConfigConfig_s3ResourceType(rName, rName2, connect.InstanceStorageResourceTypeScreenRecordings),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStorageConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, connect.InstanceStorageResourceTypeScreenRecordings),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.0.storage_type", connect.StorageTypeS3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceStorageConfigConfig_kinesisStreamResourceType(rName, rName2, connect.InstanceStorageResourceTypeRealTimeContactAnalysisSegments),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStorageConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, connect.InstanceStorageResourceTypeRealTimeContactAnalysisSegments),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.0.storage_type", connect.StorageTypeKinesisStream),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceStorageConfig_invalidStorageType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceStorageConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceStorageConfigConfig_kinesisStreamResourceType(rName, rName2, connect.InstanceStorageResourceTypeScreenRecordings),
+				ExpectError: regexache.MustCompile(`storage_type "KINESIS_STREAM" is not supported for resource_type "SCREEN_RECORDINGS"`),
+			},
+		},
+	})
+}
+
 func testAccInstanceStorageConfig_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeInstanceStorageConfigOutput
@@ -580,6 +638,53 @@ resource "aws_connect_instance_storage_config" "test" {
 `, rName2))
 }
 
+func testAccInstanceStorageConfigConfig_s3ResourceType(rName, rName2, resourceType string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceStorageConfigConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_connect_instance_storage_config" "test" {
+  instance_id   = aws_connect_instance.test.id
+  resource_type = %[2]q
+
+  storage_config {
+    s3_config {
+      bucket_name   = aws_s3_bucket.test.id
+      bucket_prefix = "tf-test"
+    }
+    storage_type = "S3"
+  }
+}
+`, rName2, resourceType))
+}
+
+func testAccInstanceStorageConfigConfig_kinesisStreamResourceType(rName, rName2, resourceType string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceStorageConfigConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 2
+}
+
+resource "aws_connect_instance_storage_config" "test" {
+  instance_id   = aws_connect_instance.test.id
+  resource_type = %[2]q
+
+  storage_config {
+    kinesis_stream_config {
+      stream_arn = aws_kinesis_stream.test.arn
+    }
+    storage_type = "KINESIS_STREAM"
+  }
+}
+`, rName2, resourceType))
+}
+
 func testAccInstanceStorageDeliveryStreamConfig_Base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_predefined_attribute", name="Predefined Attribute")
+func ResourcePredefinedAttribute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePredefinedAttributeCreate,
+		ReadWithoutTimeout:   resourcePredefinedAttributeRead,
+		UpdateWithoutTimeout: resourcePredefinedAttributeUpdate,
+		DeleteWithoutTimeout: resourcePredefinedAttributeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_modified_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 128,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func resourcePredefinedAttributeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+
+	input := &connect.CreatePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Values: &connect.PredefinedAttributeValues{
+			StringList: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+		},
+	}
+
+	_, err := conn.CreatePredefinedAttributeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Predefined Attribute (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, name))
+
+	return append(diags, resourcePredefinedAttributeRead(ctx, d, meta)...)
+}
+
+func resourcePredefinedAttributeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, name, err := PredefinedAttributeParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resp, err := conn.DescribePredefinedAttributeWithContext(ctx, &connect.DescribePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Predefined Attribute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Connect Predefined Attribute (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.PredefinedAttribute == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Connect Predefined Attribute (%s): empty response", d.Id())
+	}
+
+	attribute := resp.PredefinedAttribute
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set("last_modified_region", attribute.LastModifiedRegion)
+	if attribute.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(attribute.LastModifiedTime).Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, attribute.Name)
+	if attribute.Values != nil {
+		d.Set("values", aws.StringValueSlice(attribute.Values.StringList))
+	}
+
+	return diags
+}
+
+func resourcePredefinedAttributeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, name, err := PredefinedAttributeParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("values") {
+		input := &connect.UpdatePredefinedAttributeInput{
+			InstanceId: aws.String(instanceID),
+			Name:       aws.String(name),
+			Values: &connect.PredefinedAttributeValues{
+				StringList: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+			},
+		}
+
+		_, err := conn.UpdatePredefinedAttributeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect Predefined Attribute (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePredefinedAttributeRead(ctx, d, meta)...)
+}
+
+func resourcePredefinedAttributeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, name, err := PredefinedAttributeParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Predefined Attribute: %s", d.Id())
+	_, err = conn.DeletePredefinedAttributeWithContext(ctx, &connect.DeletePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Predefined Attribute (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func PredefinedAttributeParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
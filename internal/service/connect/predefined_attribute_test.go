@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPredefinedAttribute_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribePredefinedAttributeOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"one", "two"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "values.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "one"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "two"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"one", "three", "four"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "values.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "three"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "four"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttribute_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribePredefinedAttributeOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"one"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourcePredefinedAttribute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPredefinedAttributeExists(ctx context.Context, resourceName string, function *connect.DescribePredefinedAttributeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Predefined Attribute not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Predefined Attribute ID not set")
+		}
+		instanceID, name, err := tfconnect.PredefinedAttributeParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+		params := &connect.DescribePredefinedAttributeInput{
+			InstanceId: aws.String(instanceID),
+			Name:       aws.String(name),
+		}
+
+		getFunction, err := conn.DescribePredefinedAttributeWithContext(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		*function = *getFunction
+
+		return nil
+	}
+}
+
+func testAccCheckPredefinedAttributeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_predefined_attribute" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+			instanceID, name, err := tfconnect.PredefinedAttributeParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			params := &connect.DescribePredefinedAttributeInput{
+				InstanceId: aws.String(instanceID),
+				Name:       aws.String(name),
+			}
+
+			_, err = conn.DescribePredefinedAttributeWithContext(ctx, params)
+
+			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Predefined Attribute (%s) still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccPredefinedAttributeConfig_basic(rName, rName2, values string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[2]q
+  values      = [%[3]s]
+}
+`, rName, rName2, values)
+}
@@ -116,6 +116,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceEvaluationForm,
+			TypeName: "aws_connect_evaluation_form",
+			Name:     "Evaluation Form",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
@@ -144,6 +152,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourcePredefinedAttribute,
+			TypeName: "aws_connect_predefined_attribute",
+			Name:     "Predefined Attribute",
+		},
 		{
 			Factory:  ResourceQueue,
 			TypeName: "aws_connect_queue",
@@ -196,6 +209,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserHierarchyStructure,
 			TypeName: "aws_connect_user_hierarchy_structure",
 		},
+		{
+			Factory:  ResourceView,
+			TypeName: "aws_connect_view",
+			Name:     "View",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceVocabulary,
 			TypeName: "aws_connect_vocabulary",
@@ -4,6 +4,8 @@
 package connect
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
@@ -24,3 +26,90 @@ func validPhoneNumberPrefix(v interface{}, k string) (ws []string, errors []erro
 	}
 	return
 }
+
+const contactFlowActionTypeInvokeFlowModule = "InvokeFlowModule"
+
+// contactFlowContent is the subset of the Amazon Connect Flow language checked at plan time.
+// See https://docs.aws.amazon.com/connect/latest/APIReference/flow-language.html.
+type contactFlowContent struct {
+	Version     string
+	StartAction string
+	Actions     []struct {
+		Identifier  string
+		Type        string
+		Parameters  map[string]interface{}
+		Transitions struct {
+			NextAction string
+			Errors     []struct {
+				NextAction string
+			}
+			Conditions []struct {
+				NextAction string
+			}
+		}
+	}
+}
+
+func validContactFlowContent(s string) error {
+	var content contactFlowContent
+
+	if err := json.Unmarshal([]byte(s), &content); err != nil {
+		return fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	if content.Version == "" {
+		return errors.New("Version is required")
+	}
+
+	if len(content.Actions) == 0 {
+		return errors.New("at least one action is required")
+	}
+
+	ids := make(map[string]struct{}, len(content.Actions))
+	for i, action := range content.Actions {
+		if action.Identifier == "" {
+			return fmt.Errorf("action at index %d: Identifier is required", i)
+		}
+
+		if _, ok := ids[action.Identifier]; ok {
+			return fmt.Errorf("action (%s): duplicate Identifier", action.Identifier)
+		}
+		ids[action.Identifier] = struct{}{}
+
+		if action.Type == "" {
+			return fmt.Errorf("action (%s): Type is required", action.Identifier)
+		}
+
+		if action.Type == contactFlowActionTypeInvokeFlowModule {
+			if v, ok := action.Parameters["FlowModuleId"].(string); !ok || v == "" {
+				return fmt.Errorf("action (%s): %s requires Parameters.FlowModuleId", action.Identifier, contactFlowActionTypeInvokeFlowModule)
+			}
+		}
+	}
+
+	if _, ok := ids[content.StartAction]; !ok {
+		return fmt.Errorf("StartAction (%s) does not reference an action", content.StartAction)
+	}
+
+	for _, action := range content.Actions {
+		next := []string{action.Transitions.NextAction}
+		for _, v := range action.Transitions.Errors {
+			next = append(next, v.NextAction)
+		}
+		for _, v := range action.Transitions.Conditions {
+			next = append(next, v.NextAction)
+		}
+
+		for _, v := range next {
+			if v == "" {
+				continue
+			}
+
+			if _, ok := ids[v]; !ok {
+				return fmt.Errorf("action (%s): transition to %s does not reference an action", action.Identifier, v)
+			}
+		}
+	}
+
+	return nil
+}
@@ -64,3 +64,33 @@ func TestValidPhoneNumberPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContactFlowContent(t *testing.T) {
+	t.Parallel()
+
+	validContents := []string{
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Parameters":{"Text":"hi"},"Transitions":{"NextAction":"b","Errors":[{"NextAction":"b","ErrorType":"NoMatchingError"}],"Conditions":[]}},{"Identifier":"b","Type":"DisconnectParticipant","Parameters":{},"Transitions":{}}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"InvokeFlowModule","Parameters":{"FlowModuleId":"12345678-1234-1234-1234-123456789012"},"Transitions":{"NextAction":"b"}},{"Identifier":"b","Type":"DisconnectParticipant","Parameters":{},"Transitions":{}}]}`,
+	}
+	for _, v := range validContents {
+		if err := validContactFlowContent(v); err != nil {
+			t.Fatalf("%q should be valid contact flow content: %s", v, err)
+		}
+	}
+
+	invalidContents := []string{
+		`not json`,
+		`{"StartAction":"a","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[]}`,
+		`{"Version":"2019-10-30","StartAction":"c","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"},{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"NextAction":"missing"}}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"Conditions":[{"NextAction":"missing"}]}}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"InvokeFlowModule","Parameters":{}}]}`,
+	}
+	for _, v := range invalidContents {
+		if err := validContactFlowContent(v); err == nil {
+			t.Fatalf("%q should be invalid contact flow content", v)
+		}
+	}
+}
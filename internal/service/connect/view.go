@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_view", name="View")
+// @Tags(identifierAttribute="arn")
+func ResourceView() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceViewCreate,
+		ReadWithoutTimeout:   resourceViewRead,
+		UpdateWithoutTimeout: resourceViewUpdate,
+		DeleteWithoutTimeout: resourceViewDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrContent: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
+						"template": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connect.ViewStatusPublished,
+				ValidateFunc: validation.StringInSlice(connect.ViewStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceViewCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+
+	input := &connect.CreateViewInput{
+		Content:    expandViewInputContent(d.Get(names.AttrContent).([]interface{})),
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Status:     aws.String(d.Get(names.AttrStatus).(string)),
+		Tags:       getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateViewWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect View (%s): %s", name, err)
+	}
+
+	if output == nil || output.View == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect View (%s): empty output", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.View.Id)))
+
+	return append(diags, resourceViewRead(ctx, d, meta)...)
+}
+
+func resourceViewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, viewID, err := ViewParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resp, err := conn.DescribeViewWithContext(ctx, &connect.DescribeViewInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(viewID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect View (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Connect View (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.View == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Connect View (%s): empty response", d.Id())
+	}
+
+	view := resp.View
+	d.Set(names.AttrARN, view.Arn)
+	if err := d.Set(names.AttrContent, flattenViewContent(view.Content)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting content: %s", err)
+	}
+	d.Set(names.AttrDescription, view.Description)
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set(names.AttrName, view.Name)
+	d.Set(names.AttrStatus, view.Status)
+	d.Set(names.AttrType, view.Type)
+	d.Set(names.AttrVersion, view.Version)
+	d.Set("view_id", view.Id)
+
+	setTagsOut(ctx, view.Tags)
+
+	return diags
+}
+
+func resourceViewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, viewID, err := ViewParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges(names.AttrName, names.AttrDescription) {
+		input := &connect.UpdateViewMetadataInput{
+			InstanceId: aws.String(instanceID),
+			Name:       aws.String(d.Get(names.AttrName).(string)),
+			ViewId:     aws.String(viewID),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateViewMetadataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect View (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges(names.AttrContent, names.AttrStatus) {
+		input := &connect.UpdateViewContentInput{
+			Content:    expandViewInputContent(d.Get(names.AttrContent).([]interface{})),
+			InstanceId: aws.String(instanceID),
+			Status:     aws.String(d.Get(names.AttrStatus).(string)),
+			ViewId:     aws.String(viewID),
+		}
+
+		_, err := conn.UpdateViewContentWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect View content (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceViewRead(ctx, d, meta)...)
+}
+
+func resourceViewDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, viewID, err := ViewParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect View: %s", d.Id())
+	_, err = conn.DeleteViewWithContext(ctx, &connect.DeleteViewInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(viewID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect View (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func ViewParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:viewID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandViewInputContent(tfList []interface{}) *connect.ViewInputContent {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connect.ViewInputContent{}
+
+	if v, ok := tfMap["actions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Actions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["template"].(string); ok && v != "" {
+		apiObject.Template = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenViewContent(apiObject *connect.ViewContent) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"actions":  aws.StringValueSlice(apiObject.Actions),
+		"template": aws.StringValue(apiObject.Template),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccView_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeViewOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_view.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewConfig_basic(rName, rName2, "Created"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "content.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content.0.actions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "content.0.template"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Created"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "PUBLISHED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "CUSTOMER_MANAGED"),
+					resource.TestCheckResourceAttrSet(resourceName, "view_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccViewConfig_basic(rName, rName2, "Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckViewExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccView_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeViewOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_view.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewConfig_basic(rName, rName2, "Disappear"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceView(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccView_updateTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeViewOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_view.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewConfig_tags1(rName, rName2, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccViewConfig_tags2(rName, rName2, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckViewExists(ctx context.Context, resourceName string, function *connect.DescribeViewOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect View not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect View ID not set")
+		}
+		instanceID, viewID, err := tfconnect.ViewParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+		params := &connect.DescribeViewInput{
+			InstanceId: aws.String(instanceID),
+			ViewId:     aws.String(viewID),
+		}
+
+		getFunction, err := conn.DescribeViewWithContext(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		*function = *getFunction
+
+		return nil
+	}
+}
+
+func testAccCheckViewDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_view" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+			instanceID, viewID, err := tfconnect.ViewParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			params := &connect.DescribeViewInput{
+				InstanceId: aws.String(instanceID),
+				ViewId:     aws.String(viewID),
+			}
+
+			_, err = conn.DescribeViewWithContext(ctx, params)
+
+			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect View (%s) still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccViewConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+const testAccViewTemplate = `
+    template = jsonencode({
+      Head = {
+        Title = "Example"
+        Configuration = {
+          Layout = {
+            Columns = ["12"]
+          }
+        }
+      }
+      Body = [
+        {
+          _id  = "Text_1"
+          Type = "Text"
+          Props = {
+            Content = "Hello"
+          }
+        },
+      ]
+    })
+`
+
+func testAccViewConfig_basic(rName, rName2, label string) string {
+	return acctest.ConfigCompose(
+		testAccViewConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_view" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+
+  content {
+    actions = ["ActionSelected"]
+%[3]s
+  }
+}
+`, rName2, label, testAccViewTemplate))
+}
+
+func testAccViewConfig_tags1(rName, rName2, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccViewConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_view" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+
+  content {
+    actions = ["ActionSelected"]
+%[2]s
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName2, testAccViewTemplate, tagKey1, tagValue1))
+}
+
+func testAccViewConfig_tags2(rName, rName2, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccViewConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_view" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+
+  content {
+    actions = ["ActionSelected"]
+%[2]s
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName2, testAccViewTemplate, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_controltower_landing_zone_operation", name="Landing Zone Operation")
+func dataSourceLandingZoneOperation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLandingZoneOperationRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLandingZoneOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	id := d.Get("operation_identifier").(string)
+	output, err := findLandingZoneOperationByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Landing Zone Operation (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.ToTime(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("operation_type", output.OperationType)
+	if output.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.ToTime(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+
+	return diags
+}
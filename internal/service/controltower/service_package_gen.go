@@ -30,6 +30,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_controltower_controls",
 			Name:     "Control",
 		},
+		{
+			Factory:  dataSourceLandingZoneOperation,
+			TypeName: "aws_controltower_landing_zone_operation",
+			Name:     "Landing Zone Operation",
+		},
 	}
 }
 
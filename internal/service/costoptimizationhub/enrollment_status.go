@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costoptimizationhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costoptimizationhub/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Enrollment Status")
+func newEnrollmentStatusResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &enrollmentStatusResource{}
+
+	return r, nil
+}
+
+type enrollmentStatusResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*enrollmentStatusResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_costoptimizationhub_enrollment_status"
+}
+
+func (r *enrollmentStatusResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *enrollmentStatusResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data enrollmentStatusResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	output, err := updateEnrollmentStatus(ctx, conn, awstypes.EnrollmentStatusActive, data.IncludeMemberAccounts.ValueBool())
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Cost Optimization Hub Enrollment Status", err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringValueToFramework(ctx, r.Meta().AccountID)
+	data.Status = flex.StringToFramework(ctx, output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *enrollmentStatusResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data enrollmentStatusResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	output, includeMemberAccounts, err := findActiveEnrollmentStatusByAccountID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Cost Optimization Hub Enrollment Status (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	if includeMemberAccounts != nil {
+		data.IncludeMemberAccounts = flex.BoolToFramework(ctx, includeMemberAccounts)
+	}
+	data.Status = flex.StringValueToFramework(ctx, string(output.Status))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *enrollmentStatusResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new enrollmentStatusResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	output, err := updateEnrollmentStatus(ctx, conn, awstypes.EnrollmentStatusActive, new.IncludeMemberAccounts.ValueBool())
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Cost Optimization Hub Enrollment Status (%s)", new.ID.ValueString()), err))
+
+		return
+	}
+
+	new.Status = flex.StringToFramework(ctx, output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *enrollmentStatusResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data enrollmentStatusResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	_, err := updateEnrollmentStatus(ctx, conn, awstypes.EnrollmentStatusInactive, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Cost Optimization Hub Enrollment Status (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+}
+
+func updateEnrollmentStatus(ctx context.Context, conn *costoptimizationhub.Client, status awstypes.EnrollmentStatus, includeMemberAccounts bool) (*costoptimizationhub.UpdateEnrollmentStatusOutput, error) {
+	input := &costoptimizationhub.UpdateEnrollmentStatusInput{
+		Status: status,
+	}
+
+	// Member accounts can only be included when enrolling.
+	if status == awstypes.EnrollmentStatusActive {
+		input.IncludeMemberAccounts = aws.Bool(includeMemberAccounts)
+	}
+
+	return conn.UpdateEnrollmentStatus(ctx, input)
+}
+
+// findActiveEnrollmentStatusByAccountID returns the enrollment status of the specified account
+// and, for an organization's management account, whether member accounts are enrolled.
+func findActiveEnrollmentStatusByAccountID(ctx context.Context, conn *costoptimizationhub.Client, accountID string) (*awstypes.AccountEnrollmentStatus, *bool, error) {
+	input := &costoptimizationhub.ListEnrollmentStatusesInput{}
+	var includeMemberAccounts *bool
+	var output *awstypes.AccountEnrollmentStatus
+
+	pages := costoptimizationhub.NewListEnrollmentStatusesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page.IncludeMemberAccounts != nil {
+			includeMemberAccounts = page.IncludeMemberAccounts
+		}
+
+		for _, v := range page.Items {
+			// The account's own status is returned without an account ID.
+			if id := aws.ToString(v.AccountId); id == "" || id == accountID {
+				output = &v
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.EnrollmentStatusInactive {
+		return nil, nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, includeMemberAccounts, nil
+}
+
+type enrollmentStatusResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	IncludeMemberAccounts types.Bool   `tfsdk:"include_member_accounts"`
+	Status                types.String `tfsdk:"status"`
+}
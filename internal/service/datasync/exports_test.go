@@ -17,6 +17,7 @@ var (
 	ResourceLocationS3                   = resourceLocationS3
 	ResourceLocationSMB                  = resourceLocationSMB
 	ResourceTask                         = resourceTask
+	ResourceTaskExecution                = resourceTaskExecution
 
 	FindLocationAzureBlobByARN     = findLocationAzureBlobByARN
 	FindLocationEFSByARN           = findLocationEFSByARN
@@ -30,4 +31,5 @@ var (
 	FindLocationS3ByARN            = findLocationS3ByARN
 	FindLocationSMBByARN           = findLocationSMBByARN
 	FindTaskByARN                  = findTaskByARN
+	FindTaskExecutionByARN         = findTaskExecutionByARN
 )
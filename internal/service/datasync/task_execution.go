@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_datasync_task_execution", name="Task Execution")
+func resourceTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTaskExecutionCreate,
+		ReadWithoutTimeout:   resourceTaskExecutionRead,
+		UpdateWithoutTimeout: resourceTaskExecutionUpdate,
+		DeleteWithoutTimeout: resourceTaskExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"excludes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.FilterType](),
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"files_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.FilterType](),
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceTaskExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	taskARN := d.Get("task_arn").(string)
+	input := &datasync.StartTaskExecutionInput{
+		TaskArn: aws.String(taskARN),
+	}
+
+	if v, ok := d.GetOk("excludes"); ok {
+		input.Excludes = expandFilterRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("includes"); ok {
+		input.Includes = expandFilterRules(v.([]interface{}))
+	}
+
+	output, err := conn.StartTaskExecution(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting DataSync Task (%s) execution: %s", taskARN, err)
+	}
+
+	d.SetId(aws.ToString(output.TaskExecutionArn))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitTaskExecutionSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for DataSync Task Execution (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTaskExecutionRead(ctx, d, meta)...)
+}
+
+func resourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	output, err := findTaskExecutionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataSync Task Execution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataSync Task Execution (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.TaskExecutionArn)
+	d.Set("bytes_transferred", output.BytesTransferred)
+	d.Set("files_transferred", output.FilesTransferred)
+	if output.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.ToTime(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceTaskExecutionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// wait_for_completion only affects creation.
+
+	return append(diags, resourceTaskExecutionRead(ctx, d, meta)...)
+}
+
+func resourceTaskExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	output, err := findTaskExecutionByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataSync Task Execution (%s): %s", d.Id(), err)
+	}
+
+	// Completed executions are retained by DataSync and can't be deleted.
+	switch output.Status {
+	case awstypes.TaskExecutionStatusSuccess, awstypes.TaskExecutionStatusError, awstypes.TaskExecutionStatusCancelling:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling DataSync Task Execution: %s", d.Id())
+	_, err = conn.CancelTaskExecution(ctx, &datasync.CancelTaskExecutionInput{
+		TaskExecutionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling DataSync Task Execution (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findTaskExecutionByARN(ctx context.Context, conn *datasync.Client, arn string) (*datasync.DescribeTaskExecutionOutput, error) {
+	input := &datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTaskExecution(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTaskExecution(ctx context.Context, conn *datasync.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTaskExecutionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTaskExecutionSucceeded(ctx context.Context, conn *datasync.Client, arn string, timeout time.Duration) (*datasync.DescribeTaskExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.TaskExecutionStatusQueued,
+			awstypes.TaskExecutionStatusLaunching,
+			awstypes.TaskExecutionStatusPreparing,
+			awstypes.TaskExecutionStatusTransferring,
+			awstypes.TaskExecutionStatusVerifying,
+		),
+		Target:  enum.Slice(awstypes.TaskExecutionStatusSuccess),
+		Refresh: statusTaskExecution(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datasync.DescribeTaskExecutionOutput); ok {
+		if result := output.Result; result != nil {
+			if errorCode, errorDetail := aws.ToString(result.ErrorCode), aws.ToString(result.ErrorDetail); errorCode != "" && errorDetail != "" {
+				tfresource.SetLastError(err, fmt.Errorf("%s: %s", errorCode, errorDetail))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
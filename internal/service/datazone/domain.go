@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -173,10 +174,10 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 	}, ErrorCodeAccessDenied)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameDomain, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if outputRaw == nil {
@@ -196,10 +197,10 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	_, err = waitDomainCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameDomain, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -221,10 +222,10 @@ func (r *resourceDomain) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameDomain, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -292,10 +293,10 @@ func (r *resourceDomain) Update(ctx context.Context, req resource.UpdateRequest,
 
 		out, err := conn.UpdateDomain(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameDomain, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -332,20 +333,20 @@ func (r *resourceDomain) Delete(ctx context.Context, req resource.DeleteRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameDomain, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitDomainDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForDeletion, ResNameDomain, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -119,10 +120,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Create(ctx context.Context,
 
 	out, err := conn.PutEnvironmentBlueprintConfiguration(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironmentBlueprintConfiguration, plan.EnvironmentBlueprintId.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -152,10 +153,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Read(ctx context.Context, re
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEnvironmentBlueprintConfiguration, state.EnvironmentBlueprintId.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -212,10 +213,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Update(ctx context.Context,
 
 		out, err := conn.PutEnvironmentBlueprintConfiguration(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironmentBlueprintConfiguration, plan.EnvironmentBlueprintId.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -249,10 +250,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Delete(ctx context.Context,
 		if isResourceMissing(err) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEnvironmentBlueprintConfiguration, state.EnvironmentBlueprintId.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -268,10 +269,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) ImportState(ctx context.Cont
 
 	environmentBlueprintConfiguration, err := findEnvironmentBlueprintConfigurationByIDs(ctx, r.Meta().DataZoneClient(ctx), domainId, environmentBlueprintId)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			"Importing Resource",
-			err.Error(),
-		)
+			err,
+		))
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), aws.ToString(environmentBlueprintConfiguration.DomainId))...)
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+// Exports for use in tests only.
+var (
+	ResourceFarm                  = resourceFarm
+	ResourceFleet                 = resourceFleet
+	ResourceQueue                 = resourceQueue
+	ResourceQueueFleetAssociation = resourceQueueFleetAssociation
+	ResourceStorageProfile        = resourceStorageProfile
+
+	FindFarmByID                            = findFarmByID
+	FindFleetByTwoPartKey                   = findFleetByTwoPartKey
+	FindQueueByTwoPartKey                   = findQueueByTwoPartKey
+	FindQueueFleetAssociationByThreePartKey = findQueueFleetAssociationByThreePartKey
+	FindStorageProfileByTwoPartKey          = findStorageProfileByTwoPartKey
+	FleetParseResourceID                    = fleetParseResourceID
+	QueueFleetAssociationParseResourceID    = queueFleetAssociationParseResourceID
+	QueueParseResourceID                    = queueParseResourceID
+	StorageProfileParseResourceID           = storageProfileParseResourceID
+)
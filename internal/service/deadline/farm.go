@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_deadline_farm", name="Farm")
+// @Tags(identifierAttribute="arn")
+func resourceFarm() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFarmCreate,
+		ReadWithoutTimeout:   resourceFarmRead,
+		UpdateWithoutTimeout: resourceFarmUpdate,
+		DeleteWithoutTimeout: resourceFarmDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	name := d.Get(names.AttrDisplayName).(string)
+	input := &deadline.CreateFarmInput{
+		ClientToken: aws.String(id.UniqueId()),
+		DisplayName: aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyARN); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFarmWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Farm (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.FarmId))
+
+	return append(diags, resourceFarmRead(ctx, d, meta)...)
+}
+
+func resourceFarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	output, err := findFarmByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Farm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Farm (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, farmARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrDisplayName, output.DisplayName)
+	d.Set("farm_id", output.FarmId)
+	d.Set(names.AttrKMSKeyARN, output.KmsKeyArn)
+
+	return diags
+}
+
+func resourceFarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrDisplayName) {
+		input := &deadline.UpdateFarmInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			DisplayName: aws.String(d.Get(names.AttrDisplayName).(string)),
+			FarmId:      aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateFarmWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Farm (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFarmRead(ctx, d, meta)...)
+}
+
+func resourceFarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Farm: %s", d.Id())
+	_, err := conn.DeleteFarmWithContext(ctx, &deadline.DeleteFarmInput{
+		FarmId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Farm (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findFarmByID(ctx context.Context, conn *deadline.Deadline, id string) (*deadline.GetFarmOutput, error) {
+	input := &deadline.GetFarmInput{
+		FarmId: aws.String(id),
+	}
+
+	output, err := conn.GetFarmWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// farmARN returns the ARN of a farm. The Deadline Cloud API doesn't return resource ARNs.
+func farmARN(c *conns.AWSClient, farmID string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   deadline.ServiceName,
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  fmt.Sprintf("farm/%s", farmID),
+	}.String()
+}
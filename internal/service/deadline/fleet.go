@@ -0,0 +1,890 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_deadline_fleet", name="Fleet")
+// @Tags(identifierAttribute="arn")
+func resourceFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetCreate,
+		ReadWithoutTimeout:   resourceFleetRead,
+		UpdateWithoutTimeout: resourceFleetUpdate,
+		DeleteWithoutTimeout: resourceFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.customer_managed", "configuration.0.service_managed_ec2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMode: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(deadline.AutoScalingMode_Values(), false),
+									},
+									"storage_profile_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"worker_capabilities": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"accelerator_count":            fleetRangeSchema(false, 0),
+												"accelerator_total_memory_mib": fleetRangeSchema(false, 0),
+												"accelerator_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(deadline.AcceleratorType_Values(), false),
+													},
+												},
+												"cpu_architecture_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CpuArchitectureType_Values(), false),
+												},
+												"custom_amount":    fleetAmountCapabilitySchema(),
+												"custom_attribute": fleetAttributeCapabilitySchema(),
+												"memory_mib":       fleetRangeSchema(true, 512),
+												"os_family": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CustomerManagedFleetOperatingSystemFamily_Values(), false),
+												},
+												"vcpu_count": fleetRangeSchema(true, 1),
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_managed_ec2": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_capabilities": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allowed_instance_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"cpu_architecture_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CpuArchitectureType_Values(), false),
+												},
+												"custom_amount":    fleetAmountCapabilitySchema(),
+												"custom_attribute": fleetAttributeCapabilitySchema(),
+												"excluded_instance_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"memory_mib": fleetRangeSchema(true, 512),
+												"os_family": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.ServiceManagedFleetOperatingSystemFamily_Values(), false),
+												},
+												"root_ebs_volume": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrIOPS: {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(3000, 16000),
+															},
+															"size_gib": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"throughput_mib": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(125, 1000),
+															},
+														},
+													},
+												},
+												"vcpu_count": fleetRangeSchema(true, 1),
+											},
+										},
+									},
+									"instance_market_options": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrType: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.Ec2MarketType_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_worker_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"worker_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func fleetRangeSchema(required bool, minValue int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrMax: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(minValue),
+				},
+				names.AttrMin: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(minValue),
+				},
+			},
+		},
+	}
+}
+
+func fleetAmountCapabilitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 15,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrMax: {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+				names.AttrMin: {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func fleetAttributeCapabilitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 15,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrValues: {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID := d.Get("farm_id").(string)
+	name := d.Get(names.AttrDisplayName).(string)
+	input := &deadline.CreateFleetInput{
+		ClientToken:    aws.String(id.UniqueId()),
+		Configuration:  expandFleetConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		DisplayName:    aws.String(name),
+		FarmId:         aws.String(farmID),
+		MaxWorkerCount: aws.Int64(int64(d.Get("max_worker_count").(int))),
+		MinWorkerCount: aws.Int64(int64(d.Get("min_worker_count").(int))),
+		RoleArn:        aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// Retry for IAM eventual consistency.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateFleetWithContext(ctx, input)
+	}, deadline.ErrCodeValidationException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Fleet (%s): %s", name, err)
+	}
+
+	fleetID := aws.StringValue(outputRaw.(*deadline.CreateFleetOutput).FleetId)
+	d.SetId(fleetCreateResourceID(farmID, fleetID))
+
+	if _, err := waitFleetActive(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFleetRead(ctx, d, meta)...)
+}
+
+func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, fleetID, err := fleetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, fleetARN(meta.(*conns.AWSClient), farmID, fleetID))
+	if err := d.Set(names.AttrConfiguration, flattenFleetConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrDisplayName, output.DisplayName)
+	d.Set("farm_id", output.FarmId)
+	d.Set("fleet_id", output.FleetId)
+	d.Set("max_worker_count", output.MaxWorkerCount)
+	d.Set("min_worker_count", output.MinWorkerCount)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("worker_count", output.WorkerCount)
+
+	return diags
+}
+
+func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		farmID, fleetID, err := fleetParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &deadline.UpdateFleetInput{
+			ClientToken: aws.String(id.UniqueId()),
+			FarmId:      aws.String(farmID),
+			FleetId:     aws.String(fleetID),
+		}
+
+		if d.HasChange(names.AttrConfiguration) {
+			input.Configuration = expandFleetConfiguration(d.Get(names.AttrConfiguration).([]interface{}))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		if d.HasChange("max_worker_count") {
+			input.MaxWorkerCount = aws.Int64(int64(d.Get("max_worker_count").(int)))
+		}
+
+		if d.HasChange("min_worker_count") {
+			input.MinWorkerCount = aws.Int64(int64(d.Get("min_worker_count").(int)))
+		}
+
+		if d.HasChange(names.AttrRoleARN) {
+			input.RoleArn = aws.String(d.Get(names.AttrRoleARN).(string))
+		}
+
+		_, err = conn.UpdateFleetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Fleet (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFleetActive(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Fleet (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFleetRead(ctx, d, meta)...)
+}
+
+func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, fleetID, err := fleetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Fleet: %s", d.Id())
+	_, err = conn.DeleteFleetWithContext(ctx, &deadline.DeleteFleetInput{
+		ClientToken: aws.String(id.UniqueId()),
+		FarmId:      aws.String(farmID),
+		FleetId:     aws.String(fleetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetDeleted(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const fleetResourceIDPartCount = 2
+
+func fleetCreateResourceID(farmID, fleetID string) string {
+	parts := []string{farmID, fleetID}
+
+	return errs.Must(flex.FlattenResourceId(parts, fleetResourceIDPartCount, false))
+}
+
+func fleetParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, fleetResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findFleetByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string) (*deadline.GetFleetOutput, error) {
+	input := &deadline.GetFleetInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+	}
+
+	output, err := conn.GetFleetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFleet(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitFleetActive(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{deadline.FleetStatusCreateInProgress, deadline.FleetStatusUpdateInProgress},
+		Target:  []string{deadline.FleetStatusActive},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		if status := aws.StringValue(output.Status); status == deadline.FleetStatusCreateFailed || status == deadline.FleetStatusUpdateFailed {
+			tfresource.SetLastError(err, errors.New(status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeleted(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: deadline.FleetStatus_Values(),
+		Target:  []string{},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func fleetARN(c *conns.AWSClient, farmID, fleetID string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   deadline.ServiceName,
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  fmt.Sprintf("farm/%s/fleet/%s", farmID, fleetID),
+	}.String()
+}
+
+func expandFleetConfiguration(tfList []interface{}) *deadline.FleetConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &deadline.FleetConfiguration{}
+
+	if v, ok := tfMap["customer_managed"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManaged = &deadline.CustomerManagedFleetConfiguration{
+			Mode: aws.String(tfMap[names.AttrMode].(string)),
+		}
+
+		if v, ok := tfMap["storage_profile_id"].(string); ok && v != "" {
+			apiObject.CustomerManaged.StorageProfileId = aws.String(v)
+		}
+
+		if v, ok := tfMap["worker_capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.CustomerManaged.WorkerCapabilities = &deadline.CustomerManagedWorkerCapabilities{
+				CpuArchitectureType: aws.String(tfMap["cpu_architecture_type"].(string)),
+				CustomAmounts:       expandFleetAmountCapabilities(tfMap["custom_amount"].([]interface{})),
+				CustomAttributes:    expandFleetAttributeCapabilities(tfMap["custom_attribute"].([]interface{})),
+				OsFamily:            aws.String(tfMap["os_family"].(string)),
+			}
+
+			if min, max, ok := expandFleetRange(tfMap["accelerator_count"].([]interface{})); ok {
+				apiObject.CustomerManaged.WorkerCapabilities.AcceleratorCount = &deadline.AcceleratorCountRange{Max: max, Min: min}
+			}
+
+			if min, max, ok := expandFleetRange(tfMap["accelerator_total_memory_mib"].([]interface{})); ok {
+				apiObject.CustomerManaged.WorkerCapabilities.AcceleratorTotalMemoryMiB = &deadline.AcceleratorTotalMemoryMiBRange{Max: max, Min: min}
+			}
+
+			if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.CustomerManaged.WorkerCapabilities.AcceleratorTypes = flex.ExpandStringSet(v)
+			}
+
+			if min, max, ok := expandFleetRange(tfMap["memory_mib"].([]interface{})); ok {
+				apiObject.CustomerManaged.WorkerCapabilities.MemoryMiB = &deadline.MemoryMiBRange{Max: max, Min: min}
+			}
+
+			if min, max, ok := expandFleetRange(tfMap["vcpu_count"].([]interface{})); ok {
+				apiObject.CustomerManaged.WorkerCapabilities.VCpuCount = &deadline.VCpuCountRange{Max: max, Min: min}
+			}
+		}
+	}
+
+	if v, ok := tfMap["service_managed_ec2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ServiceManagedEc2 = &deadline.ServiceManagedEc2FleetConfiguration{}
+
+		if v, ok := tfMap["instance_capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ServiceManagedEc2.InstanceCapabilities = &deadline.ServiceManagedEc2InstanceCapabilities{
+				CpuArchitectureType: aws.String(tfMap["cpu_architecture_type"].(string)),
+				CustomAmounts:       expandFleetAmountCapabilities(tfMap["custom_amount"].([]interface{})),
+				CustomAttributes:    expandFleetAttributeCapabilities(tfMap["custom_attribute"].([]interface{})),
+				OsFamily:            aws.String(tfMap["os_family"].(string)),
+			}
+
+			if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.ServiceManagedEc2.InstanceCapabilities.AllowedInstanceTypes = flex.ExpandStringSet(v)
+			}
+
+			if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.ServiceManagedEc2.InstanceCapabilities.ExcludedInstanceTypes = flex.ExpandStringSet(v)
+			}
+
+			if min, max, ok := expandFleetRange(tfMap["memory_mib"].([]interface{})); ok {
+				apiObject.ServiceManagedEc2.InstanceCapabilities.MemoryMiB = &deadline.MemoryMiBRange{Max: max, Min: min}
+			}
+
+			if v, ok := tfMap["root_ebs_volume"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				volume := &deadline.Ec2EbsVolume{}
+
+				if v, ok := tfMap[names.AttrIOPS].(int); ok && v != 0 {
+					volume.Iops = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["size_gib"].(int); ok && v != 0 {
+					volume.SizeGiB = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["throughput_mib"].(int); ok && v != 0 {
+					volume.ThroughputMiB = aws.Int64(int64(v))
+				}
+
+				apiObject.ServiceManagedEc2.InstanceCapabilities.RootEbsVolume = volume
+			}
+
+			if min, max, ok := expandFleetRange(tfMap["vcpu_count"].([]interface{})); ok {
+				apiObject.ServiceManagedEc2.InstanceCapabilities.VCpuCount = &deadline.VCpuCountRange{Max: max, Min: min}
+			}
+		}
+
+		if v, ok := tfMap["instance_market_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ServiceManagedEc2.InstanceMarketOptions = &deadline.ServiceManagedEc2InstanceMarketOptions{
+				Type: aws.String(tfMap[names.AttrType].(string)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandFleetRange(tfList []interface{}) (*int64, *int64, bool) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil, false
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	min := aws.Int64(int64(tfMap[names.AttrMin].(int)))
+
+	var max *int64
+	if v, ok := tfMap[names.AttrMax].(int); ok && v != 0 {
+		max = aws.Int64(int64(v))
+	}
+
+	return min, max, true
+}
+
+func expandFleetAmountCapabilities(tfList []interface{}) []*deadline.FleetAmountCapability {
+	var apiObjects []*deadline.FleetAmountCapability
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &deadline.FleetAmountCapability{
+			Min:  aws.Float64(tfMap[names.AttrMin].(float64)),
+			Name: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrMax].(float64); ok && v != 0 {
+			apiObject.Max = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFleetAttributeCapabilities(tfList []interface{}) []*deadline.FleetAttributeCapability {
+	var apiObjects []*deadline.FleetAttributeCapability
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &deadline.FleetAttributeCapability{
+			Name:   aws.String(tfMap[names.AttrName].(string)),
+			Values: flex.ExpandStringSet(tfMap[names.AttrValues].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFleetConfiguration(apiObject *deadline.FleetConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManaged; v != nil {
+		customerManaged := map[string]interface{}{
+			names.AttrMode:       aws.StringValue(v.Mode),
+			"storage_profile_id": aws.StringValue(v.StorageProfileId),
+		}
+
+		if v := v.WorkerCapabilities; v != nil {
+			capabilities := map[string]interface{}{
+				"accelerator_types":     aws.StringValueSlice(v.AcceleratorTypes),
+				"cpu_architecture_type": aws.StringValue(v.CpuArchitectureType),
+				"custom_amount":         flattenFleetAmountCapabilities(v.CustomAmounts),
+				"custom_attribute":      flattenFleetAttributeCapabilities(v.CustomAttributes),
+				"os_family":             aws.StringValue(v.OsFamily),
+			}
+
+			if v := v.AcceleratorCount; v != nil {
+				capabilities["accelerator_count"] = flattenFleetRange(v.Min, v.Max)
+			}
+
+			if v := v.AcceleratorTotalMemoryMiB; v != nil {
+				capabilities["accelerator_total_memory_mib"] = flattenFleetRange(v.Min, v.Max)
+			}
+
+			if v := v.MemoryMiB; v != nil {
+				capabilities["memory_mib"] = flattenFleetRange(v.Min, v.Max)
+			}
+
+			if v := v.VCpuCount; v != nil {
+				capabilities["vcpu_count"] = flattenFleetRange(v.Min, v.Max)
+			}
+
+			customerManaged["worker_capabilities"] = []interface{}{capabilities}
+		}
+
+		tfMap["customer_managed"] = []interface{}{customerManaged}
+	}
+
+	if v := apiObject.ServiceManagedEc2; v != nil {
+		serviceManaged := map[string]interface{}{}
+
+		if v := v.InstanceCapabilities; v != nil {
+			capabilities := map[string]interface{}{
+				"allowed_instance_types":  aws.StringValueSlice(v.AllowedInstanceTypes),
+				"cpu_architecture_type":   aws.StringValue(v.CpuArchitectureType),
+				"custom_amount":           flattenFleetAmountCapabilities(v.CustomAmounts),
+				"custom_attribute":        flattenFleetAttributeCapabilities(v.CustomAttributes),
+				"excluded_instance_types": aws.StringValueSlice(v.ExcludedInstanceTypes),
+				"os_family":               aws.StringValue(v.OsFamily),
+			}
+
+			if v := v.MemoryMiB; v != nil {
+				capabilities["memory_mib"] = flattenFleetRange(v.Min, v.Max)
+			}
+
+			if v := v.RootEbsVolume; v != nil {
+				capabilities["root_ebs_volume"] = []interface{}{map[string]interface{}{
+					names.AttrIOPS:   aws.Int64Value(v.Iops),
+					"size_gib":       aws.Int64Value(v.SizeGiB),
+					"throughput_mib": aws.Int64Value(v.ThroughputMiB),
+				}}
+			}
+
+			if v := v.VCpuCount; v != nil {
+				capabilities["vcpu_count"] = flattenFleetRange(v.Min, v.Max)
+			}
+
+			serviceManaged["instance_capabilities"] = []interface{}{capabilities}
+		}
+
+		if v := v.InstanceMarketOptions; v != nil {
+			serviceManaged["instance_market_options"] = []interface{}{map[string]interface{}{
+				names.AttrType: aws.StringValue(v.Type),
+			}}
+		}
+
+		tfMap["service_managed_ec2"] = []interface{}{serviceManaged}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFleetRange(min, max *int64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		names.AttrMax: aws.Int64Value(max),
+		names.AttrMin: aws.Int64Value(min),
+	}}
+}
+
+func flattenFleetAmountCapabilities(apiObjects []*deadline.FleetAmountCapability) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrMax:  aws.Float64Value(apiObject.Max),
+			names.AttrMin:  aws.Float64Value(apiObject.Min),
+			names.AttrName: aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenFleetAttributeCapabilities(apiObjects []*deadline.FleetAttributeCapability) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:   aws.StringValue(apiObject.Name),
+			names.AttrValues: aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,482 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_deadline_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+func resourceQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueCreate,
+		ReadWithoutTimeout:   resourceQueueRead,
+		UpdateWithoutTimeout: resourceQueueUpdate,
+		DeleteWithoutTimeout: resourceQueueDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_storage_profile_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_budget_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      deadline.DefaultQueueBudgetActionNone,
+				ValidateFunc: validation.StringInSlice(deadline.DefaultQueueBudgetAction_Values(), false),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_attachment_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"root_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 63),
+						},
+						"s3_bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+					},
+				},
+			},
+			"job_run_as_user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"posix": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"run_as": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(deadline.RunAs_Values(), false),
+						},
+						"windows": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"required_file_system_location_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID := d.Get("farm_id").(string)
+	name := d.Get(names.AttrDisplayName).(string)
+	input := &deadline.CreateQueueInput{
+		ClientToken:         aws.String(id.UniqueId()),
+		DefaultBudgetAction: aws.String(d.Get("default_budget_action").(string)),
+		DisplayName:         aws.String(name),
+		FarmId:              aws.String(farmID),
+		Tags:                getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("allowed_storage_profile_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.AllowedStorageProfileIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_attachment_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobAttachmentSettings = expandJobAttachmentSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("job_run_as_user"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobRunAsUser = expandJobRunAsUser(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("required_file_system_location_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.RequiredFileSystemLocationNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrRoleARN); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateQueueWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Queue (%s): %s", name, err)
+	}
+
+	d.SetId(queueCreateResourceID(farmID, aws.StringValue(output.QueueId)))
+
+	return append(diags, resourceQueueRead(ctx, d, meta)...)
+}
+
+func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, queueID, err := queueParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findQueueByTwoPartKey(ctx, conn, farmID, queueID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Queue (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allowed_storage_profile_ids", aws.StringValueSlice(output.AllowedStorageProfileIds))
+	d.Set(names.AttrARN, queueARN(meta.(*conns.AWSClient), farmID, queueID))
+	d.Set("default_budget_action", output.DefaultBudgetAction)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrDisplayName, output.DisplayName)
+	d.Set("farm_id", output.FarmId)
+	if err := d.Set("job_attachment_settings", flattenJobAttachmentSettings(output.JobAttachmentSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting job_attachment_settings: %s", err)
+	}
+	if err := d.Set("job_run_as_user", flattenJobRunAsUser(output.JobRunAsUser)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting job_run_as_user: %s", err)
+	}
+	d.Set("queue_id", output.QueueId)
+	d.Set("required_file_system_location_names", aws.StringValueSlice(output.RequiredFileSystemLocationNames))
+	d.Set(names.AttrRoleARN, output.RoleArn)
+
+	return diags
+}
+
+func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		farmID, queueID, err := queueParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &deadline.UpdateQueueInput{
+			ClientToken: aws.String(id.UniqueId()),
+			FarmId:      aws.String(farmID),
+			QueueId:     aws.String(queueID),
+		}
+
+		if d.HasChange("allowed_storage_profile_ids") {
+			o, n := d.GetChange("allowed_storage_profile_ids")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AllowedStorageProfileIdsToAdd = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.AllowedStorageProfileIdsToRemove = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("default_budget_action") {
+			input.DefaultBudgetAction = aws.String(d.Get("default_budget_action").(string))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		if d.HasChange("job_attachment_settings") {
+			if v, ok := d.GetOk("job_attachment_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.JobAttachmentSettings = expandJobAttachmentSettings(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("job_run_as_user") {
+			if v, ok := d.GetOk("job_run_as_user"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.JobRunAsUser = expandJobRunAsUser(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("required_file_system_location_names") {
+			o, n := d.GetChange("required_file_system_location_names")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.RequiredFileSystemLocationNamesToAdd = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.RequiredFileSystemLocationNamesToRemove = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange(names.AttrRoleARN) {
+			input.RoleArn = aws.String(d.Get(names.AttrRoleARN).(string))
+		}
+
+		_, err = conn.UpdateQueueWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Queue (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceQueueRead(ctx, d, meta)...)
+}
+
+func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, queueID, err := queueParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Queue: %s", d.Id())
+	_, err = conn.DeleteQueueWithContext(ctx, &deadline.DeleteQueueInput{
+		FarmId:  aws.String(farmID),
+		QueueId: aws.String(queueID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Queue (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const queueResourceIDPartCount = 2
+
+func queueCreateResourceID(farmID, queueID string) string {
+	parts := []string{farmID, queueID}
+
+	return errs.Must(flex.FlattenResourceId(parts, queueResourceIDPartCount, false))
+}
+
+func queueParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, queueResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findQueueByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, queueID string) (*deadline.GetQueueOutput, error) {
+	input := &deadline.GetQueueInput{
+		FarmId:  aws.String(farmID),
+		QueueId: aws.String(queueID),
+	}
+
+	output, err := conn.GetQueueWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func queueARN(c *conns.AWSClient, farmID, queueID string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   deadline.ServiceName,
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  fmt.Sprintf("farm/%s/queue/%s", farmID, queueID),
+	}.String()
+}
+
+func expandJobAttachmentSettings(tfMap map[string]interface{}) *deadline.JobAttachmentSettings {
+	return &deadline.JobAttachmentSettings{
+		RootPrefix:   aws.String(tfMap["root_prefix"].(string)),
+		S3BucketName: aws.String(tfMap["s3_bucket_name"].(string)),
+	}
+}
+
+func flattenJobAttachmentSettings(apiObject *deadline.JobAttachmentSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"root_prefix":    aws.StringValue(apiObject.RootPrefix),
+		"s3_bucket_name": aws.StringValue(apiObject.S3BucketName),
+	}}
+}
+
+func expandJobRunAsUser(tfMap map[string]interface{}) *deadline.JobRunAsUser {
+	apiObject := &deadline.JobRunAsUser{
+		RunAs: aws.String(tfMap["run_as"].(string)),
+	}
+
+	if v, ok := tfMap["posix"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Posix = &deadline.PosixUser{
+			Group: aws.String(tfMap["group"].(string)),
+			User:  aws.String(tfMap["user"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["windows"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Windows = &deadline.WindowsUser{
+			PasswordArn: aws.String(tfMap["password_arn"].(string)),
+			User:        aws.String(tfMap["user"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenJobRunAsUser(apiObject *deadline.JobRunAsUser) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"run_as": aws.StringValue(apiObject.RunAs),
+	}
+
+	if v := apiObject.Posix; v != nil {
+		tfMap["posix"] = []interface{}{map[string]interface{}{
+			"group": aws.StringValue(v.Group),
+			"user":  aws.StringValue(v.User),
+		}}
+	}
+
+	if v := apiObject.Windows; v != nil {
+		tfMap["windows"] = []interface{}{map[string]interface{}{
+			"password_arn": aws.StringValue(v.PasswordArn),
+			"user":         aws.StringValue(v.User),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
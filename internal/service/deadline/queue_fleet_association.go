@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_deadline_queue_fleet_association", name="Queue Fleet Association")
+func resourceQueueFleetAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueFleetAssociationCreate,
+		ReadWithoutTimeout:   resourceQueueFleetAssociationRead,
+		DeleteWithoutTimeout: resourceQueueFleetAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceQueueFleetAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, queueID, fleetID := d.Get("farm_id").(string), d.Get("queue_id").(string), d.Get("fleet_id").(string)
+	id := queueFleetAssociationCreateResourceID(farmID, queueID, fleetID)
+	input := &deadline.CreateQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+	}
+
+	_, err := conn.CreateQueueFleetAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Queue Fleet Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceQueueFleetAssociationRead(ctx, d, meta)...)
+}
+
+func resourceQueueFleetAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, queueID, fleetID, err := queueFleetAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findQueueFleetAssociationByThreePartKey(ctx, conn, farmID, queueID, fleetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Queue Fleet Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Queue Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("farm_id", farmID)
+	d.Set("fleet_id", output.FleetId)
+	d.Set("queue_id", output.QueueId)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceQueueFleetAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, queueID, fleetID, err := queueFleetAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// An association must be stopped before it can be deleted.
+	_, err = conn.UpdateQueueFleetAssociationWithContext(ctx, &deadline.UpdateQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+		Status:  aws.String(deadline.UpdateQueueFleetAssociationStatusStopSchedulingAndCancelTasks),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping Deadline Cloud Queue Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitQueueFleetAssociationStopped(ctx, conn, farmID, queueID, fleetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Queue Fleet Association (%s) stop: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Queue Fleet Association: %s", d.Id())
+	_, err = conn.DeleteQueueFleetAssociationWithContext(ctx, &deadline.DeleteQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Queue Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const queueFleetAssociationResourceIDPartCount = 3
+
+func queueFleetAssociationCreateResourceID(farmID, queueID, fleetID string) string {
+	parts := []string{farmID, queueID, fleetID}
+
+	return errs.Must(flex.FlattenResourceId(parts, queueFleetAssociationResourceIDPartCount, false))
+}
+
+func queueFleetAssociationParseResourceID(id string) (string, string, string, error) {
+	parts, err := flex.ExpandResourceId(id, queueFleetAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func findQueueFleetAssociationByThreePartKey(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string) (*deadline.GetQueueFleetAssociationOutput, error) {
+	input := &deadline.GetQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+	}
+
+	output, err := conn.GetQueueFleetAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusQueueFleetAssociation(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueueFleetAssociationByThreePartKey(ctx, conn, farmID, queueID, fleetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitQueueFleetAssociationStopped(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string, timeout time.Duration) (*deadline.GetQueueFleetAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			deadline.QueueFleetAssociationStatusActive,
+			deadline.QueueFleetAssociationStatusStopSchedulingAndCancelTasks,
+			deadline.QueueFleetAssociationStatusStopSchedulingAndCompleteTasks,
+		},
+		Target:  []string{deadline.QueueFleetAssociationStatusStopped},
+		Refresh: statusQueueFleetAssociation(ctx, conn, farmID, queueID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*deadline.GetQueueFleetAssociationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
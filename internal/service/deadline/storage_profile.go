@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_deadline_storage_profile", name="Storage Profile")
+func resourceStorageProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStorageProfileCreate,
+		ReadWithoutTimeout:   resourceStorageProfileRead,
+		UpdateWithoutTimeout: resourceStorageProfileUpdate,
+		DeleteWithoutTimeout: resourceStorageProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_system_location": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						names.AttrPath: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(deadline.FileSystemLocationType_Values(), false),
+						},
+					},
+				},
+			},
+			"os_family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(deadline.StorageProfileOperatingSystemFamily_Values(), false),
+			},
+			"storage_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID := d.Get("farm_id").(string)
+	name := d.Get(names.AttrDisplayName).(string)
+	input := &deadline.CreateStorageProfileInput{
+		ClientToken: aws.String(id.UniqueId()),
+		DisplayName: aws.String(name),
+		FarmId:      aws.String(farmID),
+		OsFamily:    aws.String(d.Get("os_family").(string)),
+	}
+
+	if v, ok := d.GetOk("file_system_location"); ok && v.(*schema.Set).Len() > 0 {
+		input.FileSystemLocations = expandFileSystemLocations(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateStorageProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Storage Profile (%s): %s", name, err)
+	}
+
+	d.SetId(storageProfileCreateResourceID(farmID, aws.StringValue(output.StorageProfileId)))
+
+	return append(diags, resourceStorageProfileRead(ctx, d, meta)...)
+}
+
+func resourceStorageProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, storageProfileID, err := storageProfileParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findStorageProfileByTwoPartKey(ctx, conn, farmID, storageProfileID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Storage Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Storage Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDisplayName, output.DisplayName)
+	d.Set("farm_id", farmID)
+	if err := d.Set("file_system_location", flattenFileSystemLocations(output.FileSystemLocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting file_system_location: %s", err)
+	}
+	d.Set("os_family", output.OsFamily)
+	d.Set("storage_profile_id", output.StorageProfileId)
+
+	return diags
+}
+
+func resourceStorageProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, storageProfileID, err := storageProfileParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &deadline.UpdateStorageProfileInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		FarmId:           aws.String(farmID),
+		StorageProfileId: aws.String(storageProfileID),
+	}
+
+	if d.HasChange(names.AttrDisplayName) {
+		input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+	}
+
+	if d.HasChange("file_system_location") {
+		o, n := d.GetChange("file_system_location")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			input.FileSystemLocationsToAdd = expandFileSystemLocations(add)
+		}
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			input.FileSystemLocationsToRemove = expandFileSystemLocations(del)
+		}
+	}
+
+	if d.HasChange("os_family") {
+		input.OsFamily = aws.String(d.Get("os_family").(string))
+	}
+
+	_, err = conn.UpdateStorageProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Storage Profile (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceStorageProfileRead(ctx, d, meta)...)
+}
+
+func resourceStorageProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID, storageProfileID, err := storageProfileParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Storage Profile: %s", d.Id())
+	_, err = conn.DeleteStorageProfileWithContext(ctx, &deadline.DeleteStorageProfileInput{
+		FarmId:           aws.String(farmID),
+		StorageProfileId: aws.String(storageProfileID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Storage Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const storageProfileResourceIDPartCount = 2
+
+func storageProfileCreateResourceID(farmID, storageProfileID string) string {
+	parts := []string{farmID, storageProfileID}
+
+	return errs.Must(flex.FlattenResourceId(parts, storageProfileResourceIDPartCount, false))
+}
+
+func storageProfileParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, storageProfileResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findStorageProfileByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, storageProfileID string) (*deadline.GetStorageProfileOutput, error) {
+	input := &deadline.GetStorageProfileInput{
+		FarmId:           aws.String(farmID),
+		StorageProfileId: aws.String(storageProfileID),
+	}
+
+	output, err := conn.GetStorageProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandFileSystemLocations(tfList []interface{}) []*deadline.FileSystemLocation {
+	var apiObjects []*deadline.FileSystemLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &deadline.FileSystemLocation{
+			Name: aws.String(tfMap[names.AttrName].(string)),
+			Path: aws.String(tfMap[names.AttrPath].(string)),
+			Type: aws.String(tfMap[names.AttrType].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFileSystemLocations(apiObjects []*deadline.FileSystemLocation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.StringValue(apiObject.Name),
+			names.AttrPath: aws.StringValue(apiObject.Path),
+			names.AttrType: aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
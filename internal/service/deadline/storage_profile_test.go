@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeadlineStorageProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetStorageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_storage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeadlineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageProfileConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "file_system_location.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "file_system_location.*", map[string]string{
+						names.AttrName: "shared",
+						names.AttrPath: "/mnt/shared",
+						names.AttrType: deadline.FileSystemLocationTypeShared,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "os_family", deadline.StorageProfileOperatingSystemFamilyLinux),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_profile_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineStorageProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetStorageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_storage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeadlineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageProfileExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceStorageProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineStorageProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetStorageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_storage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeadlineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageProfileConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "file_system_location.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccStorageProfileConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "file_system_location.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "file_system_location.*", map[string]string{
+						names.AttrName: "local",
+						names.AttrPath: "/mnt/local",
+						names.AttrType: deadline.FileSystemLocationTypeLocal,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "file_system_location.*", map[string]string{
+						names.AttrName: "shared",
+						names.AttrPath: "/mnt/shared2",
+						names.AttrType: deadline.FileSystemLocationTypeShared,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStorageProfileExists(ctx context.Context, n string, v *deadline.GetStorageProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		farmID, storageProfileID, err := tfdeadline.StorageProfileParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		output, err := tfdeadline.FindStorageProfileByTwoPartKey(ctx, conn, farmID, storageProfileID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStorageProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_storage_profile" {
+				continue
+			}
+
+			farmID, storageProfileID, err := tfdeadline.StorageProfileParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfdeadline.FindStorageProfileByTwoPartKey(ctx, conn, farmID, storageProfileID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Deadline Cloud Storage Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccStorageProfileConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFarmConfig_basic(rName), fmt.Sprintf(`
+resource "aws_deadline_storage_profile" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+  os_family    = "LINUX"
+
+  file_system_location {
+    name = "shared"
+    path = "/mnt/shared"
+    type = "SHARED"
+  }
+}
+`, rName))
+}
+
+func testAccStorageProfileConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccFarmConfig_basic(rName), fmt.Sprintf(`
+resource "aws_deadline_storage_profile" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+  os_family    = "LINUX"
+
+  file_system_location {
+    name = "shared"
+    path = "/mnt/shared2"
+    type = "SHARED"
+  }
+
+  file_system_location {
+    name = "local"
+    path = "/mnt/local"
+    type = "LOCAL"
+  }
+}
+`, rName))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -96,10 +97,10 @@ func (r *resourceEventSourcesConfig) Create(ctx context.Context, req resource.Cr
 
 	_, err := conn.UpdateEventSourcesConfig(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DevOpsGuru, create.ErrActionCreating, ResNameEventSourcesConfig, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -121,10 +122,10 @@ func (r *resourceEventSourcesConfig) Read(ctx context.Context, req resource.Read
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DevOpsGuru, create.ErrActionSetting, ResNameEventSourcesConfig, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -155,10 +156,10 @@ func (r *resourceEventSourcesConfig) Delete(ctx context.Context, req resource.De
 
 	_, err := conn.UpdateEventSourcesConfig(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DevOpsGuru, create.ErrActionDeleting, ResNameEventSourcesConfig, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
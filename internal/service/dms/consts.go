@@ -182,3 +182,13 @@ const (
 	eventSubscriptionStatusDeleting  = "deleting"
 	eventSubscriptionStatusModifying = "modifying"
 )
+
+const (
+	replicationTaskAssessmentRunStatusDeleting     = "deleting"
+	replicationTaskAssessmentRunStatusFailed       = "failed"
+	replicationTaskAssessmentRunStatusPassed       = "passed"
+	replicationTaskAssessmentRunStatusProvisioning = "provisioning"
+	replicationTaskAssessmentRunStatusRunning      = "running"
+	replicationTaskAssessmentRunStatusStarting     = "starting"
+	replicationTaskAssessmentRunStatusWarning      = "warning"
+)
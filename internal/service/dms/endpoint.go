@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -124,6 +127,7 @@ func ResourceEndpoint() *schema.Resource {
 				Computed:         true,
 				Optional:         true,
 				DiffSuppressFunc: suppressExtraConnectionAttributesDiffs,
+				Deprecated:       "extra_connection_attributes is deprecated. Use the engine-specific settings block (e.g. mysql_settings, oracle_settings or postgres_settings) instead.",
 			},
 			"kafka_settings": {
 				Type:             schema.TypeList,
@@ -331,6 +335,260 @@ func ResourceEndpoint() *schema.Resource {
 					},
 				},
 			},
+			"mysql_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_connect_script": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"clean_source_metadata_on_mismatch": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"events_poll_interval": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"execute_timeout": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"max_file_size": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"parallel_load_threads": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"server_timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"target_db_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.TargetDbType_Values(), false),
+						},
+					},
+				},
+			},
+			"oracle_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_alternate_directly": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"add_supplemental_logging": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"additional_archived_log_dest_id": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"allow_select_nested_tables": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"archived_log_dest_id": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"archived_logs_only": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"char_length_semantics": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.CharLengthSemantics_Values(), false),
+						},
+						"convert_timestamp_with_zone_to_utc": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"direct_path_no_log": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"direct_path_parallel_load": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"enable_homogenous_tablespace": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"extra_archived_log_dest_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"fail_tasks_on_lob_truncation": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"number_datatype_scale": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"open_transaction_window": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"oracle_path_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"parallel_asm_read_threads": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"read_ahead_blocks": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"read_table_space_name": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"replace_path_prefix": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"retry_interval": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"spatial_data_option_to_geo_json_function_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"standby_delay_time": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableInt,
+						},
+						"trim_space_in_char": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"use_alternate_folder_for_online": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"use_b_file": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"use_direct_path_full_load": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"use_logminer_reader": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
+						"use_path_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrPassword: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -806,23 +1064,26 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	switch d.Get("engine_name").(string) {
 	case engineNameAurora, engineNameMariadb, engineNameMySQL:
+		settings := &dms.MySQLSettings{}
+		if v, ok := d.GetOk("mysql_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			settings = expandMySQLSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
-			input.MySQLSettings = &dms.MySQLSettings{
-				SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-				SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-			}
+			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
-			input.MySQLSettings = &dms.MySQLSettings{
-				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
-				ServerName:   aws.String(d.Get("server_name").(string)),
-				Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-			}
+			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.ServerName = aws.String(d.Get("server_name").(string))
+			settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		input.MySQLSettings = settings
 	case engineNameAuroraPostgresql, engineNamePostgres:
 		settings := &dms.PostgreSQLSettings{}
 		if _, ok := d.GetOk("postgres_settings"); ok {
@@ -888,24 +1149,27 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 		input.MongoDbSettings = settings
 	case engineNameOracle:
+		settings := &dms.OracleSettings{}
+		if v, ok := d.GetOk("oracle_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			settings = expandOracleSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
-			input.OracleSettings = &dms.OracleSettings{
-				SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-				SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-				DatabaseName:                aws.String(d.Get(names.AttrDatabaseName).(string)),
-			}
+			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
+			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 		} else {
-			input.OracleSettings = &dms.OracleSettings{
-				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
-				ServerName:   aws.String(d.Get("server_name").(string)),
-				Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-			}
+			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.ServerName = aws.String(d.Get("server_name").(string))
+			settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		input.OracleSettings = settings
 	case engineNameRedis:
 		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
 	case engineNameRedshift:
@@ -1105,49 +1369,55 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			case engineNameAurora, engineNameMariadb, engineNameMySQL:
 				if d.HasChanges(
 					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
-					"secrets_manager_arn") {
+					"secrets_manager_arn", "mysql_settings") {
+					settings := &dms.MySQLSettings{}
+					if v, ok := d.GetOk("mysql_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+						settings = expandMySQLSettings(v.([]interface{})[0].(map[string]interface{}))
+					}
+
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
-						input.MySQLSettings = &dms.MySQLSettings{
-							SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-							SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-						}
+						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						input.MySQLSettings = &dms.MySQLSettings{
-							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
-							ServerName:   aws.String(d.Get("server_name").(string)),
-							Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-						}
+						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+						settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+						settings.ServerName = aws.String(d.Get("server_name").(string))
+						settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 						input.EngineName = aws.String(engineName)
 
 						// Update connection info in top-level namespace as well
 						expandTopLevelConnectionInfoModify(d, input)
 					}
+
+					input.MySQLSettings = settings
 				}
 			case engineNameAuroraPostgresql, engineNamePostgres:
 				if d.HasChanges(
 					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
-					"secrets_manager_arn") {
+					"secrets_manager_arn", "postgres_settings") {
+					settings := &dms.PostgreSQLSettings{}
+					if v, ok := d.GetOk("postgres_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+						settings = expandPostgreSQLSettings(v.([]interface{})[0].(map[string]interface{}))
+					}
+
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
-						input.PostgreSQLSettings = &dms.PostgreSQLSettings{
-							DatabaseName:                aws.String(d.Get(names.AttrDatabaseName).(string)),
-							SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-							SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-						}
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
+						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						input.PostgreSQLSettings = &dms.PostgreSQLSettings{
-							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
-							ServerName:   aws.String(d.Get("server_name").(string)),
-							Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-						}
+						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+						settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+						settings.ServerName = aws.String(d.Get("server_name").(string))
+						settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
 						expandTopLevelConnectionInfoModify(d, input)
 					}
+
+					input.PostgreSQLSettings = settings
 				}
 			case engineNameDynamoDB:
 				if d.HasChange("service_access_role") {
@@ -1226,26 +1496,29 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			case engineNameOracle:
 				if d.HasChanges(
 					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
-					"secrets_manager_arn") {
+					"secrets_manager_arn", "oracle_settings") {
+					settings := &dms.OracleSettings{}
+					if v, ok := d.GetOk("oracle_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+						settings = expandOracleSettings(v.([]interface{})[0].(map[string]interface{}))
+					}
+
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
-						input.OracleSettings = &dms.OracleSettings{
-							DatabaseName:                aws.String(d.Get(names.AttrDatabaseName).(string)),
-							SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-							SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-						}
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
+						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						input.OracleSettings = &dms.OracleSettings{
-							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
-							ServerName:   aws.String(d.Get("server_name").(string)),
-							Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-						}
+						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+						settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+						settings.ServerName = aws.String(d.Get("server_name").(string))
+						settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 						input.EngineName = aws.String(engineName) // Must be included (should be 'oracle')
 
 						// Update connection info in top-level namespace as well
 						expandTopLevelConnectionInfoModify(d, input)
 					}
+
+					input.OracleSettings = settings
 				}
 			case engineNameRedis:
 				if d.HasChanges("redis_settings") {
@@ -1550,6 +1823,9 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("mysql_settings", flattenMySQLSettings(endpoint.MySQLSettings)); err != nil {
+			return fmt.Errorf("setting mysql_settings: %w", err)
+		}
 	case engineNameAuroraPostgresql, engineNamePostgres:
 		if endpoint.PostgreSQLSettings != nil {
 			d.Set(names.AttrUsername, endpoint.PostgreSQLSettings.Username)
@@ -1615,6 +1891,9 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("oracle_settings", flattenOracleSettings(endpoint.OracleSettings)); err != nil {
+			return fmt.Errorf("setting oracle_settings: %w", err)
+		}
 	case engineNameRedis:
 		// Auth password isn't returned in API. Propagate state value.
 		tfMap := flattenRedisSettings(endpoint.RedisSettings)
@@ -2281,6 +2560,272 @@ func flattenPostgreSQLSettings(apiObject *dms.PostgreSQLSettings) []map[string]i
 	return []map[string]interface{}{tfMap}
 }
 
+func expandMySQLSettings(tfMap map[string]interface{}) *dms.MySQLSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.MySQLSettings{}
+
+	if v, ok := tfMap["after_connect_script"].(string); ok && v != "" {
+		apiObject.AfterConnectScript = aws.String(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["clean_source_metadata_on_mismatch"].(string)).ValueBool(); !null {
+		apiObject.CleanSourceMetadataOnMismatch = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["events_poll_interval"].(string)).ValueInt64(); !null {
+		apiObject.EventsPollInterval = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["execute_timeout"].(string)).ValueInt64(); !null {
+		apiObject.ExecuteTimeout = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["max_file_size"].(string)).ValueInt64(); !null {
+		apiObject.MaxFileSize = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["parallel_load_threads"].(string)).ValueInt64(); !null {
+		apiObject.ParallelLoadThreads = aws.Int64(v)
+	}
+	if v, ok := tfMap["server_timezone"].(string); ok && v != "" {
+		apiObject.ServerTimezone = aws.String(v)
+	}
+	if v, ok := tfMap["target_db_type"].(string); ok && v != "" {
+		apiObject.TargetDbType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMySQLSettings(apiObject *dms.MySQLSettings) []map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AfterConnectScript; v != nil {
+		tfMap["after_connect_script"] = aws.StringValue(v)
+	}
+	if v := apiObject.CleanSourceMetadataOnMismatch; v != nil {
+		tfMap["clean_source_metadata_on_mismatch"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.EventsPollInterval; v != nil {
+		tfMap["events_poll_interval"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.ExecuteTimeout; v != nil {
+		tfMap["execute_timeout"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.MaxFileSize; v != nil {
+		tfMap["max_file_size"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.ParallelLoadThreads; v != nil {
+		tfMap["parallel_load_threads"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.ServerTimezone; v != nil {
+		tfMap["server_timezone"] = aws.StringValue(v)
+	}
+	if v := apiObject.TargetDbType; v != nil {
+		tfMap["target_db_type"] = aws.StringValue(v)
+	}
+
+	return []map[string]interface{}{tfMap}
+}
+
+func expandOracleSettings(tfMap map[string]interface{}) *dms.OracleSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.OracleSettings{}
+
+	if v, null, _ := nullable.Bool(tfMap["access_alternate_directly"].(string)).ValueBool(); !null {
+		apiObject.AccessAlternateDirectly = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["add_supplemental_logging"].(string)).ValueBool(); !null {
+		apiObject.AddSupplementalLogging = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["additional_archived_log_dest_id"].(string)).ValueInt64(); !null {
+		apiObject.AdditionalArchivedLogDestId = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["allow_select_nested_tables"].(string)).ValueBool(); !null {
+		apiObject.AllowSelectNestedTables = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["archived_log_dest_id"].(string)).ValueInt64(); !null {
+		apiObject.ArchivedLogDestId = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["archived_logs_only"].(string)).ValueBool(); !null {
+		apiObject.ArchivedLogsOnly = aws.Bool(v)
+	}
+	if v, ok := tfMap["char_length_semantics"].(string); ok && v != "" {
+		apiObject.CharLengthSemantics = aws.String(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["convert_timestamp_with_zone_to_utc"].(string)).ValueBool(); !null {
+		apiObject.ConvertTimestampWithZoneToUTC = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["direct_path_no_log"].(string)).ValueBool(); !null {
+		apiObject.DirectPathNoLog = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["direct_path_parallel_load"].(string)).ValueBool(); !null {
+		apiObject.DirectPathParallelLoad = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["enable_homogenous_tablespace"].(string)).ValueBool(); !null {
+		apiObject.EnableHomogenousTablespace = aws.Bool(v)
+	}
+	if v, ok := tfMap["extra_archived_log_dest_ids"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExtraArchivedLogDestIds = flex.ExpandInt64List(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["fail_tasks_on_lob_truncation"].(string)).ValueBool(); !null {
+		apiObject.FailTasksOnLobTruncation = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["number_datatype_scale"].(string)).ValueInt64(); !null {
+		apiObject.NumberDatatypeScale = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["open_transaction_window"].(string)).ValueInt64(); !null {
+		apiObject.OpenTransactionWindow = aws.Int64(v)
+	}
+	if v, ok := tfMap["oracle_path_prefix"].(string); ok && v != "" {
+		apiObject.OraclePathPrefix = aws.String(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["parallel_asm_read_threads"].(string)).ValueInt64(); !null {
+		apiObject.ParallelAsmReadThreads = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["read_ahead_blocks"].(string)).ValueInt64(); !null {
+		apiObject.ReadAheadBlocks = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["read_table_space_name"].(string)).ValueBool(); !null {
+		apiObject.ReadTableSpaceName = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["replace_path_prefix"].(string)).ValueBool(); !null {
+		apiObject.ReplacePathPrefix = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["retry_interval"].(string)).ValueInt64(); !null {
+		apiObject.RetryInterval = aws.Int64(v)
+	}
+	if v, ok := tfMap["spatial_data_option_to_geo_json_function_name"].(string); ok && v != "" {
+		apiObject.SpatialDataOptionToGeoJsonFunctionName = aws.String(v)
+	}
+	if v, null, _ := nullable.Int(tfMap["standby_delay_time"].(string)).ValueInt64(); !null {
+		apiObject.StandbyDelayTime = aws.Int64(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["trim_space_in_char"].(string)).ValueBool(); !null {
+		apiObject.TrimSpaceInChar = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["use_alternate_folder_for_online"].(string)).ValueBool(); !null {
+		apiObject.UseAlternateFolderForOnline = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["use_b_file"].(string)).ValueBool(); !null {
+		apiObject.UseBFile = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["use_direct_path_full_load"].(string)).ValueBool(); !null {
+		apiObject.UseDirectPathFullLoad = aws.Bool(v)
+	}
+	if v, null, _ := nullable.Bool(tfMap["use_logminer_reader"].(string)).ValueBool(); !null {
+		apiObject.UseLogminerReader = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_path_prefix"].(string); ok && v != "" {
+		apiObject.UsePathPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOracleSettings(apiObject *dms.OracleSettings) []map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessAlternateDirectly; v != nil {
+		tfMap["access_alternate_directly"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.AddSupplementalLogging; v != nil {
+		tfMap["add_supplemental_logging"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.AdditionalArchivedLogDestId; v != nil {
+		tfMap["additional_archived_log_dest_id"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.AllowSelectNestedTables; v != nil {
+		tfMap["allow_select_nested_tables"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.ArchivedLogDestId; v != nil {
+		tfMap["archived_log_dest_id"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.ArchivedLogsOnly; v != nil {
+		tfMap["archived_logs_only"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.CharLengthSemantics; v != nil {
+		tfMap["char_length_semantics"] = aws.StringValue(v)
+	}
+	if v := apiObject.ConvertTimestampWithZoneToUTC; v != nil {
+		tfMap["convert_timestamp_with_zone_to_utc"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.DirectPathNoLog; v != nil {
+		tfMap["direct_path_no_log"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.DirectPathParallelLoad; v != nil {
+		tfMap["direct_path_parallel_load"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.EnableHomogenousTablespace; v != nil {
+		tfMap["enable_homogenous_tablespace"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.ExtraArchivedLogDestIds; v != nil {
+		tfMap["extra_archived_log_dest_ids"] = flex.FlattenInt64List(v)
+	}
+	if v := apiObject.FailTasksOnLobTruncation; v != nil {
+		tfMap["fail_tasks_on_lob_truncation"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.NumberDatatypeScale; v != nil {
+		tfMap["number_datatype_scale"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.OpenTransactionWindow; v != nil {
+		tfMap["open_transaction_window"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.OraclePathPrefix; v != nil {
+		tfMap["oracle_path_prefix"] = aws.StringValue(v)
+	}
+	if v := apiObject.ParallelAsmReadThreads; v != nil {
+		tfMap["parallel_asm_read_threads"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.ReadAheadBlocks; v != nil {
+		tfMap["read_ahead_blocks"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.ReadTableSpaceName; v != nil {
+		tfMap["read_table_space_name"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.ReplacePathPrefix; v != nil {
+		tfMap["replace_path_prefix"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.RetryInterval; v != nil {
+		tfMap["retry_interval"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.SpatialDataOptionToGeoJsonFunctionName; v != nil {
+		tfMap["spatial_data_option_to_geo_json_function_name"] = aws.StringValue(v)
+	}
+	if v := apiObject.StandbyDelayTime; v != nil {
+		tfMap["standby_delay_time"] = flex.Int64ToStringValue(v)
+	}
+	if v := apiObject.TrimSpaceInChar; v != nil {
+		tfMap["trim_space_in_char"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.UseAlternateFolderForOnline; v != nil {
+		tfMap["use_alternate_folder_for_online"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.UseBFile; v != nil {
+		tfMap["use_b_file"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.UseDirectPathFullLoad; v != nil {
+		tfMap["use_direct_path_full_load"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.UseLogminerReader; v != nil {
+		tfMap["use_logminer_reader"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := apiObject.UsePathPrefix; v != nil {
+		tfMap["use_path_prefix"] = aws.StringValue(v)
+	}
+
+	return []map[string]interface{}{tfMap}
+}
+
 func expandS3Settings(tfMap map[string]interface{}) *dms.S3Settings {
 	if tfMap == nil {
 		return nil
@@ -2534,7 +3079,7 @@ func suppressExtraConnectionAttributesDiffs(_, old, new string, d *schema.Resour
 		n := extraConnectionAttributesToSet(new)
 
 		var config *schema.Set
-		// when the engine is "s3" or "mongodb", the extra_connection_attributes
+		// when the engine is "s3", "mongodb", "mysql", "oracle" or "postgres", the extra_connection_attributes
 		// can consist of a subset of the attributes configured in the {engine}_settings block;
 		// fields such as service_access_role_arn (in the case of "s3") are not returned from the API in
 		// extra_connection_attributes thus we take the Set difference to ensure
@@ -2544,6 +3089,12 @@ func suppressExtraConnectionAttributesDiffs(_, old, new string, d *schema.Resour
 			config = engineSettingsToSet(v.([]interface{}))
 		} else if v, ok := d.GetOk("s3_settings"); ok {
 			config = engineSettingsToSet(v.([]interface{}))
+		} else if v, ok := d.GetOk("mysql_settings"); ok {
+			config = engineSettingsToSet(v.([]interface{}))
+		} else if v, ok := d.GetOk("oracle_settings"); ok {
+			config = engineSettingsToSet(v.([]interface{}))
+		} else if v, ok := d.GetOk("postgres_settings"); ok {
+			config = engineSettingsToSet(v.([]interface{}))
 		}
 
 		if o != nil && config != nil {
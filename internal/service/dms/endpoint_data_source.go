@@ -233,6 +233,171 @@ func DataSourceEndpoint() *schema.Resource {
 					},
 				},
 			},
+			"mysql_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_connect_script": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"clean_source_metadata_on_mismatch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"events_poll_interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"execute_timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_file_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parallel_load_threads": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_db_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"oracle_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_alternate_directly": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"add_supplemental_logging": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"additional_archived_log_dest_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allow_select_nested_tables": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"archived_log_dest_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"archived_logs_only": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"char_length_semantics": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"convert_timestamp_with_zone_to_utc": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct_path_no_log": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct_path_parallel_load": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_homogenous_tablespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"extra_archived_log_dest_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"fail_tasks_on_lob_truncation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number_datatype_scale": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"open_transaction_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oracle_path_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parallel_asm_read_threads": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"read_ahead_blocks": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"read_table_space_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replace_path_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retry_interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spatial_data_option_to_geo_json_function_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"standby_delay_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trim_space_in_char": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_alternate_folder_for_online": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_b_file": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_direct_path_full_load": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_logminer_reader": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_path_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrPassword: {
 				Type:     schema.TypeString,
 				Computed: true,
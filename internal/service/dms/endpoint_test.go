@@ -1156,6 +1156,47 @@ func TestAccDMSEndpoint_MySQL_update(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_MySQL_settings(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_mySQLSettings(rName, "Europe/Paris", 2, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.clean_source_metadata_on_mismatch", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.events_poll_interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.parallel_load_threads", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.server_timezone", "Europe/Paris"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrPassword},
+			},
+			{
+				Config: testAccEndpointConfig_mySQLSettings(rName, "UTC", 4, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.clean_source_metadata_on_mismatch", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.parallel_load_threads", "4"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.server_timezone", "UTC"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_Oracle_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -1260,6 +1301,46 @@ func TestAccDMSEndpoint_Oracle_update(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_Oracle_settings(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_oracleSettings(rName, true, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.add_supplemental_logging", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.char_length_semantics", "char"),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.number_datatype_scale", "10"),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.use_logminer_reader", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrPassword},
+			},
+			{
+				Config: testAccEndpointConfig_oracleSettings(rName, false, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.add_supplemental_logging", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.number_datatype_scale", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_PostgreSQL_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -1416,6 +1497,43 @@ func TestAccDMSEndpoint_PostgreSQL_settings_source(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_PostgreSQL_settings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_postgreSQLSettingsUpdate(rName, 100, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.execute_timeout", "100"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.slot_name", "test1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrPassword},
+			},
+			{
+				Config: testAccEndpointConfig_postgreSQLSettingsUpdate(rName, 200, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.execute_timeout", "200"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.slot_name", "test2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_PostgreSQL_settings_target(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -3778,6 +3896,29 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_mySQLSettings(rName, serverTimezone string, parallelLoadThreads int, cleanSourceMetadataOnMismatch bool) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "target"
+  engine_name   = "mysql"
+  server_name   = "tftest"
+  port          = 3306
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "none"
+
+  mysql_settings {
+    clean_source_metadata_on_mismatch = %[4]t
+    events_poll_interval              = 5
+    parallel_load_threads             = %[3]d
+    server_timezone                   = %[2]q
+  }
+}
+`, rName, serverTimezone, parallelLoadThreads, cleanSourceMetadataOnMismatch)
+}
+
 func testAccEndpointConfig_oracle(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
@@ -3845,6 +3986,29 @@ resource "aws_dms_endpoint" "test" {
 `, rName))
 }
 
+func testAccEndpointConfig_oracleSettings(rName string, addSupplementalLogging bool, numberDatatypeScale int) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "oracle"
+  server_name   = "tftest"
+  port          = 1521
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "none"
+
+  oracle_settings {
+    add_supplemental_logging = %[2]t
+    char_length_semantics    = "char"
+    number_datatype_scale    = %[3]d
+    use_logminer_reader      = true
+  }
+}
+`, rName, addSupplementalLogging, numberDatatypeScale)
+}
+
 func testAccEndpointConfig_postgreSQL(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
@@ -3947,6 +4111,27 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_postgreSQLSettingsUpdate(rName string, executeTimeout int, slotName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "postgres"
+  server_name   = "tftest"
+  port          = 5432
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "require"
+
+  postgres_settings {
+    execute_timeout = %[2]d
+    slot_name       = %[3]q
+  }
+}
+`, rName, executeTimeout, slotName)
+}
+
 func testAccEndpointConfig_postgreSQLTargetSettings(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
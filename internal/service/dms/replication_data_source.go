@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dms_replication")
+func DataSourceReplication() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"failure_messages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"provision_data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date_new_provisioning_data_available": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_provisioned": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_new_provisioning_available": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"provision_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioned_capacity_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason_for_new_provisioning_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"replication_config_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"replication_config_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_stats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"elapsed_time_millis": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"full_load_progress_percent": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tables_errored": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tables_loaded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tables_loading": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tables_queued": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"replication_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_replication_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stop_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	arn := d.Get("replication_config_arn").(string)
+	replication, err := findReplicationByReplicationConfigARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.StringValue(replication.ReplicationConfigArn))
+	d.Set("failure_messages", aws.StringValueSlice(replication.FailureMessages))
+	if err := d.Set("provision_data", flattenProvisionData(replication.ProvisionData)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting provision_data: %s", err)
+	}
+	d.Set("replication_config_arn", replication.ReplicationConfigArn)
+	d.Set("replication_config_identifier", replication.ReplicationConfigIdentifier)
+	if err := d.Set("replication_stats", flattenReplicationStats(replication.ReplicationStats)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_stats: %s", err)
+	}
+	d.Set("replication_type", replication.ReplicationType)
+	d.Set("start_replication_type", replication.StartReplicationType)
+	d.Set(names.AttrStatus, replication.Status)
+	d.Set("stop_reason", replication.StopReason)
+
+	return diags
+}
+
+func flattenProvisionData(apiObject *dms.ProvisionData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"is_new_provisioning_available":    aws.BoolValue(apiObject.IsNewProvisioningAvailable),
+		"provision_state":                  aws.StringValue(apiObject.ProvisionState),
+		"provisioned_capacity_units":       aws.Int64Value(apiObject.ProvisionedCapacityUnits),
+		"reason_for_new_provisioning_data": aws.StringValue(apiObject.ReasonForNewProvisioningData),
+	}
+
+	if v := apiObject.DateNewProvisioningDataAvailable; v != nil {
+		tfMap["date_new_provisioning_data_available"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.DateProvisioned; v != nil {
+		tfMap["date_provisioned"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenReplicationStats(apiObject *dms.ReplicationStats) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"elapsed_time_millis":        aws.Int64Value(apiObject.ElapsedTimeMillis),
+		"full_load_progress_percent": aws.Int64Value(apiObject.FullLoadProgressPercent),
+		"tables_errored":             aws.Int64Value(apiObject.TablesErrored),
+		"tables_loaded":              aws.Int64Value(apiObject.TablesLoaded),
+		"tables_loading":             aws.Int64Value(apiObject.TablesLoading),
+		"tables_queued":              aws.Int64Value(apiObject.TablesQueued),
+	}
+
+	return []interface{}{tfMap}
+}
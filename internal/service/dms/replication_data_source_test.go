@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSReplicationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_replication_config.test"
+	dataSourceName := "data.aws_dms_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_config_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_config_identifier", resourceName, "replication_config_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_type", resourceName, "replication_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "provision_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provision_data.0.provision_state"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provision_data.0.provisioned_capacity_units"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigConfig_startReplication(rName, true), `
+data "aws_dms_replication" "test" {
+  replication_config_arn = aws_dms_replication_config.test.arn
+}
+`)
+}
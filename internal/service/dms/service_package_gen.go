@@ -35,6 +35,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEndpoint,
 			TypeName: "aws_dms_endpoint",
 		},
+		{
+			Factory:  DataSourceReplication,
+			TypeName: "aws_dms_replication",
+		},
 		{
 			Factory:  DataSourceReplicationInstance,
 			TypeName: "aws_dms_replication_instance",
@@ -76,6 +80,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceReplicationAssessmentRun,
+			TypeName: "aws_dms_replication_assessment_run",
+			Name:     "Replication Assessment Run",
+		},
 		{
 			Factory:  ResourceReplicationConfig,
 			TypeName: "aws_dms_replication_config",
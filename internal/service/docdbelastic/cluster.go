@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -81,6 +82,16 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					enum.FrameworkValidate[awstypes.Auth](),
 				},
 			},
+			"backup_retention_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 35),
+				},
+			},
 			names.AttrEndpoint: schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -102,6 +113,13 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"preferred_backup_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrPreferredMaintenanceWindow: schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -118,6 +136,22 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64validator.Between(1, 32),
 				},
 			},
+			"shard_instance_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"snapshot_arn": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrSubnetIDs: schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -161,6 +195,11 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	if !plan.SnapshotARN.IsNull() {
+		r.restore(ctx, plan, response)
+		return
+	}
+
 	input := &docdbelastic.CreateClusterInput{
 		ClientToken:       aws.String(id.UniqueId()),
 		AdminUserName:     flex.StringFromFramework(ctx, plan.AdminUserName),
@@ -172,14 +211,26 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		Tags:              getTagsIn(ctx),
 	}
 
+	if !plan.BackupRetentionPeriod.IsNull() && !plan.BackupRetentionPeriod.IsUnknown() {
+		input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+	}
+
 	if !plan.KmsKeyID.IsNull() || !plan.KmsKeyID.IsUnknown() {
 		input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
 	}
 
+	if !plan.PreferredBackupWindow.IsNull() && !plan.PreferredBackupWindow.IsUnknown() {
+		input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+	}
+
 	if !plan.PreferredMaintenanceWindow.IsNull() || !plan.PreferredMaintenanceWindow.IsUnknown() {
 		input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
 	}
 
+	if !plan.ShardInstanceCount.IsNull() && !plan.ShardInstanceCount.IsUnknown() {
+		input.ShardInstanceCount = flex.Int32FromFramework(ctx, plan.ShardInstanceCount)
+	}
+
 	if !plan.SubnetIds.IsNull() || !plan.SubnetIds.IsUnknown() {
 		input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
 	}
@@ -191,10 +242,10 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 	createOut, err := conn.CreateCluster(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -205,10 +256,112 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 	out, err := waitClusterCreated(ctx, conn, state.ID.ValueString(), createTimeout)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// restore creates the cluster from a snapshot. Settings that can't be specified on restore are applied afterwards.
+func (r *resourceCluster) restore(ctx context.Context, plan resourceClusterData, response *resource.CreateResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	input := &docdbelastic.RestoreClusterFromSnapshotInput{
+		ClusterName:   flex.StringFromFramework(ctx, plan.Name),
+		ShardCapacity: flex.Int32FromFramework(ctx, plan.ShardCapacity),
+		SnapshotArn:   flex.StringFromFramework(ctx, plan.SnapshotARN),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if !plan.KmsKeyID.IsNull() && !plan.KmsKeyID.IsUnknown() {
+		input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
+	}
+
+	if !plan.ShardInstanceCount.IsNull() && !plan.ShardInstanceCount.IsUnknown() {
+		input.ShardInstanceCount = flex.Int32FromFramework(ctx, plan.ShardInstanceCount)
+	}
+
+	if !plan.SubnetIds.IsNull() && !plan.SubnetIds.IsUnknown() {
+		input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
+	}
+
+	if !plan.VpcSecurityGroupIds.IsNull() && !plan.VpcSecurityGroupIds.IsUnknown() {
+		input.VpcSecurityGroupIds = flex.ExpandFrameworkStringValueSet(ctx, plan.VpcSecurityGroupIds)
+	}
+
+	restoreOut, err := conn.RestoreClusterFromSnapshot(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringToFramework(ctx, restoreOut.Cluster.ClusterArn)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	out, err := waitClusterCreated(ctx, conn, state.ID.ValueString(), createTimeout)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+			err,
+		))
+		return
+	}
+
+	updateInput := &docdbelastic.UpdateClusterInput{
+		AdminUserPassword: flex.StringFromFramework(ctx, plan.AdminUserPassword),
+		ClientToken:       aws.String(id.UniqueId()),
+		ClusterArn:        flex.StringFromFramework(ctx, state.ID),
+	}
+
+	if !plan.AuthType.Equal(flex.StringValueToFramework(ctx, string(out.AuthType))) {
+		updateInput.AuthType = awstypes.Auth(plan.AuthType.ValueString())
+	}
+
+	if !plan.BackupRetentionPeriod.IsNull() && !plan.BackupRetentionPeriod.IsUnknown() {
+		updateInput.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+	}
+
+	if !plan.PreferredBackupWindow.IsNull() && !plan.PreferredBackupWindow.IsUnknown() {
+		updateInput.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+	}
+
+	if !plan.PreferredMaintenanceWindow.IsNull() && !plan.PreferredMaintenanceWindow.IsUnknown() {
+		updateInput.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
+	}
+
+	if !plan.ShardCount.Equal(flex.Int32ToFramework(ctx, out.ShardCount)) {
+		updateInput.ShardCount = flex.Int32FromFramework(ctx, plan.ShardCount)
+	}
+
+	_, err = conn.UpdateCluster(ctx, updateInput)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
+			err,
+		))
+		return
+	}
+
+	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+	out, err = waitClusterUpdated(ctx, conn, state.ID.ValueString(), updateTimeout)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForUpdate, ResNameCluster, state.ID.ValueString(), err),
+			err,
+		))
 		return
 	}
 
@@ -235,10 +388,10 @@ func (r *resourceCluster) Read(ctx context.Context, request resource.ReadRequest
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionReading, ResNameCluster, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -276,6 +429,14 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			input.AuthType = awstypes.Auth(plan.AuthType.ValueString())
 		}
 
+		if !plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) {
+			input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+		}
+
+		if !plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+		}
+
 		if !plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) {
 			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
 		}
@@ -288,6 +449,10 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			input.ShardCount = flex.Int32FromFramework(ctx, plan.ShardCount)
 		}
 
+		if !plan.ShardInstanceCount.Equal(state.ShardInstanceCount) {
+			input.ShardInstanceCount = flex.Int32FromFramework(ctx, plan.ShardInstanceCount)
+		}
+
 		if !plan.SubnetIds.Equal(state.SubnetIds) {
 			input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
 		}
@@ -299,10 +464,10 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 		_, err := conn.UpdateCluster(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -310,10 +475,10 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 		out, err := waitClusterUpdated(ctx, conn, state.ID.ValueString(), updateTimeout)
 
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForUpdate, ResNameCluster, state.ID.ValueString(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -349,10 +514,10 @@ func (r *resourceCluster) Delete(ctx context.Context, request resource.DeleteReq
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionDeleting, ResNameCluster, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -360,10 +525,10 @@ func (r *resourceCluster) Delete(ctx context.Context, request resource.DeleteReq
 	_, err = waitClusterDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForDeletion, ResNameCluster, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -381,13 +546,17 @@ type resourceClusterData struct {
 	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
 	ARN                        types.String   `tfsdk:"arn"`
 	AuthType                   types.String   `tfsdk:"auth_type"`
+	BackupRetentionPeriod      types.Int64    `tfsdk:"backup_retention_period"`
 	Endpoint                   types.String   `tfsdk:"endpoint"`
 	ID                         types.String   `tfsdk:"id"`
 	KmsKeyID                   types.String   `tfsdk:"kms_key_id"`
 	Name                       types.String   `tfsdk:"name"`
+	PreferredBackupWindow      types.String   `tfsdk:"preferred_backup_window"`
 	PreferredMaintenanceWindow types.String   `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64    `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64    `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int64    `tfsdk:"shard_instance_count"`
+	SnapshotARN                types.String   `tfsdk:"snapshot_arn"`
 	SubnetIds                  types.Set      `tfsdk:"subnet_ids"`
 	Tags                       types.Map      `tfsdk:"tags"`
 	TagsAll                    types.Map      `tfsdk:"tags_all"`
@@ -415,7 +584,7 @@ func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id strin
 
 func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:                   enum.Slice(awstypes.StatusUpdating),
+		Pending:                   enum.Slice(awstypes.StatusUpdating, awstypes.StatusModifying, awstypes.StatusSplitting, awstypes.StatusMerging),
 		Target:                    enum.Slice(awstypes.StatusActive),
 		Refresh:                   statusCluster(ctx, conn, id),
 		Timeout:                   timeout,
@@ -489,13 +658,16 @@ func findClusterByID(ctx context.Context, conn *docdbelastic.Client, id string)
 func (r *resourceClusterData) refreshFromOutput(ctx context.Context, output *awstypes.Cluster) {
 	r.AdminUserName = flex.StringToFrameworkLegacy(ctx, output.AdminUserName)
 	r.AuthType = flex.StringValueToFramework(ctx, string(output.AuthType))
+	r.BackupRetentionPeriod = flex.Int32ToFramework(ctx, output.BackupRetentionPeriod)
 	r.ARN = flex.StringToFramework(ctx, output.ClusterArn)
 	r.Endpoint = flex.StringToFramework(ctx, output.ClusterEndpoint)
 	r.KmsKeyID = flex.StringToFramework(ctx, output.KmsKeyId)
 	r.Name = flex.StringToFramework(ctx, output.ClusterName)
+	r.PreferredBackupWindow = flex.StringToFramework(ctx, output.PreferredBackupWindow)
 	r.PreferredMaintenanceWindow = flex.StringToFramework(ctx, output.PreferredMaintenanceWindow)
 	r.ShardCapacity = flex.Int32ToFramework(ctx, output.ShardCapacity)
 	r.ShardCount = flex.Int32ToFramework(ctx, output.ShardCount)
+	r.ShardInstanceCount = flex.Int32ToFramework(ctx, output.ShardInstanceCount)
 	r.SubnetIds = flex.FlattenFrameworkStringValueSet(ctx, output.SubnetIds)
 	r.VpcSecurityGroupIds = flex.FlattenFrameworkStringValueSet(ctx, output.VpcSecurityGroupIds)
 }
@@ -504,9 +676,12 @@ func clusterHasChanges(_ context.Context, plan, state resourceClusterData) bool
 	return !plan.Name.Equal(state.Name) ||
 		!plan.AdminUserPassword.Equal(state.AdminUserPassword) ||
 		!plan.AuthType.Equal(state.AuthType) ||
+		!plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) ||
+		!plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) ||
 		!plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) ||
 		!plan.ShardCapacity.Equal(state.ShardCapacity) ||
 		!plan.ShardCount.Equal(state.ShardCount) ||
+		!plan.ShardInstanceCount.Equal(state.ShardInstanceCount) ||
 		!plan.SubnetIds.Equal(state.SubnetIds) ||
 		!plan.VpcSecurityGroupIds.Equal(state.VpcSecurityGroupIds)
 }
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Cluster Snapshot")
+// @Tags(identifierAttribute="arn")
+func newResourceClusterSnapshot(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceClusterSnapshot{}
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceClusterSnapshot struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithNoOpUpdate[resourceClusterSnapshotData]
+}
+
+const (
+	ResNameClusterSnapshot = "Cluster Snapshot"
+)
+
+func (r *resourceClusterSnapshot) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_docdbelastic_cluster_snapshot"
+}
+
+func (r *resourceClusterSnapshot) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_arn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cluster_arn"), path.MatchRoot("source_snapshot_arn")),
+				},
+			},
+			"cluster_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"snapshot_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_snapshot_arn": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCSecurityGroupIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	if s.Blocks == nil {
+		s.Blocks = make(map[string]schema.Block)
+	}
+	s.Blocks[names.AttrTimeouts] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Delete: true,
+	})
+
+	response.Schema = s
+}
+
+func (r *resourceClusterSnapshot) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+	var plan resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var snapshotARN *string
+
+	if !plan.SourceSnapshotARN.IsNull() {
+		input := &docdbelastic.CopyClusterSnapshotInput{
+			SnapshotArn:        flex.StringFromFramework(ctx, plan.SourceSnapshotARN),
+			Tags:               getTagsIn(ctx),
+			TargetSnapshotName: flex.StringFromFramework(ctx, plan.SnapshotName),
+		}
+
+		if !plan.CopyTags.IsNull() {
+			input.CopyTags = flex.BoolFromFramework(ctx, plan.CopyTags)
+		}
+
+		if !plan.KmsKeyID.IsNull() && !plan.KmsKeyID.IsUnknown() {
+			input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
+		}
+
+		out, err := conn.CopyClusterSnapshot(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameClusterSnapshot, plan.SnapshotName.ValueString(), err),
+				err,
+			))
+			return
+		}
+
+		snapshotARN = out.Snapshot.SnapshotArn
+	} else {
+		input := &docdbelastic.CreateClusterSnapshotInput{
+			ClusterArn:   flex.StringFromFramework(ctx, plan.ClusterARN),
+			SnapshotName: flex.StringFromFramework(ctx, plan.SnapshotName),
+			Tags:         getTagsIn(ctx),
+		}
+
+		out, err := conn.CreateClusterSnapshot(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameClusterSnapshot, plan.SnapshotName.ValueString(), err),
+				err,
+			))
+			return
+		}
+
+		snapshotARN = out.Snapshot.SnapshotArn
+	}
+
+	state := plan
+	state.ID = flex.StringToFramework(ctx, snapshotARN)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	out, err := waitClusterSnapshotCreated(ctx, conn, state.ID.ValueString(), createTimeout)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForCreation, ResNameClusterSnapshot, plan.SnapshotName.ValueString(), err),
+			err,
+		))
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceClusterSnapshot) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+	var state resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findClusterSnapshotByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionReading, ResNameClusterSnapshot, state.ID.ValueString(), err),
+			err,
+		))
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceClusterSnapshot) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+	var state resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting DocDB Elastic Cluster Snapshot", map[string]interface{}{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	input := &docdbelastic.DeleteClusterSnapshotInput{
+		SnapshotArn: flex.StringFromFramework(ctx, state.ID),
+	}
+
+	_, err := conn.DeleteClusterSnapshot(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionDeleting, ResNameClusterSnapshot, state.ID.ValueString(), err),
+			err,
+		))
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitClusterSnapshotDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForDeletion, ResNameClusterSnapshot, state.ID.ValueString(), err),
+			err,
+		))
+		return
+	}
+}
+
+func (r *resourceClusterSnapshot) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceClusterSnapshot) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceClusterSnapshotData struct {
+	AdminUserName        types.String   `tfsdk:"admin_user_name"`
+	ARN                  types.String   `tfsdk:"arn"`
+	ClusterARN           types.String   `tfsdk:"cluster_arn"`
+	ClusterCreationTime  types.String   `tfsdk:"cluster_creation_time"`
+	CopyTags             types.Bool     `tfsdk:"copy_tags"`
+	ID                   types.String   `tfsdk:"id"`
+	KmsKeyID             types.String   `tfsdk:"kms_key_id"`
+	SnapshotCreationTime types.String   `tfsdk:"snapshot_creation_time"`
+	SnapshotName         types.String   `tfsdk:"snapshot_name"`
+	SnapshotType         types.String   `tfsdk:"snapshot_type"`
+	SourceSnapshotARN    types.String   `tfsdk:"source_snapshot_arn"`
+	Status               types.String   `tfsdk:"status"`
+	SubnetIds            types.Set      `tfsdk:"subnet_ids"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	TagsAll              types.Map      `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	VpcSecurityGroupIds  types.Set      `tfsdk:"vpc_security_group_ids"`
+}
+
+func waitClusterSnapshotCreated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.ClusterSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.StatusCreating, awstypes.StatusCopying),
+		Target:         enum.Slice(awstypes.StatusActive),
+		Refresh:        statusClusterSnapshot(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ClusterSnapshot); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitClusterSnapshotDeleted(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.ClusterSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
+		Target:  []string{},
+		Refresh: statusClusterSnapshot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ClusterSnapshot); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusClusterSnapshot(ctx context.Context, conn *docdbelastic.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findClusterSnapshotByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findClusterSnapshotByID(ctx context.Context, conn *docdbelastic.Client, id string) (*awstypes.ClusterSnapshot, error) {
+	in := &docdbelastic.GetClusterSnapshotInput{
+		SnapshotArn: aws.String(id),
+	}
+	out, err := conn.GetClusterSnapshot(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Snapshot == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Snapshot, nil
+}
+
+func (r *resourceClusterSnapshotData) refreshFromOutput(ctx context.Context, output *awstypes.ClusterSnapshot) {
+	r.AdminUserName = flex.StringToFramework(ctx, output.AdminUserName)
+	r.ARN = flex.StringToFramework(ctx, output.SnapshotArn)
+	r.ClusterARN = flex.StringToFramework(ctx, output.ClusterArn)
+	r.ClusterCreationTime = flex.StringToFramework(ctx, output.ClusterCreationTime)
+	r.KmsKeyID = flex.StringToFramework(ctx, output.KmsKeyId)
+	r.SnapshotCreationTime = flex.StringToFramework(ctx, output.SnapshotCreationTime)
+	r.SnapshotName = flex.StringToFramework(ctx, output.SnapshotName)
+	r.SnapshotType = flex.StringValueToFramework(ctx, string(output.SnapshotType))
+	r.Status = flex.StringValueToFramework(ctx, string(output.Status))
+	r.SubnetIds = flex.FlattenFrameworkStringValueSet(ctx, output.SubnetIds)
+	r.VpcSecurityGroupIds = flex.FlattenFrameworkStringValueSet(ctx, output.VpcSecurityGroupIds)
+}
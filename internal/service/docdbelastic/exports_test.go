@@ -5,7 +5,9 @@ package docdbelastic
 
 // Exports for use in tests only.
 var (
-	ResourceCluster = newResourceCluster
+	ResourceCluster         = newResourceCluster
+	ResourceClusterSnapshot = newResourceClusterSnapshot
 
-	FindClusterByID = findClusterByID
+	FindClusterByID         = findClusterByID
+	FindClusterSnapshotByID = findClusterSnapshotByID
 )
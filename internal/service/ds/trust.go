@@ -169,10 +169,10 @@ func (r *resourceTrust) Create(ctx context.Context, req resource.CreateRequest,
 
 	output, err := conn.CreateTrust(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DS, create.ErrActionCreating, ResNameTrust, directoryID, nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -254,10 +254,10 @@ func (r *resourceTrust) Update(ctx context.Context, req resource.UpdateRequest,
 
 		_, err := conn.UpdateTrust(ctx, params)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				fmt.Sprintf("updating Cognito User Pool Client (%s)", plan.ID.ValueString()),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -275,10 +275,10 @@ func (r *resourceTrust) Update(ctx context.Context, req resource.UpdateRequest,
 
 		_, err := conn.UpdateConditionalForwarder(ctx, params)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				fmt.Sprintf("updating Cognito User Pool Client (%s) conditional forwarder IPs", plan.ID.ValueString()),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -317,19 +317,19 @@ func (r *resourceTrust) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DS, create.ErrActionDeleting, ResNameTrust, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	_, err = waitTrustDeleted(ctx, conn, state.DirectoryID.ValueString(), state.ID.ValueString(), trustDeleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.DS, create.ErrActionDeleting, ResNameTrust, state.ID.ValueString(), fmt.Errorf("waiting for completion: %w", err)),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -345,10 +345,10 @@ func (r *resourceTrust) ImportState(ctx context.Context, req resource.ImportStat
 
 	trust, err := findTrustByDomain(ctx, r.Meta().DSClient(ctx), directoryID, domain)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			"Importing Resource",
-			err.Error(),
-		)
+			err,
+		))
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), aws.ToString(trust.TrustId))...)
@@ -88,7 +88,7 @@ func (r *resourcePolicyResource) Create(ctx context.Context, request resource.Cr
 	output, err := conn.PutResourcePolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating DynamoDB Resource Policy (%s)", data.ResourceARN.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating DynamoDB Resource Policy (%s)", data.ResourceARN.ValueString()), err))
 
 		return
 	}
@@ -102,7 +102,7 @@ func (r *resourcePolicyResource) Create(ctx context.Context, request resource.Cr
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for DynamoDB Resource Policy (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for DynamoDB Resource Policy (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -118,7 +118,7 @@ func (r *resourcePolicyResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -135,7 +135,7 @@ func (r *resourcePolicyResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Resource Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading DynamoDB Resource Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -171,7 +171,7 @@ func (r *resourcePolicyResource) Update(ctx context.Context, request resource.Up
 	output, err := conn.PutResourcePolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Resource Policy (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating DynamoDB Resource Policy (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -200,7 +200,7 @@ func (r *resourcePolicyResource) Delete(ctx context.Context, request resource.De
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting DynamoDB Resource Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting DynamoDB Resource Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
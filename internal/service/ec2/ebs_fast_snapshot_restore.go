@@ -97,7 +97,7 @@ func (r *ebsFastSnapshotRestoreResource) Create(ctx context.Context, request res
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError("creating EC2 EBS Fast Snapshot Restore", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating EC2 EBS Fast Snapshot Restore", err))
 
 		return
 	}
@@ -108,7 +108,7 @@ func (r *ebsFastSnapshotRestoreResource) Create(ctx context.Context, request res
 	v, err := waitFastSnapshotRestoreCreated(ctx, conn, availabilityZone, snapshotID, r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EBS Fast Snapshot Restore (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 EBS Fast Snapshot Restore (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -126,7 +126,7 @@ func (r *ebsFastSnapshotRestoreResource) Read(ctx context.Context, request resou
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -143,7 +143,7 @@ func (r *ebsFastSnapshotRestoreResource) Read(ctx context.Context, request resou
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EBS Fast Snapshot Restore (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading EC2 EBS Fast Snapshot Restore (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -170,13 +170,13 @@ func (r *ebsFastSnapshotRestoreResource) Delete(ctx context.Context, request res
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 EBS Fast Snapshot Restore (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting EC2 EBS Fast Snapshot Restore (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err := waitFastSnapshotRestoreDeleted(ctx, conn, availabilityZone, snapshotID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EBS Fast Snapshot Restore (%s) delete", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 EBS Fast Snapshot Restore (%s) delete", data.ID.ValueString()), err))
 
 		return
 	}
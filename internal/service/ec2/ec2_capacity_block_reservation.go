@@ -193,10 +193,10 @@ func (r *resourceCapacityBlockReservation) Create(ctx context.Context, request r
 
 	output, err := conn.PurchaseCapacityBlock(ctx, input)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameCapacityBlockReservation, plan.CapacityBlockOfferingID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -216,10 +216,10 @@ func (r *resourceCapacityBlockReservation) Create(ctx context.Context, request r
 	out, err := waitCapacityBlockReservationActive(ctx, conn, createTimeout, state.ID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EC2, create.ErrActionWaitingForCreation, ResNameCapacityBlockReservation, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -92,7 +92,7 @@ func (r *eipDomainNameResource) Create(ctx context.Context, request resource.Cre
 	output, err := conn.ModifyAddressAttribute(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating EC2 EIP Domain Name", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating EC2 EIP Domain Name", err))
 
 		return
 	}
@@ -103,7 +103,7 @@ func (r *eipDomainNameResource) Create(ctx context.Context, request resource.Cre
 	v, err := waitEIPDomainNameAttributeUpdated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP Domain Name (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 EIP Domain Name (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -132,7 +132,7 @@ func (r *eipDomainNameResource) Read(ctx context.Context, request resource.ReadR
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Domain Name (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading EC2 EIP Domain Name (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -168,13 +168,13 @@ func (r *eipDomainNameResource) Update(ctx context.Context, request resource.Upd
 		_, err := conn.ModifyAddressAttribute(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 EIP Domain Name (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating EC2 EIP Domain Name (%s)", new.ID.ValueString()), err))
 
 			return
 		}
 
 		if _, err := waitEIPDomainNameAttributeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP Domain Name (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 EIP Domain Name (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -202,13 +202,13 @@ func (r *eipDomainNameResource) Delete(ctx context.Context, request resource.Del
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 EIP Domain Name (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting EC2 EIP Domain Name (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err := waitEIPDomainNameAttributeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP Domain Name (%s) delete", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 EIP Domain Name (%s) delete", data.ID.ValueString()), err))
 
 		return
 	}
@@ -157,7 +157,7 @@ func (r *instanceConnectEndpointResource) Create(ctx context.Context, request re
 	output, err := conn.CreateInstanceConnectEndpoint(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating EC2 Instance Connect Endpoint", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating EC2 Instance Connect Endpoint", err))
 
 		return
 	}
@@ -168,7 +168,7 @@ func (r *instanceConnectEndpointResource) Create(ctx context.Context, request re
 	instanceConnectEndpoint, err := waitInstanceConnectEndpointCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance Connect Endpoint (%s) create", id), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 Instance Connect Endpoint (%s) create", id), err))
 
 		return
 	}
@@ -202,7 +202,7 @@ func (r *instanceConnectEndpointResource) Read(ctx context.Context, request reso
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Instance Connect Endpoint (%s)", id), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading EC2 Instance Connect Endpoint (%s)", id), err))
 
 		return
 	}
@@ -237,13 +237,13 @@ func (r *instanceConnectEndpointResource) Delete(ctx context.Context, request re
 	id := data.InstanceConnectEndpointId.ValueString()
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Instance Connect Endpoint (%s)", id), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting EC2 Instance Connect Endpoint (%s)", id), err))
 
 		return
 	}
 
 	if _, err := waitInstanceConnectEndpointDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance Connect Endpoint (%s) delete", id), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 Instance Connect Endpoint (%s) delete", id), err))
 
 		return
 	}
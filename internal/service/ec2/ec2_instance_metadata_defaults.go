@@ -115,7 +115,7 @@ func (r *instanceMetadataDefaultsResource) Create(ctx context.Context, request r
 	_, err := conn.ModifyInstanceMetadataDefaults(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating EC2 Instance Metadata Defaults", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating EC2 Instance Metadata Defaults", err))
 
 		return
 	}
@@ -147,7 +147,7 @@ func (r *instanceMetadataDefaultsResource) Read(ctx context.Context, request res
 
 		return
 	case err != nil:
-		response.Diagnostics.AddError("reading EC2 Instance Metadata Defaults", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading EC2 Instance Metadata Defaults", err))
 
 		return
 	}
@@ -193,7 +193,7 @@ func (r *instanceMetadataDefaultsResource) Update(ctx context.Context, request r
 	_, err := conn.ModifyInstanceMetadataDefaults(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("updating EC2 Instance Metadata Defaults", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("updating EC2 Instance Metadata Defaults", err))
 
 		return
 	}
@@ -214,7 +214,7 @@ func (r *instanceMetadataDefaultsResource) Delete(ctx context.Context, request r
 	_, err := conn.ModifyInstanceMetadataDefaults(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("deleting EC2 Instance Metadata Defaults", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("deleting EC2 Instance Metadata Defaults", err))
 
 		return
 	}
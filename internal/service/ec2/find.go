@@ -684,25 +684,23 @@ func FindSecurityGroupRuleByID(ctx context.Context, conn *ec2.EC2, id string) (*
 func FindSecurityGroupEgressRuleByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
 	output, err := FindSecurityGroupRuleByID(ctx, conn, id)
 
-	if err != nil {
-		return nil, err
-	}
-
-	if !aws.BoolValue(output.IsEgress) {
-		return nil, &retry.NotFoundError{}
-	}
-
-	return output, nil
+	return securityGroupRuleOfDirection(output, err, true)
 }
 
 func FindSecurityGroupIngressRuleByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
 	output, err := FindSecurityGroupRuleByID(ctx, conn, id)
 
+	return securityGroupRuleOfDirection(output, err, false)
+}
+
+// securityGroupRuleOfDirection returns the result of a security group rule lookup if the rule is an egress
+// rule and egress is true, or an ingress rule and egress is false. Otherwise it returns a retry.NotFoundError.
+func securityGroupRuleOfDirection(output *ec2.SecurityGroupRule, err error, egress bool) (*ec2.SecurityGroupRule, error) {
 	if err != nil {
 		return nil, err
 	}
 
-	if aws.BoolValue(output.IsEgress) {
+	if aws.BoolValue(output.IsEgress) != egress {
 		return nil, &retry.NotFoundError{}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -72,10 +73,10 @@ func (r *resourceEndpointPrivateDNS) Create(ctx context.Context, req resource.Cr
 
 	out, err := conn.ModifyVpcEndpoint(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameEndpointPrivateDNS, plan.VpcEndpointID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -104,10 +105,10 @@ func (r *resourceEndpointPrivateDNS) Read(ctx context.Context, req resource.Read
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameEndpointPrivateDNS, state.VpcEndpointID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -134,10 +135,10 @@ func (r *resourceEndpointPrivateDNS) Update(ctx context.Context, req resource.Up
 
 		out, err := conn.ModifyVpcEndpoint(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameEndpointPrivateDNS, plan.VpcEndpointID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -81,10 +82,10 @@ func (r *resourceEndpointServicePrivateDNSVerification) Create(ctx context.Conte
 
 	out, err := conn.StartVpcEndpointServicePrivateDnsVerification(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameEndpointServicePrivateDNSVerification, plan.ServiceID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.ReturnValue == nil {
@@ -106,10 +107,10 @@ func (r *resourceEndpointServicePrivateDNSVerification) Create(ctx context.Conte
 		createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 		_, err := waitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, plan.ServiceID.ValueString(), createTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.EC2, create.ErrActionWaitingForCreation, ResNameEndpointServicePrivateDNSVerification, plan.ServiceID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
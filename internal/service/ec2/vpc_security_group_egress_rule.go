@@ -65,5 +65,9 @@ func (r *securityGroupEgressRuleResource) delete(ctx context.Context, data *secu
 func (r *securityGroupEgressRuleResource) findByID(ctx context.Context, id string) (*ec2.SecurityGroupRule, error) {
 	conn := r.Meta().EC2Conn(ctx)
 
+	if r.Meta().BatchReads(ctx) {
+		return findSecurityGroupEgressRuleByIDBatched(ctx, r.Meta(), id)
+	}
+
 	return FindSecurityGroupEgressRuleByID(ctx, conn, id)
 }
@@ -98,6 +98,10 @@ func (r *securityGroupIngressRuleResource) delete(ctx context.Context, data *sec
 func (r *securityGroupIngressRuleResource) findByID(ctx context.Context, id string) (*ec2.SecurityGroupRule, error) {
 	conn := r.Meta().EC2Conn(ctx)
 
+	if r.Meta().BatchReads(ctx) {
+		return findSecurityGroupIngressRuleByIDBatched(ctx, r.Meta(), id)
+	}
+
 	return FindSecurityGroupIngressRuleByID(ctx, conn, id)
 }
 
@@ -247,7 +251,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, request resource
 	securityGroupRuleID, err := r.securityGroupRule.create(ctx, &data)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating VPC Security Group Rule", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating VPC Security Group Rule", err))
 
 		return
 	}
@@ -259,7 +263,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, request resource
 
 	conn := r.Meta().EC2Conn(ctx)
 	if err := createTags(ctx, conn, data.ID.ValueString(), getTagsIn(ctx)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("setting VPC Security Group Rule (%s) tags", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("setting VPC Security Group Rule (%s) tags", data.ID.ValueString()), err))
 
 		return
 	}
@@ -275,7 +279,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -290,7 +294,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group Rule (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading VPC Security Group Rule (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -354,7 +358,7 @@ func (r *securityGroupRuleResource) Update(ctx context.Context, request resource
 		_, err := conn.ModifySecurityGroupRulesWithContext(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group Rule (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating VPC Security Group Rule (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -380,7 +384,7 @@ func (r *securityGroupRuleResource) Delete(ctx context.Context, request resource
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Security Group Rule (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting VPC Security Group Rule (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -562,6 +562,37 @@ func TestAccVPCSecurityGroupIngressRule_updateSourceType(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupIngressRule_batchReads(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_batchReads(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName+".0", &v1),
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName+".1", &v2),
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName+".2", &v3),
+					resource.TestCheckResourceAttr(resourceName+".0", "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName+".1", "from_port", "81"),
+					resource.TestCheckResourceAttr(resourceName+".2", "from_port", "82"),
+				),
+			},
+			{
+				Config:   testAccVPCSecurityGroupIngressRuleConfig_batchReads(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckSecurityGroupRuleNotRecreated(i, j *ec2.SecurityGroupRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(i.SecurityGroupRuleId) != aws.StringValue(j.SecurityGroupRuleId) {
@@ -675,6 +706,26 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 `)
 }
 
+func testAccVPCSecurityGroupIngressRuleConfig_batchReads(rName string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+provider "aws" {
+  batch_reads = true
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  count = 3
+
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80 + count.index
+  ip_protocol = "tcp"
+  to_port     = 80 + count.index
+}
+`)
+}
+
 func testAccVPCSecurityGroupIngressRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
 resource "aws_vpc_security_group_ingress_rule" "test" {
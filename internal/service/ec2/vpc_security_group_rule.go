@@ -257,11 +257,18 @@ information and instructions for recovery. Error: %s`, securityGroupID, err)
 func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 	ruleType := securityGroupRuleType(d.Get(names.AttrType).(string))
 
-	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
+	var sg *ec2.SecurityGroup
+	var err error
+	if c.BatchReads(ctx) {
+		sg, err = findSecurityGroupByIDBatched(ctx, c, securityGroupID)
+	} else {
+		sg, err = FindSecurityGroupByID(ctx, conn, securityGroupID)
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", securityGroupID)
@@ -307,7 +314,12 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Attempt to find the single matching AWS Security Group Rule resource ID.
-	securityGroupRules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+	var securityGroupRules []*ec2.SecurityGroupRule
+	if c.BatchReads(ctx) {
+		securityGroupRules, err = findSecurityGroupRulesBySecurityGroupIDBatched(ctx, c, securityGroupID)
+	} else {
+		securityGroupRules, err = FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+	}
 
 	// Ignore UnsupportedOperation errors for AWS China and GovCloud (US).
 	if tfawserr.ErrCodeEquals(err, errCodeUnsupportedOperation) {
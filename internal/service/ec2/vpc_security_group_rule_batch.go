@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// securityGroupRuleBatchWindow is how long a read waits for other reads to join its batch.
+	securityGroupRuleBatchWindow = 50 * time.Millisecond
+	// securityGroupRuleBatchMaxSize is the maximum number of IDs described in one call.
+	securityGroupRuleBatchMaxSize = 200
+	// securityGroupRuleBatchTimeout bounds how long the Describe calls for one batch can take.
+	securityGroupRuleBatchTimeout = 5 * time.Minute
+)
+
+type batchResult[T any] struct {
+	value T
+	err   error
+}
+
+// idBatcher combines concurrent reads by ID into Describe calls for multiple IDs.
+type idBatcher[T any] struct {
+	// describe returns the values found for the specified IDs. A NotFound error causes each ID to be
+	// described individually with describeOne.
+	describe    func(context.Context, []string) (map[string]T, error)
+	describeOne func(context.Context, string) (T, error)
+	mu          sync.Mutex
+	name        string
+	pending     map[string][]chan batchResult[T]
+	pendingCtx  context.Context // Context of the first read in the pending batch.
+	timer       *time.Timer
+	window      time.Duration
+}
+
+func newIDBatcher[T any](name string, window time.Duration, describe func(context.Context, []string) (map[string]T, error), describeOne func(context.Context, string) (T, error)) *idBatcher[T] {
+	return &idBatcher[T]{
+		describe:    describe,
+		describeOne: describeOne,
+		name:        name,
+		pending:     make(map[string][]chan batchResult[T]),
+		window:      window,
+	}
+}
+
+// findByID returns the value with the specified ID, described together with any other values
+// requested within the batch window.
+func (b *idBatcher[T]) findByID(ctx context.Context, id string) (T, error) {
+	ch := make(chan batchResult[T], 1)
+
+	b.mu.Lock()
+	if len(b.pending) == 0 {
+		b.pendingCtx = ctx
+	}
+	b.pending[id] = append(b.pending[id], ch)
+	if len(b.pending) >= securityGroupRuleBatchMaxSize {
+		b.flushLocked()
+	} else if b.timer == nil {
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+	b.mu.Unlock()
+
+	select {
+	case result := <-ch:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+func (b *idBatcher[T]) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.flushLocked()
+}
+
+func (b *idBatcher[T]) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if len(b.pending) == 0 {
+		return
+	}
+
+	batch, ctx := b.pending, b.pendingCtx
+	b.pending, b.pendingCtx = make(map[string][]chan batchResult[T]), nil
+
+	go b.execute(ctx, batch)
+}
+
+// execute describes the batched IDs. The batch is shared by several reads, so cancellation of the read
+// that started it doesn't stop the batch; its logging context is kept and the calls are bounded by a timeout.
+func (b *idBatcher[T]) execute(ctx context.Context, batch map[string][]chan batchResult[T]) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), securityGroupRuleBatchTimeout)
+	defer cancel()
+
+	ids := make([]string, 0, len(batch))
+	for id := range batch {
+		ids = append(ids, id)
+	}
+
+	tflog.Debug(ctx, "Describing "+b.name+" in batch", map[string]any{
+		"count": len(ids),
+	})
+
+	values, err := b.describe(ctx, ids)
+
+	// The whole call fails if any one of the IDs doesn't exist, so fall back to describing each ID individually.
+	if tfresource.NotFound(err) && len(ids) > 1 {
+		for id, chs := range batch {
+			value, err := b.describeOne(ctx, id)
+
+			for _, ch := range chs {
+				ch <- batchResult[T]{value: value, err: err}
+			}
+		}
+
+		return
+	}
+
+	for id, chs := range batch {
+		result := batchResult[T]{err: err}
+
+		if err == nil {
+			if value, ok := values[id]; ok {
+				result.value = value
+			} else {
+				result.err = &retry.NotFoundError{}
+			}
+		}
+
+		for _, ch := range chs {
+			ch <- result
+		}
+	}
+}
+
+// securityGroupRuleBatcherFor returns the batcher of security group rules by rule ID for the specified client.
+func securityGroupRuleBatcherFor(ctx context.Context, c *conns.AWSClient) *idBatcher[*ec2.SecurityGroupRule] {
+	// The connection must be obtained before the client's lock is held by Batcher.
+	conn := c.EC2Conn(ctx)
+	v := c.Batcher(ctx, "ec2.SecurityGroupRules", func() any {
+		return newIDBatcher("security group rules", securityGroupRuleBatchWindow,
+			func(ctx context.Context, ids []string) (map[string]*ec2.SecurityGroupRule, error) {
+				input := &ec2.DescribeSecurityGroupRulesInput{
+					SecurityGroupRuleIds: aws.StringSlice(ids),
+				}
+
+				rules, err := FindSecurityGroupRules(ctx, conn, input)
+
+				if err != nil {
+					return nil, err
+				}
+
+				output := make(map[string]*ec2.SecurityGroupRule, len(rules))
+				for _, v := range rules {
+					output[aws.StringValue(v.SecurityGroupRuleId)] = v
+				}
+
+				return output, nil
+			},
+			func(ctx context.Context, id string) (*ec2.SecurityGroupRule, error) {
+				return FindSecurityGroupRuleByID(ctx, conn, id)
+			},
+		)
+	})
+
+	return v.(*idBatcher[*ec2.SecurityGroupRule])
+}
+
+// securityGroupBatcherFor returns the batcher of security groups by group ID for the specified client.
+func securityGroupBatcherFor(ctx context.Context, c *conns.AWSClient) *idBatcher[*ec2.SecurityGroup] {
+	// The connection must be obtained before the client's lock is held by Batcher.
+	conn := c.EC2Conn(ctx)
+	v := c.Batcher(ctx, "ec2.SecurityGroups", func() any {
+		return newIDBatcher("security groups", securityGroupRuleBatchWindow,
+			func(ctx context.Context, ids []string) (map[string]*ec2.SecurityGroup, error) {
+				input := &ec2.DescribeSecurityGroupsInput{
+					GroupIds: aws.StringSlice(ids),
+				}
+
+				groups, err := FindSecurityGroups(ctx, conn, input)
+
+				if err != nil {
+					return nil, err
+				}
+
+				output := make(map[string]*ec2.SecurityGroup, len(groups))
+				for _, v := range groups {
+					output[aws.StringValue(v.GroupId)] = v
+				}
+
+				return output, nil
+			},
+			func(ctx context.Context, id string) (*ec2.SecurityGroup, error) {
+				return FindSecurityGroupByID(ctx, conn, id)
+			},
+		)
+	})
+
+	return v.(*idBatcher[*ec2.SecurityGroup])
+}
+
+// securityGroupRulesBatcherFor returns the batcher of security group rules by group ID for the specified client.
+func securityGroupRulesBatcherFor(ctx context.Context, c *conns.AWSClient) *idBatcher[[]*ec2.SecurityGroupRule] {
+	// The connection must be obtained before the client's lock is held by Batcher.
+	conn := c.EC2Conn(ctx)
+	v := c.Batcher(ctx, "ec2.SecurityGroupRulesBySecurityGroupID", func() any {
+		return newIDBatcher("security group rules by security group", securityGroupRuleBatchWindow,
+			func(ctx context.Context, ids []string) (map[string][]*ec2.SecurityGroupRule, error) {
+				input := &ec2.DescribeSecurityGroupRulesInput{
+					Filters: []*ec2.Filter{{
+						Name:   aws.String("group-id"),
+						Values: aws.StringSlice(ids),
+					}},
+				}
+
+				rules, err := FindSecurityGroupRules(ctx, conn, input)
+
+				if err != nil {
+					return nil, err
+				}
+
+				// A security group without rules has no entries.
+				output := make(map[string][]*ec2.SecurityGroupRule, len(ids))
+				for _, id := range ids {
+					output[id] = nil
+				}
+				for _, v := range rules {
+					id := aws.StringValue(v.GroupId)
+					output[id] = append(output[id], v)
+				}
+
+				return output, nil
+			},
+			func(ctx context.Context, id string) ([]*ec2.SecurityGroupRule, error) {
+				return FindSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+			},
+		)
+	})
+
+	return v.(*idBatcher[[]*ec2.SecurityGroupRule])
+}
+
+func findSecurityGroupEgressRuleByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*ec2.SecurityGroupRule, error) {
+	output, err := securityGroupRuleBatcherFor(ctx, c).findByID(ctx, id)
+
+	return securityGroupRuleOfDirection(output, err, true)
+}
+
+func findSecurityGroupIngressRuleByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*ec2.SecurityGroupRule, error) {
+	output, err := securityGroupRuleBatcherFor(ctx, c).findByID(ctx, id)
+
+	return securityGroupRuleOfDirection(output, err, false)
+}
+
+func findSecurityGroupByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*ec2.SecurityGroup, error) {
+	return securityGroupBatcherFor(ctx, c).findByID(ctx, id)
+}
+
+func findSecurityGroupRulesBySecurityGroupIDBatched(ctx context.Context, c *conns.AWSClient, id string) ([]*ec2.SecurityGroupRule, error) {
+	return securityGroupRulesBatcherFor(ctx, c).findByID(ctx, id)
+}
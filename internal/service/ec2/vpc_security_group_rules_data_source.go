@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -70,7 +71,7 @@ func (d *securityGroupRulesDataSource) Read(ctx context.Context, request datasou
 	output, err := FindSecurityGroupRules(ctx, conn, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("reading Security Group Rules", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading Security Group Rules", err))
 
 		return
 	}
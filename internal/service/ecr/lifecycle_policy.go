@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"sort"
 	"strings"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,11 +40,15 @@ func resourceLifecyclePolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: lifecyclePolicyRulesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrPolicy: {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
+				ExactlyOneOf: []string{names.AttrPolicy, names.AttrRule},
 				ValidateFunc: validation.StringIsJSON,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					equal, _ := equivalentLifecyclePolicyJSON(old, new)
@@ -63,6 +69,89 @@ func resourceLifecyclePolicy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			names.AttrRule: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{names.AttrPolicy, names.AttrRule},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"expire"}, false),
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						names.AttrPriority: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"imageCountMoreThan", "sinceImagePushed"}, false),
+									},
+									"count_unit": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"tag_pattern_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"tagged", "untagged", "any"}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -71,9 +160,21 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRClient(ctx)
 
-	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
-	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	var policy string
+	if v, ok := d.GetOk(names.AttrRule); ok && len(v.([]interface{})) > 0 {
+		v, err := expandLifecyclePolicyRules(v.([]interface{}))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		policy = v
+	} else {
+		v, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		policy = v
 	}
 
 	input := &ecr.PutLifecyclePolicyInput{
@@ -124,6 +225,17 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set(names.AttrPolicy, policyToSet)
 	}
 
+	if v, ok := d.GetOk(names.AttrRule); ok && len(v.([]interface{})) > 0 {
+		var policy lifecyclePolicy
+		if err := json.Unmarshal([]byte(aws.ToString(output.LifecyclePolicyText)), &policy); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := d.Set(names.AttrRule, flattenLifecyclePolicyRules(policy.Rules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+		}
+	}
+
 	d.Set("registry_id", output.RegistryId)
 	d.Set("repository", output.RepositoryName)
 
@@ -175,6 +287,133 @@ func findLifecyclePolicyByRepositoryName(ctx context.Context, conn *ecr.Client,
 	return output, nil
 }
 
+func lifecyclePolicyRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	priorities := make(map[int]struct{})
+
+	for _, tfMapRaw := range d.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Priority may not be known until apply.
+		priority := tfMap[names.AttrPriority].(int)
+		if priority == 0 {
+			continue
+		}
+
+		if _, ok := priorities[priority]; ok {
+			return fmt.Errorf("duplicate rule priority (%d): each rule must have a unique priority", priority)
+		}
+
+		priorities[priority] = struct{}{}
+	}
+
+	return nil
+}
+
+func expandLifecyclePolicyRules(tfList []interface{}) (string, error) {
+	policy := &lifecyclePolicy{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := &lifecyclePolicyRule{
+			Action: &lifecyclePolicyRuleAction{
+				Type: aws.String("expire"),
+			},
+			RulePriority: aws.Int64(int64(tfMap[names.AttrPriority].(int))),
+		}
+
+		if v, ok := tfMap[names.AttrAction].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			rule.Action.Type = aws.String(v[0].(map[string]interface{})[names.AttrType].(string))
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			rule.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			rule.Selection = expandLifecyclePolicyRuleSelection(v[0].(map[string]interface{}))
+		}
+
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	bytes, err := json.Marshal(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(bytes))
+}
+
+func expandLifecyclePolicyRuleSelection(tfMap map[string]interface{}) *lifecyclePolicyRuleSelection {
+	selection := &lifecyclePolicyRuleSelection{
+		CountNumber: aws.Int64(int64(tfMap["count_number"].(int))),
+		CountType:   aws.String(tfMap["count_type"].(string)),
+		TagStatus:   aws.String(tfMap["tag_status"].(string)),
+	}
+
+	if v, ok := tfMap["count_unit"].(string); ok && v != "" {
+		selection.CountUnit = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_pattern_list"].([]interface{}); ok && len(v) > 0 {
+		selection.TagPatternList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["tag_prefix_list"].([]interface{}); ok && len(v) > 0 {
+		selection.TagPrefixList = flex.ExpandStringList(v)
+	}
+
+	return selection
+}
+
+func flattenLifecyclePolicyRules(apiObjects []*lifecyclePolicyRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrPriority:    aws.ToInt64(apiObject.RulePriority),
+		}
+
+		if v := apiObject.Action; v != nil {
+			tfMap[names.AttrAction] = []interface{}{
+				map[string]interface{}{
+					names.AttrType: aws.ToString(v.Type),
+				},
+			}
+		}
+
+		if v := apiObject.Selection; v != nil {
+			tfMap["selection"] = []interface{}{
+				map[string]interface{}{
+					"count_number":     aws.ToInt64(v.CountNumber),
+					"count_type":       aws.ToString(v.CountType),
+					"count_unit":       aws.ToString(v.CountUnit),
+					"tag_pattern_list": aws.ToStringSlice(v.TagPatternList),
+					"tag_prefix_list":  aws.ToStringSlice(v.TagPrefixList),
+					"tag_status":       aws.ToString(v.TagStatus),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 type lifecyclePolicyRuleSelection struct {
 	TagStatus      *string   `json:"tagStatus,omitempty"`
 	TagPatternList []*string `json:"tagPatternList,omitempty"`
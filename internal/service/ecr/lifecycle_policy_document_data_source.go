@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -150,7 +151,7 @@ func (d *lifecyclePolicyDocumentDataSource) Read(ctx context.Context, request da
 	bytes, err := json.MarshalIndent(input, "", "  ")
 
 	if err != nil {
-		response.Diagnostics.AddError("Marshalling lifecycle policy to JSON", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("Marshalling lifecycle policy to JSON", err))
 	}
 
 	data.JSON = types.StringValue(string(bytes))
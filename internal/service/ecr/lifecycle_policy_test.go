@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -144,6 +145,61 @@ func TestAccECRLifecyclePolicy_detectTagPatternListDiff(t *testing.T) {
 	})
 }
 
+func TestAccECRLifecyclePolicy_rule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_rule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.type", "expire"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.tag_status", "untagged"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.count_unit", "days"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.selection.0.tag_prefix_list.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.selection.0.tag_prefix_list.0", "v"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrRule},
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicy_ruleDuplicatePriority(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyConfig_ruleDuplicatePriority(rName),
+				ExpectError: regexache.MustCompile(`duplicate rule priority \(1\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckLifecyclePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
@@ -410,3 +466,75 @@ resource "aws_ecr_lifecycle_policy" "test" {
 }
 `, rName)
 }
+
+func testAccLifecyclePolicyConfig_rule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority    = 1
+    description = "Expire untagged images older than 14 days"
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+  }
+
+  rule {
+    priority    = 2
+    description = "Keep last 30 release images"
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v", "release"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 30
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_ruleDuplicatePriority(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+  }
+}
+`, rName)
+}
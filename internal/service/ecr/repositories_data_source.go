@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -58,7 +59,7 @@ func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRe
 	output, err := findRepositories(ctx, conn, &ecr.DescribeRepositoriesInput{})
 
 	if err != nil {
-		resp.Diagnostics.AddError("reading ECR Repositories", err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading ECR Repositories", err))
 
 		return
 	}
@@ -1187,14 +1187,19 @@ func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 
 func capacityProviderStrategyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// to be backward compatible, should ForceNew almost always (previous behavior), unless:
+	//   only the base or weight of the existing capacity providers changes or
 	//   force_new_deployment is true and
 	//   neither the old set nor new set is 0 length
+	old, new := d.GetChange(names.AttrCapacityProviderStrategy)
+
+	if capacityProviderStrategyProvidersEqual(old.(*schema.Set), new.(*schema.Set)) {
+		return nil
+	}
+
 	if v := d.Get("force_new_deployment").(bool); !v {
 		return capacityProviderStrategyForceNew(d)
 	}
 
-	old, new := d.GetChange(names.AttrCapacityProviderStrategy)
-
 	ol := old.(*schema.Set).Len()
 	nl := new.(*schema.Set).Len()
 
@@ -1205,6 +1210,27 @@ func capacityProviderStrategyCustomizeDiff(_ context.Context, d *schema.Resource
 	return nil
 }
 
+// capacityProviderStrategyProvidersEqual returns whether two non-empty capacity provider strategies
+// reference the same capacity providers, ignoring their base and weight.
+func capacityProviderStrategyProvidersEqual(old, new *schema.Set) bool {
+	if old.Len() == 0 || old.Len() != new.Len() {
+		return false
+	}
+
+	providers := make(map[string]struct{}, old.Len())
+	for _, tfMapRaw := range old.List() {
+		providers[tfMapRaw.(map[string]interface{})["capacity_provider"].(string)] = struct{}{}
+	}
+
+	for _, tfMapRaw := range new.List() {
+		if _, ok := providers[tfMapRaw.(map[string]interface{})["capacity_provider"].(string)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 func capacityProviderStrategyForceNew(d *schema.ResourceDiff) error {
 	for _, key := range d.GetChangedKeysPrefix(names.AttrCapacityProviderStrategy) {
 		if d.HasChange(key) {
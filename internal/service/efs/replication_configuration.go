@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,6 +28,7 @@ func ResourceReplicationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicationConfigurationCreate,
 		ReadWithoutTimeout:   resourceReplicationConfigurationRead,
+		UpdateWithoutTimeout: resourceReplicationConfigurationUpdate,
 		DeleteWithoutTimeout: resourceReplicationConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -35,6 +37,7 @@ func ResourceReplicationConfiguration() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -82,6 +85,11 @@ func ResourceReplicationConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"failover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"original_source_file_system_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,6 +108,11 @@ func ResourceReplicationConfiguration() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("failover", func(_ context.Context, old, new, meta interface{}) bool {
+			// Replication can't be resumed once the destination has been promoted.
+			return old.(bool) && !new.(bool)
+		}),
 	}
 }
 
@@ -108,6 +121,11 @@ func resourceReplicationConfigurationCreate(ctx context.Context, d *schema.Resou
 	conn := meta.(*conns.AWSClient).EFSConn(ctx)
 
 	fsID := d.Get("source_file_system_id").(string)
+
+	if d.Get("failover").(bool) {
+		return sdkdiag.AppendErrorf(diags, "creating EFS Replication Configuration (%s): failover can only be set on an existing replication configuration", fsID)
+	}
+
 	input := &efs.CreateReplicationConfigurationInput{
 		SourceFileSystemId: aws.String(fsID),
 	}
@@ -137,6 +155,11 @@ func resourceReplicationConfigurationRead(ctx context.Context, d *schema.Resourc
 
 	replication, err := FindReplicationConfigurationByID(ctx, conn, d.Id())
 
+	// After failover the replication configuration no longer exists; keep the last known state.
+	if tfresource.NotFound(err) && d.Get("failover").(bool) {
+		return diags
+	}
+
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EFS Replication Configuration (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -163,6 +186,7 @@ func resourceReplicationConfigurationRead(ctx context.Context, d *schema.Resourc
 	if err := d.Set(names.AttrDestination, destinations); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting destination: %s", err)
 	}
+	d.Set("failover", d.Get("failover").(bool))
 	d.Set("original_source_file_system_arn", replication.OriginalSourceFileSystemArn)
 	d.Set("source_file_system_arn", replication.SourceFileSystemArn)
 	d.Set("source_file_system_id", replication.SourceFileSystemId)
@@ -171,6 +195,29 @@ func resourceReplicationConfigurationRead(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
+func resourceReplicationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("failover") && d.Get("failover").(bool) {
+		// Failover is initiated by deleting the replication configuration from the destination Region,
+		// which makes the destination file system writable.
+		tfMap := d.Get(names.AttrDestination).([]interface{})[0].(map[string]interface{})
+		destinationFsID, destinationRegion := tfMap[names.AttrFileSystemID].(string), tfMap[names.AttrRegion].(string)
+		regionConn := meta.(*conns.AWSClient).EFSConnForRegion(ctx, destinationRegion)
+
+		log.Printf("[DEBUG] Failing over EFS Replication Configuration: %s", d.Id())
+		if err := deleteReplicationConfiguration(ctx, regionConn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "failing over EFS Replication Configuration (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFileSystemReplicationOverwriteProtectionReleased(ctx, regionConn, destinationFsID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EFS Replication Configuration (%s) destination file system (%s) to become writable: %s", d.Id(), destinationFsID, err)
+		}
+	}
+
+	return append(diags, resourceReplicationConfigurationRead(ctx, d, meta)...)
+}
+
 func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EFSConn(ctx)
@@ -322,6 +369,44 @@ func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.EFS, id
 	return nil, err
 }
 
+func statusFileSystemReplicationOverwriteProtection(ctx context.Context, conn *efs.EFS, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFileSystemByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.FileSystemProtection == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.FileSystemProtection.ReplicationOverwriteProtection), nil
+	}
+}
+
+// waitFileSystemReplicationOverwriteProtectionReleased waits until a replication destination file system is no longer read-only.
+func waitFileSystemReplicationOverwriteProtectionReleased(ctx context.Context, conn *efs.EFS, id string, timeout time.Duration) (*efs.FileSystemDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{efs.ReplicationOverwriteProtectionReplicating},
+		Target:  []string{efs.ReplicationOverwriteProtectionEnabled, efs.ReplicationOverwriteProtectionDisabled},
+		Refresh: statusFileSystemReplicationOverwriteProtection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandDestinationToCreate(tfMap map[string]interface{}) *efs.DestinationToCreate {
 	if tfMap == nil {
 		return nil
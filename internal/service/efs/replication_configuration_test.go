@@ -163,6 +163,46 @@ func TestAccEFSReplicationConfiguration_existingDestination(t *testing.T) {
 	})
 }
 
+func TestAccEFSReplicationConfiguration_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_efs_replication_configuration.test"
+	destinationFsResourceName := "aws_efs_file_system.destination"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_failover(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failover", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.file_system_id", destinationFsResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationConfig_failover(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "failover", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.file_system_id", destinationFsResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -300,3 +340,39 @@ resource "aws_efs_replication_configuration" "test" {
 }
 `, rName, acctest.AlternateRegion()))
 }
+
+func testAccReplicationConfigurationConfig_failover(rName string, failover bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_efs_file_system" "source" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_file_system" "destination" {
+  provider = "awsalternate"
+
+  protection {
+    replication_overwrite = "DISABLED"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [protection]
+  }
+}
+
+resource "aws_efs_replication_configuration" "test" {
+  source_file_system_id = aws_efs_file_system.source.id
+  failover              = %[3]t
+
+  destination {
+    file_system_id = aws_efs_file_system.destination.id
+    region         = %[2]q
+  }
+}
+`, rName, acctest.AlternateRegion(), failover))
+}
@@ -133,10 +133,10 @@ func (r *podIdentityAssociationResource) Create(ctx context.Context, req resourc
 	}, "Role provided in the request does not exist")
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EKS, create.ErrActionCreating, ResNamePodIdentityAssociation, plan.AssociationID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -168,10 +168,10 @@ func (r *podIdentityAssociationResource) Read(ctx context.Context, req resource.
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EKS, create.ErrActionSetting, ResNamePodIdentityAssociation, data.AssociationID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -215,10 +215,10 @@ func (r *podIdentityAssociationResource) Update(ctx context.Context, req resourc
 		}, "Role provided in the request does not exist")
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.EKS, create.ErrActionUpdating, ResNamePodIdentityAssociation, new.AssociationID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
@@ -248,10 +248,10 @@ func (r *podIdentityAssociationResource) Delete(ctx context.Context, req resourc
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.EKS, create.ErrActionDeleting, ResNamePodIdentityAssociation, state.AssociationID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -262,7 +262,7 @@ func (r *podIdentityAssociationResource) ImportState(ctx context.Context, req re
 	)
 	parts, err := flex.ExpandResourceId(req.ID, partCount, false)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("importing Pod Identity Association (%s)", req.ID), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("importing Pod Identity Association (%s)", req.ID), err))
 		return
 	}
 
@@ -272,7 +272,7 @@ func (r *serverlessCacheResource) Create(ctx context.Context, request resource.C
 	_, err := conn.CreateServerlessCache(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating ElastiCache Serverless Cache", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating ElastiCache Serverless Cache", err))
 
 		return
 	}
@@ -283,7 +283,7 @@ func (r *serverlessCacheResource) Create(ctx context.Context, request resource.C
 	output, err := waitServerlessCacheAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -304,7 +304,7 @@ func (r *serverlessCacheResource) Read(ctx context.Context, request resource.Rea
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -321,7 +321,7 @@ func (r *serverlessCacheResource) Read(ctx context.Context, request resource.Rea
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -357,13 +357,13 @@ func (r *serverlessCacheResource) Update(ctx context.Context, request resource.U
 		_, err := conn.ModifyServerlessCache(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating ElastiCache Serverless Cache (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating ElastiCache Serverless Cache (%s)", new.ID.ValueString()), err))
 
 			return
 		}
 
 		if _, err := waitServerlessCacheAvailable(ctx, conn, old.ServerlessCacheName.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -374,7 +374,7 @@ func (r *serverlessCacheResource) Update(ctx context.Context, request resource.U
 	output, err := findServerlessCacheByID(ctx, conn, old.ID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", old.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", old.ID.ValueString()), err))
 
 		return
 	}
@@ -414,13 +414,13 @@ func (r *serverlessCacheResource) Delete(ctx context.Context, request resource.D
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting ElastiCache Serverless Cache (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting ElastiCache Serverless Cache (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err := waitServerlessCacheDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) delete", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) delete", data.ID.ValueString()), err))
 
 		return
 	}
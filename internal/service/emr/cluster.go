@@ -21,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -48,7 +49,11 @@ func resourceCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			clusterConfigurationsJSONCustomizeDiff,
+			clusterMasterInstanceFleetCustomizeDiff,
+		),
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			instanceFleetConfigSchema := func() *schema.Resource {
@@ -215,16 +220,50 @@ func resourceCluster() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"resize_specifications": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_demand_resize_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_duration_minutes": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+											},
+										},
+									},
+									"spot_resize_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_duration_minutes": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"target_on_demand_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 							Default:  0,
 						},
 						"target_spot_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 							Default:  0,
 						},
 					},
@@ -308,7 +347,6 @@ func resourceCluster() *schema.Resource {
 				"configurations_json": {
 					Type:             schema.TypeString,
 					Optional:         true,
-					ForceNew:         true,
 					ValidateFunc:     validation.StringIsJSON,
 					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 					StateFunc: func(v interface{}) string {
@@ -1094,6 +1132,15 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		if err := d.Set("master_instance_group", flattenMasterInstanceGroup(masterGroup)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting master_instance_group: %s", err)
 		}
+
+		// DescribeCluster only ever returns the configurations the cluster was launched with.
+		// configurations_json changes are applied by reconfiguring the master and core instance groups
+		// (ConfigurationsVersion is incremented from 0 on each reconfiguration), so once that has happened
+		// the master instance group holds the cluster's current configurations. Without this, every plan
+		// after an in-place update would show a diff back to the launch configurations.
+		if masterGroup != nil && aws.Int64Value(masterGroup.ConfigurationsVersion) > 0 {
+			cluster.Configurations = masterGroup.Configurations
+		}
 	}
 
 	instanceFleets, err := FetchAllInstanceFleets(ctx, conn, d.Id())
@@ -1381,6 +1428,121 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("configurations_json") {
+		var configurations []*emr.Configuration
+
+		if v, ok := d.GetOk("configurations_json"); ok {
+			info, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "configurations_json contains an invalid JSON: %v", err)
+			}
+			configurations, err = expandConfigurationJSON(info)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EMR configurations_json: %s", err)
+			}
+		}
+
+		if configurations == nil {
+			configurations = []*emr.Configuration{}
+		}
+
+		// Only the master and core instance groups are reconfigured. Task instance groups
+		// (e.g. aws_emr_instance_group) manage their own configurations.
+		var instanceGroupIDs []string
+		for _, k := range []string{"master_instance_group.0.id", "core_instance_group.0.id"} {
+			if v, ok := d.GetOk(k); ok {
+				instanceGroupIDs = append(instanceGroupIDs, v.(string))
+			}
+		}
+
+		// Record each group's configurations version so that the wait below doesn't return
+		// before the reconfiguration has started.
+		configurationsVersions := make(map[string]int64, len(instanceGroupIDs))
+		for _, instanceGroupID := range instanceGroupIDs {
+			ig, err := fetchInstanceGroup(ctx, conn, d.Id(), instanceGroupID)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EMR Cluster (%s) Instance Group (%s): %s", d.Id(), instanceGroupID, err)
+			}
+
+			configurationsVersions[instanceGroupID] = aws.Int64Value(ig.ConfigurationsVersion)
+		}
+
+		input := &emr.ModifyInstanceGroupsInput{
+			ClusterId: aws.String(d.Id()),
+		}
+		for _, instanceGroupID := range instanceGroupIDs {
+			input.InstanceGroups = append(input.InstanceGroups, &emr.InstanceGroupModifyConfig{
+				Configurations:  configurations,
+				InstanceGroupId: aws.String(instanceGroupID),
+			})
+		}
+
+		if _, err := conn.ModifyInstanceGroupsWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Cluster (%s): reconfiguring applications: %s", d.Id(), err)
+		}
+
+		for _, instanceGroupID := range instanceGroupIDs {
+			stateConf := &retry.StateChangeConf{
+				Pending: []string{
+					emr.InstanceGroupStateReconfiguring,
+				},
+				Target:  []string{emr.InstanceGroupStateRunning},
+				Refresh: instanceGroupReconfigurationRefresh(ctx, conn, d.Id(), instanceGroupID, configurationsVersions[instanceGroupID]),
+				Timeout: 20 * time.Minute,
+				Delay:   10 * time.Second,
+			}
+
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Group (%s) reconfiguration: %s", d.Id(), instanceGroupID, err)
+			}
+		}
+	}
+
+	// The master instance fleet can't be modified; changes to it force a new resource.
+	for _, k := range []string{"core_instance_fleet"} {
+		if !d.HasChanges(k+".0.target_on_demand_capacity", k+".0.target_spot_capacity", k+".0.resize_specifications") {
+			continue
+		}
+
+		instanceFleetID := d.Get(k + ".0.id").(string)
+		tfMap := d.Get(k + ".0").(map[string]interface{})
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId: aws.String(d.Id()),
+			InstanceFleet: &emr.InstanceFleetModifyConfig{
+				InstanceFleetId:        aws.String(instanceFleetID),
+				TargetOnDemandCapacity: aws.Int64(int64(tfMap["target_on_demand_capacity"].(int))),
+				TargetSpotCapacity:     aws.Int64(int64(tfMap["target_spot_capacity"].(int))),
+			},
+		}
+
+		if v, ok := tfMap["resize_specifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			input.InstanceFleet.ResizeSpecifications = expandInstanceFleetResizingSpecifications(v[0].(map[string]interface{}))
+		}
+
+		if _, err := conn.ModifyInstanceFleetWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EMR Cluster (%s) Instance Fleet (%s): %s", d.Id(), instanceFleetID, err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending: []string{
+				emr.InstanceFleetStateBootstrapping,
+				emr.InstanceFleetStateProvisioning,
+				emr.InstanceFleetStateResizing,
+			},
+			Target:     []string{emr.InstanceFleetStateRunning},
+			Refresh:    statusInstanceFleet(ctx, conn, d.Id(), instanceFleetID),
+			Timeout:    75 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 30 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Fleet (%s) modification: %s", d.Id(), instanceFleetID, err)
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -1437,6 +1599,42 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// clusterConfigurationsJSONCustomizeDiff forces replacement when configurations_json changes on a cluster
+// using instance fleets, as only instance groups can be reconfigured in place.
+func clusterConfigurationsJSONCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("configurations_json") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("master_instance_fleet"); ok && len(v.([]interface{})) > 0 {
+		return d.ForceNew("configurations_json")
+	}
+
+	return nil
+}
+
+// clusterMasterInstanceFleetCustomizeDiff forces replacement when the master instance fleet's capacity changes,
+// as only the core instance fleet can be resized in place.
+func clusterMasterInstanceFleetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, k := range []string{
+		"master_instance_fleet.0.resize_specifications",
+		"master_instance_fleet.0.target_on_demand_capacity",
+		"master_instance_fleet.0.target_spot_capacity",
+	} {
+		if d.HasChange(k) {
+			if err := d.ForceNew(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRConn(ctx)
@@ -2136,6 +2334,10 @@ func readInstanceFleetConfig(data map[string]interface{}, InstanceFleetType stri
 		config.LaunchSpecifications = expandLaunchSpecification(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := data["resize_specifications"].([]interface{}); ok && len(v) == 1 && v[0] != nil {
+		config.ResizeSpecifications = expandInstanceFleetResizingSpecifications(v[0].(map[string]interface{}))
+	}
+
 	return config
 }
 
@@ -2179,6 +2381,7 @@ func flattenInstanceFleet(instanceFleet *emr.InstanceFleet) []interface{} {
 		"provisioned_spot_capacity":      aws.Int64Value(instanceFleet.ProvisionedSpotCapacity),
 		"instance_type_configs":          flatteninstanceTypeConfigs(instanceFleet.InstanceTypeSpecifications),
 		"launch_specifications":          flattenLaunchSpecifications(instanceFleet.LaunchSpecifications),
+		"resize_specifications":          flattenInstanceFleetResizingSpecifications(instanceFleet.ResizeSpecifications),
 	}
 
 	return []interface{}{m}
@@ -2346,6 +2549,46 @@ func expandLaunchSpecification(launchSpecification map[string]interface{}) *emr.
 	return fleetSpecification
 }
 
+func expandInstanceFleetResizingSpecifications(tfMap map[string]interface{}) *emr.InstanceFleetResizingSpecifications {
+	apiObject := &emr.InstanceFleetResizingSpecifications{}
+
+	if v, ok := tfMap["on_demand_resize_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnDemandResizeSpecification = &emr.OnDemandResizingSpecification{
+			TimeoutDurationMinutes: aws.Int64(int64(v[0].(map[string]interface{})["timeout_duration_minutes"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["spot_resize_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpotResizeSpecification = &emr.SpotResizingSpecification{
+			TimeoutDurationMinutes: aws.Int64(int64(v[0].(map[string]interface{})["timeout_duration_minutes"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenInstanceFleetResizingSpecifications(apiObject *emr.InstanceFleetResizingSpecifications) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OnDemandResizeSpecification; v != nil {
+		tfMap["on_demand_resize_specification"] = []interface{}{map[string]interface{}{
+			"timeout_duration_minutes": aws.Int64Value(v.TimeoutDurationMinutes),
+		}}
+	}
+
+	if v := apiObject.SpotResizeSpecification; v != nil {
+		tfMap["spot_resize_specification"] = []interface{}{map[string]interface{}{
+			"timeout_duration_minutes": aws.Int64Value(v.TimeoutDurationMinutes),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandConfigurations(configurations []interface{}) []*emr.Configuration {
 	configsOut := []*emr.Configuration{}
 
@@ -1702,6 +1702,112 @@ func TestAccEMRCluster_InstanceFleetMaster_only(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_InstanceFleet_resize(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_instanceFleetsResize(rName, 1, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.0.on_demand_resize_specification.0.timeout_duration_minutes", "20"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetsResize(rName, 2, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.provisioned_on_demand_capacity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.0.on_demand_resize_specification.0.timeout_duration_minutes", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRCluster_configurationsJSONReconfigure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "1000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json", regexache.MustCompile(`"yarn.nodemanager.vmem-pmem-ratio":"1000"`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "2000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json", regexache.MustCompile(`"yarn.nodemanager.vmem-pmem-ratio":"2000"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRCluster_configurationsJSONReconfigureTaskInstanceGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+	var instanceGroup emr.InstanceGroup
+
+	resourceName := "aws_emr_cluster.test"
+	instanceGroupResourceName := "aws_emr_instance_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigureTaskInstanceGroup(rName, "1000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					testAccCheckInstanceGroupExists(ctx, instanceGroupResourceName, &instanceGroup),
+					resource.TestMatchResourceAttr(instanceGroupResourceName, "configurations_json", regexache.MustCompile(`"yarn.nodemanager.vmem-pmem-ratio":"500"`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigureTaskInstanceGroup(rName, "2000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json", regexache.MustCompile(`"yarn.nodemanager.vmem-pmem-ratio":"2000"`)),
+					testAccCheckInstanceGroupExists(ctx, instanceGroupResourceName, &instanceGroup),
+					resource.TestMatchResourceAttr(instanceGroupResourceName, "configurations_json", regexache.MustCompile(`"yarn.nodemanager.vmem-pmem-ratio":"500"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_unhealthyNodeReplacement(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster emr.Cluster
@@ -3980,6 +4086,129 @@ resource "aws_emr_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_instanceFleetsResize(rName string, onDemandCapacity, timeout int) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.30.1"
+  applications  = ["Hadoop", "Hive"]
+
+  master_instance_fleet {
+    instance_type_configs {
+      instance_type = "m4.large"
+    }
+
+    target_on_demand_capacity = 1
+  }
+
+  core_instance_fleet {
+    instance_type_configs {
+      instance_type     = "m4.large"
+      weighted_capacity = 1
+    }
+
+    resize_specifications {
+      on_demand_resize_specification {
+        timeout_duration_minutes = %[3]d
+      }
+    }
+
+    name                      = "core fleet"
+    target_on_demand_capacity = %[2]d
+  }
+
+  service_role = aws_iam_role.emr_service.arn
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+}
+`, rName, onDemandCapacity, timeout))
+}
+
+func testAccClusterConfig_configurationsJSONReconfigure(rName, ratio string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.30.1"
+  applications  = ["Hadoop"]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+
+  master_instance_group {
+    instance_type = "m4.large"
+  }
+
+  core_instance_group {
+    instance_count = 1
+    instance_type  = "m4.large"
+  }
+
+  keep_job_flow_alive_when_no_steps = true
+  termination_protection            = false
+
+  configurations_json = jsonencode([
+    {
+      Classification = "yarn-site"
+      Properties = {
+        "yarn.nodemanager.vmem-pmem-ratio" = %[2]q
+      }
+    }
+  ])
+
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  service_role = aws_iam_role.emr_service.arn
+}
+`, rName, ratio))
+}
+
+func testAccClusterConfig_configurationsJSONReconfigureTaskInstanceGroup(rName, ratio string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_configurationsJSONReconfigure(rName, ratio),
+		`
+resource "aws_emr_instance_group" "test" {
+  cluster_id     = aws_emr_cluster.test.id
+  instance_count = 1
+  instance_type  = "m4.large"
+
+  configurations_json = jsonencode([
+    {
+      Classification = "yarn-site"
+      Properties = {
+        "yarn.nodemanager.vmem-pmem-ratio" = "500"
+      }
+    }
+  ])
+}
+`)
+}
+
 func testAccClusterConfig_instanceFleetMultipleSubnets(rName string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
@@ -378,6 +378,26 @@ func instanceGroupStateRefresh(ctx context.Context, conn *emr.EMR, clusterID, gr
 	}
 }
 
+// instanceGroupReconfigurationRefresh reports an instance group as RECONFIGURING until its
+// configurations version has moved past previousVersion and that version has been applied.
+func instanceGroupReconfigurationRefresh(ctx context.Context, conn *emr.EMR, clusterID, groupID string, previousVersion int64) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ig, state, err := instanceGroupStateRefresh(ctx, conn, clusterID, groupID)()
+		if err != nil {
+			return ig, state, err
+		}
+
+		group := ig.(*emr.InstanceGroup)
+		version := aws.Int64Value(group.ConfigurationsVersion)
+
+		if state == emr.InstanceGroupStateRunning && (version <= previousVersion || aws.Int64Value(group.LastSuccessfullyAppliedConfigurationsVersion) != version) {
+			return group, emr.InstanceGroupStateReconfiguring, nil
+		}
+
+		return group, state, nil
+	}
+}
+
 func fetchInstanceGroup(ctx context.Context, conn *emr.EMR, clusterID, groupID string) (*emr.InstanceGroup, error) {
 	input := &emr.ListInstanceGroupsInput{ClusterId: aws.String(clusterID)}
 
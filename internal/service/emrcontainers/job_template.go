@@ -226,6 +226,33 @@ func ResourceJobTemplate() *schema.Resource {
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"parameter_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 20,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDefaultValue: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      emrcontainers.TemplateParameterDataTypeString,
+										ValidateFunc: validation.StringInSlice(emrcontainers.TemplateParameterDataType_Values(), false),
+									},
+								},
+							},
+						},
 						"release_label": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -374,6 +401,10 @@ func expandJobTemplateData(tfMap map[string]interface{}) *emrcontainers.JobTempl
 		apiObject.JobTags = flex.ExpandStringMap(v)
 	}
 
+	if v, ok := tfMap["parameter_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ParameterConfiguration = expandParameterConfiguration(v.List())
+	}
+
 	if v, ok := tfMap["release_label"].(string); ok && v != "" {
 		apiObject.ReleaseLabel = aws.String(v)
 	}
@@ -381,6 +412,35 @@ func expandJobTemplateData(tfMap map[string]interface{}) *emrcontainers.JobTempl
 	return apiObject
 }
 
+func expandParameterConfiguration(tfList []interface{}) map[string]*emrcontainers.TemplateParameterConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*emrcontainers.TemplateParameterConfiguration)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &emrcontainers.TemplateParameterConfiguration{}
+
+		if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects[tfMap[names.AttrName].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
 func expandConfigurationOverrides(tfMap map[string]interface{}) *emrcontainers.ParametricConfigurationOverrides {
 	if tfMap == nil {
 		return nil
@@ -475,7 +535,7 @@ func expandCloudWatchMonitoringConfiguration(tfMap map[string]interface{}) *emrc
 
 	apiObject := &emrcontainers.ParametricCloudWatchMonitoringConfiguration{}
 
-	if v, ok := tfMap["log_group_mame"].(string); ok && v != "" {
+	if v, ok := tfMap[names.AttrLogGroupName].(string); ok && v != "" {
 		apiObject.LogGroupName = aws.String(v)
 	}
 
@@ -581,6 +641,10 @@ func flattenJobTemplateData(apiObject *emrcontainers.JobTemplateData) map[string
 		tfMap["job_tags"] = aws.StringValueMap(v)
 	}
 
+	if v := apiObject.ParameterConfiguration; v != nil {
+		tfMap["parameter_configuration"] = flattenParameterConfiguration(v)
+	}
+
 	if v := apiObject.ReleaseLabel; v != nil {
 		tfMap["release_label"] = aws.StringValue(v)
 	}
@@ -588,6 +652,28 @@ func flattenJobTemplateData(apiObject *emrcontainers.JobTemplateData) map[string
 	return tfMap
 }
 
+func flattenParameterConfiguration(apiObjects map[string]*emrcontainers.TemplateParameterConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDefaultValue: aws.StringValue(apiObject.DefaultValue),
+			names.AttrName:         name,
+			names.AttrType:         aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
 func flattenConfigurationOverrides(apiObject *emrcontainers.ParametricConfigurationOverrides) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -596,7 +682,7 @@ func flattenConfigurationOverrides(apiObject *emrcontainers.ParametricConfigurat
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.ApplicationConfiguration; v != nil {
-		tfMap["application_configuration"] = []interface{}{flattenConfigurations(v)}
+		tfMap["application_configuration"] = flattenConfigurations(v)
 	}
 
 	if v := apiObject.MonitoringConfiguration; v != nil {
@@ -635,6 +721,10 @@ func flattenConfiguration(apiObject *emrcontainers.Configuration) map[string]int
 		tfMap["classification"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.Configurations; v != nil {
+		tfMap["configurations"] = flattenConfigurations(v)
+	}
+
 	if v := apiObject.Properties; v != nil {
 		tfMap[names.AttrProperties] = aws.StringValueMap(v)
 	}
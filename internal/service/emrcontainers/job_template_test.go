@@ -113,6 +113,48 @@ func TestAccEMRContainersJobTemplate_tags(t *testing.T) {
 	})
 }
 
+func TestAccEMRContainersJobTemplate_parameterConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRContainersServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_parameterConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.parameter_configuration.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "job_template_data.0.parameter_configuration.*", map[string]string{
+						names.AttrName:         "EntryPoint",
+						names.AttrType:         "STRING",
+						names.AttrDefaultValue: "s3://example/entrypoint.py",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "job_template_data.0.parameter_configuration.*", map[string]string{
+						names.AttrName: "ExecutorCount",
+						names.AttrType: "NUMBER",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.0.classification", "spark-defaults"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.0.cloud_watch_monitoring_configuration.0.log_group_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckJobTemplateExists(ctx context.Context, n string, v *emrcontainers.JobTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -246,3 +288,69 @@ resource "aws_emrcontainers_job_template" "test" {
 }
 `, rName, tagKey1, tagValue1)
 }
+
+func testAccJobTemplateConfig_parameterConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "eks.${data.aws_partition.current.dns_suffix}",
+          "eks-nodegroup.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    configuration_overrides {
+      application_configuration {
+        classification = "spark-defaults"
+
+        properties = {
+          "spark.executor.instances" = "$${ExecutorCount}"
+        }
+      }
+
+      monitoring_configuration {
+        cloud_watch_monitoring_configuration {
+          log_group_name = %[1]q
+        }
+      }
+    }
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "$${EntryPoint}"
+      }
+    }
+
+    parameter_configuration {
+      name          = "EntryPoint"
+      type          = "STRING"
+      default_value = "s3://example/entrypoint.py"
+    }
+
+    parameter_configuration {
+      name = "ExecutorCount"
+      type = "NUMBER"
+    }
+  }
+
+  name = %[1]q
+}
+`, rName)
+}
@@ -44,6 +44,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceSecurityConfiguration,
+			TypeName: "aws_emrcontainers_security_configuration",
+			Name:     "Security Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceVirtualCluster,
 			TypeName: "aws_emrcontainers_virtual_cluster",
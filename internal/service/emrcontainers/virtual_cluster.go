@@ -103,6 +103,11 @@ func ResourceVirtualCluster() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_./#-]+`), "must contain only alphanumeric, hyphen, underscore, dot and # characters"),
 				),
 			},
+			"security_configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -126,6 +131,10 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		input.ContainerProvider = expandContainerProvider(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("security_configuration_id"); ok {
+		input.SecurityConfigurationId = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateVirtualClusterWithContext(ctx, input)
 
 	if err != nil {
@@ -163,6 +172,7 @@ func resourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("container_provider", nil)
 	}
 	d.Set(names.AttrName, vc.Name)
+	d.Set("security_configuration_id", vc.SecurityConfigurationId)
 
 	setTagsOut(ctx, vc.Tags)
 
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+// Exports for use in tests only.
+var (
+	ResourceIDMappingWorkflow = resourceIDMappingWorkflow
+	ResourceIDNamespace       = resourceIDNamespace
+	ResourceMatchingWorkflow  = resourceMatchingWorkflow
+	ResourceSchemaMapping     = resourceSchemaMapping
+
+	FindIDMappingWorkflowByName = findIDMappingWorkflowByName
+	FindIDNamespaceByName       = findIDNamespaceByName
+	FindMatchingWorkflowByName  = findMatchingWorkflowByName
+	FindSchemaMappingByName     = findSchemaMappingByName
+)
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_id_mapping_workflow", name="ID Mapping Workflow")
+// @Tags(identifierAttribute="arn")
+func resourceIDMappingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDMappingWorkflowCreate,
+		ReadWithoutTimeout:   resourceIDMappingWorkflowRead,
+		UpdateWithoutTimeout: resourceIDMappingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceIDMappingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"id_mapping_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdMappingType_Values(), false),
+						},
+						"provider_properties": providerPropertiesSchema(),
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdNamespaceType_Values(), false),
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKMSKeyARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIDMappingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateIdMappingWorkflowInput{
+		IdMappingTechniques: expandIDMappingTechniques(d.Get("id_mapping_techniques").([]interface{})),
+		InputSourceConfig:   expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+		RoleArn:             aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:                getTagsIn(ctx),
+		WorkflowName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_source_config"); ok {
+		input.OutputSourceConfig = expandIDMappingWorkflowOutputSources(v.([]interface{}))
+	}
+
+	output, err := conn.CreateIdMappingWorkflowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution ID Mapping Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkflowName))
+
+	return append(diags, resourceIDMappingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	output, err := findIDMappingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution ID Mapping Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.WorkflowArn)
+	d.Set(names.AttrDescription, output.Description)
+	if err := d.Set("id_mapping_techniques", flattenIDMappingTechniques(output.IdMappingTechniques)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting id_mapping_techniques: %s", err)
+	}
+	if err := d.Set("input_source_config", flattenIDMappingWorkflowInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenIDMappingWorkflowOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	return diags
+}
+
+func resourceIDMappingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &entityresolution.UpdateIdMappingWorkflowInput{
+			Description:         aws.String(d.Get(names.AttrDescription).(string)),
+			IdMappingTechniques: expandIDMappingTechniques(d.Get("id_mapping_techniques").([]interface{})),
+			InputSourceConfig:   expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+			RoleArn:             aws.String(d.Get(names.AttrRoleARN).(string)),
+			WorkflowName:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("output_source_config"); ok {
+			input.OutputSourceConfig = expandIDMappingWorkflowOutputSources(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateIdMappingWorkflowWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIDMappingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution ID Mapping Workflow: %s", d.Id())
+	_, err := conn.DeleteIdMappingWorkflowWithContext(ctx, &entityresolution.DeleteIdMappingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIDMappingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetIdMappingWorkflowOutput, error) {
+	input := &entityresolution.GetIdMappingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetIdMappingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIDMappingTechniques(tfList []interface{}) *entityresolution.IdMappingTechniques {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.IdMappingTechniques{
+		IdMappingType: aws.String(tfMap["id_mapping_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ProviderProperties = expandProviderProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandIDMappingWorkflowInputSources(tfList []interface{}) []*entityresolution.IdMappingWorkflowInputSource {
+	var apiObjects []*entityresolution.IdMappingWorkflowInputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdMappingWorkflowInputSource{
+			InputSourceARN: aws.String(tfMap["input_source_arn"].(string)),
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIDMappingWorkflowOutputSources(tfList []interface{}) []*entityresolution.IdMappingWorkflowOutputSource {
+	var apiObjects []*entityresolution.IdMappingWorkflowOutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdMappingWorkflowOutputSource{
+			OutputS3Path: aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIDMappingTechniques(apiObject *entityresolution.IdMappingTechniques) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"id_mapping_type":     aws.StringValue(apiObject.IdMappingType),
+		"provider_properties": flattenProviderProperties(apiObject.ProviderProperties),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIDMappingWorkflowInputSources(apiObjects []*entityresolution.IdMappingWorkflowInputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"input_source_arn": aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":      aws.StringValue(apiObject.SchemaName),
+			names.AttrType:     aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIDMappingWorkflowOutputSources(apiObjects []*entityresolution.IdMappingWorkflowOutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKMSKeyARN: aws.StringValue(apiObject.KMSArn),
+			"output_s3_path":    aws.StringValue(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
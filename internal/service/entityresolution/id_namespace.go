@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_id_namespace", name="ID Namespace")
+// @Tags(identifierAttribute="arn")
+func resourceIDNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDNamespaceCreate,
+		ReadWithoutTimeout:   resourceIDNamespaceRead,
+		UpdateWithoutTimeout: resourceIDNamespaceUpdate,
+		DeleteWithoutTimeout: resourceIDNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"id_mapping_workflow_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdMappingType_Values(), false),
+						},
+						"provider_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id_namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(entityresolution.IdNamespaceType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIDNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	name := d.Get("id_namespace_name").(string)
+	input := &entityresolution.CreateIdNamespaceInput{
+		IdNamespaceName: aws.String(name),
+		Tags:            getTagsIn(ctx),
+		Type:            aws.String(d.Get(names.AttrType).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("id_mapping_workflow_properties"); ok {
+		input.IdMappingWorkflowProperties = expandIDNamespaceIDMappingWorkflowProperties(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_source_config"); ok {
+		input.InputSourceConfig = expandIDNamespaceInputSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrRoleARN); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIdNamespaceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution ID Namespace (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IdNamespaceName))
+
+	return append(diags, resourceIDNamespaceRead(ctx, d, meta)...)
+}
+
+func resourceIDNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	output, err := findIDNamespaceByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution ID Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution ID Namespace (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.IdNamespaceArn)
+	d.Set(names.AttrDescription, output.Description)
+	if err := d.Set("id_mapping_workflow_properties", flattenIDNamespaceIDMappingWorkflowProperties(output.IdMappingWorkflowProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting id_mapping_workflow_properties: %s", err)
+	}
+	d.Set("id_namespace_name", output.IdNamespaceName)
+	if err := d.Set("input_source_config", flattenIDNamespaceInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrType, output.Type)
+
+	return diags
+}
+
+func resourceIDNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &entityresolution.UpdateIdNamespaceInput{
+			Description:     aws.String(d.Get(names.AttrDescription).(string)),
+			IdNamespaceName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("id_mapping_workflow_properties"); ok {
+			input.IdMappingWorkflowProperties = expandIDNamespaceIDMappingWorkflowProperties(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("input_source_config"); ok {
+			input.InputSourceConfig = expandIDNamespaceInputSources(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk(names.AttrRoleARN); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateIdNamespaceWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution ID Namespace (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIDNamespaceRead(ctx, d, meta)...)
+}
+
+func resourceIDNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution ID Namespace: %s", d.Id())
+	_, err := conn.DeleteIdNamespaceWithContext(ctx, &entityresolution.DeleteIdNamespaceInput{
+		IdNamespaceName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution ID Namespace (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIDNamespaceByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetIdNamespaceOutput, error) {
+	input := &entityresolution.GetIdNamespaceInput{
+		IdNamespaceName: aws.String(name),
+	}
+
+	output, err := conn.GetIdNamespaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIDNamespaceIDMappingWorkflowProperties(tfList []interface{}) []*entityresolution.IdNamespaceIdMappingWorkflowProperties {
+	var apiObjects []*entityresolution.IdNamespaceIdMappingWorkflowProperties
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdNamespaceIdMappingWorkflowProperties{
+			IdMappingType: aws.String(tfMap["id_mapping_type"].(string)),
+		}
+
+		if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ProviderProperties = &entityresolution.NamespaceProviderProperties{
+				ProviderServiceArn: aws.String(v[0].(map[string]interface{})["provider_service_arn"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIDNamespaceInputSources(tfList []interface{}) []*entityresolution.IdNamespaceInputSource {
+	var apiObjects []*entityresolution.IdNamespaceInputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdNamespaceInputSource{
+			InputSourceARN: aws.String(tfMap["input_source_arn"].(string)),
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIDNamespaceIDMappingWorkflowProperties(apiObjects []*entityresolution.IdNamespaceIdMappingWorkflowProperties) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id_mapping_type": aws.StringValue(apiObject.IdMappingType),
+		}
+
+		if v := apiObject.ProviderProperties; v != nil {
+			tfMap["provider_properties"] = []interface{}{map[string]interface{}{
+				"provider_service_arn": aws.StringValue(v.ProviderServiceArn),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIDNamespaceInputSources(apiObjects []*entityresolution.IdNamespaceInputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"input_source_arn": aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":      aws.StringValue(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,632 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_matching_workflow", name="Matching Workflow")
+// @Tags(identifierAttribute="arn")
+func resourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IncrementalRunType_Values(), false),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrKMSKeyARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 750,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": providerPropertiesSchema(),
+						"resolution_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.ResolutionType_Values(), false),
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 15,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 15,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 255),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func providerPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"intermediate_source_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"intermediate_s3_path": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"provider_service_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+		ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:                 getTagsIn(ctx),
+		WorkflowName:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok {
+		input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{}))
+	}
+
+	output, err := conn.CreateMatchingWorkflowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Matching Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkflowName))
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	output, err := findMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.WorkflowArn)
+	d.Set(names.AttrDescription, output.Description)
+	if err := d.Set("incremental_run_config", flattenIncrementalRunConfig(output.IncrementalRunConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting incremental_run_config: %s", err)
+	}
+	if err := d.Set("input_source_config", flattenInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	if err := d.Set("resolution_techniques", flattenResolutionTechniques(output.ResolutionTechniques)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resolution_techniques: %s", err)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	return diags
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &entityresolution.UpdateMatchingWorkflowInput{
+			Description:          aws.String(d.Get(names.AttrDescription).(string)),
+			InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+			ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+			RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+			WorkflowName:         aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok {
+			input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateMatchingWorkflowWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Matching Workflow: %s", d.Id())
+	_, err := conn.DeleteMatchingWorkflowWithContext(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMatchingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIncrementalRunConfig(tfList []interface{}) *entityresolution.IncrementalRunConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &entityresolution.IncrementalRunConfig{
+		IncrementalRunType: aws.String(tfMap["incremental_run_type"].(string)),
+	}
+}
+
+func expandInputSources(tfList []interface{}) []*entityresolution.InputSource {
+	var apiObjects []*entityresolution.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.InputSource{
+			ApplyNormalization: aws.Bool(tfMap["apply_normalization"].(bool)),
+			InputSourceARN:     aws.String(tfMap["input_source_arn"].(string)),
+			SchemaName:         aws.String(tfMap["schema_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []*entityresolution.OutputSource {
+	var apiObjects []*entityresolution.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputSource{
+			ApplyNormalization: aws.Bool(tfMap["apply_normalization"].(bool)),
+			Output:             expandOutputAttributes(tfMap["output"].([]interface{})),
+			OutputS3Path:       aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputAttributes(tfList []interface{}) []*entityresolution.OutputAttribute {
+	var apiObjects []*entityresolution.OutputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.OutputAttribute{
+			Hashed: aws.Bool(tfMap["hashed"].(bool)),
+			Name:   aws.String(tfMap[names.AttrName].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfList []interface{}) *entityresolution.ResolutionTechniques {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.ResolutionTechniques{
+		ResolutionType: aws.String(tfMap["resolution_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ProviderProperties = expandProviderProperties(v)
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RuleBasedProperties = expandRuleBasedProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandProviderProperties(tfList []interface{}) *entityresolution.ProviderProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.ProviderProperties{
+		ProviderServiceArn: aws.String(tfMap["provider_service_arn"].(string)),
+	}
+
+	if v, ok := tfMap["intermediate_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IntermediateSourceConfiguration = &entityresolution.IntermediateSourceConfiguration{
+			IntermediateS3Path: aws.String(v[0].(map[string]interface{})["intermediate_s3_path"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandRuleBasedProperties(tfList []interface{}) *entityresolution.RuleBasedProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.RuleBasedProperties{
+		AttributeMatchingModel: aws.String(tfMap["attribute_matching_model"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["rule"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Rules = append(apiObject.Rules, &entityresolution.Rule{
+			MatchingKeys: flex.ExpandStringList(tfMap["matching_keys"].([]interface{})),
+			RuleName:     aws.String(tfMap["rule_name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalRunConfig(apiObject *entityresolution.IncrementalRunConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"incremental_run_type": aws.StringValue(apiObject.IncrementalRunType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInputSources(apiObjects []*entityresolution.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":         aws.StringValue(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []*entityresolution.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			names.AttrKMSKeyARN:   aws.StringValue(apiObject.KMSArn),
+			"output":              flattenOutputAttributes(apiObject.Output),
+			"output_s3_path":      aws.StringValue(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputAttributes(apiObjects []*entityresolution.OutputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hashed":       aws.BoolValue(apiObject.Hashed),
+			names.AttrName: aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *entityresolution.ResolutionTechniques) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider_properties":   flattenProviderProperties(apiObject.ProviderProperties),
+		"resolution_type":       aws.StringValue(apiObject.ResolutionType),
+		"rule_based_properties": flattenRuleBasedProperties(apiObject.RuleBasedProperties),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenProviderProperties(apiObject *entityresolution.ProviderProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider_service_arn": aws.StringValue(apiObject.ProviderServiceArn),
+	}
+
+	if v := apiObject.IntermediateSourceConfiguration; v != nil {
+		tfMap["intermediate_source_configuration"] = []interface{}{map[string]interface{}{
+			"intermediate_s3_path": aws.StringValue(v.IntermediateS3Path),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRuleBasedProperties(apiObject *entityresolution.RuleBasedProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var rules []interface{}
+
+	for _, rule := range apiObject.Rules {
+		if rule == nil {
+			continue
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"matching_keys": aws.StringValueSlice(rule.MatchingKeys),
+			"rule_name":     aws.StringValue(rule.RuleName),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"attribute_matching_model": aws.StringValue(apiObject.AttributeMatchingModel),
+		"rule":                     rules,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -40,6 +40,20 @@ func resourceBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dead_letter_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"event_source_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -70,6 +84,10 @@ func resourceBusCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		Tags: getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 {
+		input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("event_source_name"); ok {
 		input.EventSourceName = aws.String(v.(string))
 	}
@@ -123,6 +141,13 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	d.Set(names.AttrARN, output.Arn)
+	if output.DeadLetterConfig != nil && output.DeadLetterConfig.Arn != nil {
+		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(output.DeadLetterConfig)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting dead_letter_config: %s", err)
+		}
+	} else {
+		d.Set("dead_letter_config", nil)
+	}
 	d.Set(names.AttrName, output.Name)
 
 	return diags
@@ -130,8 +155,21 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta interface
 
 func resourceBusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
 
-	// Tags only.
+	if d.HasChange("dead_letter_config") {
+		input := &eventbridge.UpdateEventBusInput{
+			// An empty dead-letter configuration removes the dead-letter queue.
+			DeadLetterConfig: expandDeadLetterParametersConfig(d.Get("dead_letter_config").([]interface{})),
+			Name:             aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateEventBus(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EventBridge Event Bus (%s): %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceBusRead(ctx, d, meta)...)
 }
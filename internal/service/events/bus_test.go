@@ -122,6 +122,56 @@ func TestAccEventsBus_tags(t *testing.T) {
 	})
 }
 
+func TestAccEventsBus_deadLetterConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 eventbridge.DescribeEventBusOutput
+	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBusConfig_deadLetterConfigEmpty(busName),
+				ExpectError: regexache.MustCompile(`The argument "arn" is required`),
+			},
+			{
+				Config: testAccBusConfig_deadLetterConfig(busName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", "aws_sqs_queue.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBusConfig_deadLetterConfig(busName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusExists(ctx, resourceName, &v2),
+					testAccCheckBusNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", "aws_sqs_queue.test.1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccBusConfig_basic(busName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusExists(ctx, resourceName, &v3),
+					testAccCheckBusNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEventsBus_default(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -267,6 +317,34 @@ resource "aws_cloudwatch_event_bus" "test" {
 `, name)
 }
 
+func testAccBusConfig_deadLetterConfig(name string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+
+  dead_letter_config {
+    arn = aws_sqs_queue.test[%[2]d].arn
+  }
+}
+`, name, index)
+}
+
+func testAccBusConfig_deadLetterConfigEmpty(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+
+  dead_letter_config {}
+}
+`, name)
+}
+
 func testAccBusConfig_tags1(name, key, value string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
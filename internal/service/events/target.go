@@ -1002,7 +1002,10 @@ func expandDeadLetterParametersConfig(dlp []interface{}) *types.DeadLetterConfig
 	deadLetterConfig := &types.DeadLetterConfig{}
 
 	for _, v := range dlp {
-		params := v.(map[string]interface{})
+		params, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
 
 		if val, ok := params[names.AttrARN].(string); ok && val != "" {
 			deadLetterConfig.Arn = aws.String(val)
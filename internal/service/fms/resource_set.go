@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -135,10 +136,10 @@ func (r *resourceResourceSet) Create(ctx context.Context, req resource.CreateReq
 
 	out, err := conn.PutResourceSet(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.FMS, create.ErrActionCreating, ResNameResourceSet, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.ResourceSet == nil {
@@ -155,10 +156,10 @@ func (r *resourceResourceSet) Create(ctx context.Context, req resource.CreateReq
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	output, err := waitResourceSetCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.FMS, create.ErrActionWaitingForCreation, ResNameResourceSet, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -185,10 +186,10 @@ func (r *resourceResourceSet) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.FMS, create.ErrActionSetting, ResNameResourceSet, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -215,10 +216,10 @@ func (r *resourceResourceSet) Update(ctx context.Context, req resource.UpdateReq
 
 		out, err := conn.PutResourceSet(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.FMS, create.ErrActionUpdating, ResNameResourceSet, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil || out.ResourceSet == nil {
@@ -238,10 +239,10 @@ func (r *resourceResourceSet) Update(ctx context.Context, req resource.UpdateReq
 	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
 	_, err := waitResourceSetUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.FMS, create.ErrActionWaitingForUpdate, ResNameResourceSet, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -266,20 +267,20 @@ func (r *resourceResourceSet) Delete(ctx context.Context, req resource.DeleteReq
 		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.FMS, create.ErrActionDeleting, ResNameResourceSet, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitResourceSetDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.FMS, create.ErrActionWaitingForDeletion, ResNameResourceSet, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -754,7 +754,8 @@ func expandLustreMetadataUpdateConfiguration(l []interface{}) *fsx.UpdateFileSys
 		Mode: aws.String(data[names.AttrMode].(string)),
 	}
 
-	if v, ok := data[names.AttrIOPS].(int); ok && v != 0 {
+	// IOPS are only accepted in USER_PROVISIONED mode. A computed value is carried over from state when switching to AUTOMATIC.
+	if v, ok := data[names.AttrIOPS].(int); ok && v != 0 && aws.StringValue(req.Mode) == fsx.MetadataConfigurationModeUserProvisioned {
 		req.Iops = aws.Int64(int64(v))
 	}
 
@@ -0,0 +1,683 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_container_fleet", name="Container Fleet")
+// @Tags(identifierAttribute="arn")
+func ResourceContainerFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerFleetCreate,
+		ReadWithoutTimeout:   resourceContainerFleetRead,
+		UpdateWithoutTimeout: resourceContainerFleetUpdate,
+		DeleteWithoutTimeout: resourceFleetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(fleetCreatedDefaultTimeout),
+			Update: schema.DefaultTimeout(fleetCreatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(FleetDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      gamelift.CertificateTypeDisabled,
+							ValidateFunc: validation.StringInSlice(gamelift.CertificateType_Values(), false),
+						},
+					},
+				},
+			},
+			"container_groups_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_port_range": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"from_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"to_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+								},
+							},
+						},
+						"container_group_definition_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 512),
+							},
+						},
+						"desired_replica_container_groups_per_instance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_replica_container_groups_per_instance": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"ec2_inbound_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						names.AttrProtocol: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(gamelift.IpProtocol_Values(), false),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"ec2_instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
+			},
+			"fleet_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      gamelift.FleetTypeOnDemand,
+				ValidateFunc: validation.StringInSlice(gamelift.FleetType_Values(), false),
+			},
+			"instance_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrLocation: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      containerFleetLocationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_ec2_instances": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrLocation: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"metric_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"new_game_session_protection_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      gamelift.ProtectionPolicyNoProtection,
+				ValidateFunc: validation.StringInSlice(gamelift.ProtectionPolicy_Values(), false),
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_creation_limit_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"new_game_sessions_per_creator": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"policy_period_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContainerFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateFleetInput{
+		ComputeType:                  aws.String(gamelift.ComputeTypeContainer),
+		ContainerGroupsConfiguration: expandContainerGroupsConfiguration(d.Get("container_groups_configuration").([]interface{})),
+		EC2InstanceType:              aws.String(d.Get("ec2_instance_type").(string)),
+		Name:                         aws.String(name),
+		Tags:                         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("certificate_configuration"); ok {
+		input.CertificateConfiguration = expandCertificateConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ec2_inbound_permission"); ok {
+		input.EC2InboundPermissions = expandIPPermissions(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("fleet_type"); ok {
+		input.FleetType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_role_arn"); ok {
+		input.InstanceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrLocation); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("metric_groups"); ok {
+		input.MetricGroups = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("new_game_session_protection_policy"); ok {
+		input.NewGameSessionProtectionPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_creation_limit_policy"); ok {
+		input.ResourceCreationLimitPolicy = expandResourceCreationLimitPolicy(v.([]interface{}))
+	}
+
+	var output *gamelift.CreateFleetOutput
+	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+		var err error
+		output, err = conn.CreateFleetWithContext(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeInvalidRequestException, "GameLift is not authorized to perform") {
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.CreateFleetWithContext(ctx, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Container Fleet (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.FleetAttributes.FleetId))
+
+	if _, err := waitFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	region := meta.(*conns.AWSClient).Region
+	for _, v := range input.Locations {
+		location := aws.StringValue(v.Location)
+
+		if location == region {
+			continue
+		}
+
+		if _, err := waitFleetLocationActive(ctx, conn, d.Id(), location, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) location (%s) create: %s", d.Id(), location, err)
+		}
+	}
+
+	for location, input := range expandFleetLocationCapacities(d) {
+		input.FleetId = aws.String(d.Id())
+
+		if _, err := conn.UpdateFleetCapacityWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s) location (%s) capacity: %s", d.Id(), location, err)
+		}
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleet, err := FindFleetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Container Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, fleet.FleetArn)
+	if err := d.Set("certificate_configuration", flattenCertificateConfiguration(fleet.CertificateConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_configuration: %s", err)
+	}
+	if err := d.Set("container_groups_configuration", flattenContainerGroupsAttributes(fleet.ContainerGroupsAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_groups_configuration: %s", err)
+	}
+	d.Set(names.AttrDescription, fleet.Description)
+	d.Set("ec2_instance_type", fleet.InstanceType)
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("instance_role_arn", fleet.InstanceRoleArn)
+	d.Set("metric_groups", flex.FlattenStringList(fleet.MetricGroups))
+	d.Set(names.AttrName, fleet.Name)
+	d.Set("new_game_session_protection_policy", fleet.NewGameSessionProtectionPolicy)
+	d.Set("operating_system", fleet.OperatingSystem)
+	if err := d.Set("resource_creation_limit_policy", flattenResourceCreationLimitPolicy(fleet.ResourceCreationLimitPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_creation_limit_policy: %s", err)
+	}
+
+	portConfig, err := conn.DescribeFleetPortSettingsWithContext(ctx, &gamelift.DescribeFleetPortSettingsInput{
+		FleetId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s) port settings: %s", d.Id(), err)
+	}
+
+	if err := d.Set("ec2_inbound_permission", flattenIPPermissions(portConfig.InboundPermissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ec2_inbound_permission: %s", err)
+	}
+
+	locations, err := findFleetLocationAttributes(ctx, conn, &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s) locations: %s", d.Id(), err)
+	}
+
+	// The home Region is only tracked when it's explicitly configured.
+	region := meta.(*conns.AWSClient).Region
+	var homeRegionConfigured bool
+	for _, tfMapRaw := range d.Get(names.AttrLocation).(*schema.Set).List() {
+		if tfMapRaw.(map[string]interface{})[names.AttrLocation].(string) == region {
+			homeRegionConfigured = true
+		}
+	}
+
+	var tfList []interface{}
+	for _, v := range locations {
+		if v.LocationState == nil {
+			continue
+		}
+
+		location := aws.StringValue(v.LocationState.Location)
+
+		if location == region && !homeRegionConfigured {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrLocation: location,
+		}
+
+		capacity, err := FindFleetLocationCapacityByTwoPartKey(ctx, conn, d.Id(), location)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s) location (%s) capacity: %s", d.Id(), location, err)
+		default:
+			tfMap["desired_ec2_instances"] = aws.Int64Value(capacity.DESIRED)
+			tfMap["max_size"] = aws.Int64Value(capacity.MAXIMUM)
+			tfMap["min_size"] = aws.Int64Value(capacity.MINIMUM)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if err := d.Set(names.AttrLocation, tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+	}
+
+	return diags
+}
+
+func resourceContainerFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, "metric_groups", names.AttrName, "new_game_session_protection_policy", "resource_creation_limit_policy") {
+		_, err := conn.UpdateFleetAttributesWithContext(ctx, &gamelift.UpdateFleetAttributesInput{
+			Description:                    aws.String(d.Get(names.AttrDescription).(string)),
+			FleetId:                        aws.String(d.Id()),
+			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
+			Name:                           aws.String(d.Get(names.AttrName).(string)),
+			NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
+			ResourceCreationLimitPolicy:    expandResourceCreationLimitPolicy(d.Get("resource_creation_limit_policy").([]interface{})),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s) attributes: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ec2_inbound_permission") {
+		o, n := d.GetChange("ec2_inbound_permission")
+		authorizations, revocations := DiffPortSettings(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		_, err := conn.UpdateFleetPortSettingsWithContext(ctx, &gamelift.UpdateFleetPortSettingsInput{
+			FleetId:                         aws.String(d.Id()),
+			InboundPermissionAuthorizations: authorizations,
+			InboundPermissionRevocations:    revocations,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s) port settings: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrLocation) {
+		o, n := d.GetChange(names.AttrLocation)
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := ns.Difference(os).List(), os.Difference(ns).List()
+		region := meta.(*conns.AWSClient).Region
+
+		if len(del) > 0 {
+			locations := locationNames(del)
+
+			_, err := conn.DeleteFleetLocationsWithContext(ctx, &gamelift.DeleteFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: aws.StringSlice(locations),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting GameLift Container Fleet (%s) locations: %s", d.Id(), err)
+			}
+
+			for _, location := range locations {
+				if _, err := waitFleetLocationDeleted(ctx, conn, d.Id(), location, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) location (%s) delete: %s", d.Id(), location, err)
+				}
+			}
+		}
+
+		if len(add) > 0 {
+			_, err := conn.CreateFleetLocationsWithContext(ctx, &gamelift.CreateFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: expandLocationConfigurations(add),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating GameLift Container Fleet (%s) locations: %s", d.Id(), err)
+			}
+
+			for _, location := range locationNames(add) {
+				if location == region {
+					continue
+				}
+
+				if _, err := waitFleetLocationActive(ctx, conn, d.Id(), location, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) location (%s) create: %s", d.Id(), location, err)
+				}
+			}
+		}
+
+		old := make(map[string]map[string]interface{})
+		for _, tfMapRaw := range os.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			old[tfMap[names.AttrLocation].(string)] = tfMap
+		}
+
+		for location, input := range expandFleetLocationCapacities(d) {
+			if tfMap, ok := old[location]; ok &&
+				(input.DesiredInstances == nil || aws.Int64Value(input.DesiredInstances) == int64(tfMap["desired_ec2_instances"].(int))) &&
+				(input.MaxSize == nil || aws.Int64Value(input.MaxSize) == int64(tfMap["max_size"].(int))) &&
+				(input.MinSize == nil || aws.Int64Value(input.MinSize) == int64(tfMap["min_size"].(int))) {
+				continue
+			}
+
+			input.FleetId = aws.String(d.Id())
+
+			if _, err := conn.UpdateFleetCapacityWithContext(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s) location (%s) capacity: %s", d.Id(), location, err)
+			}
+		}
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func containerFleetLocationHash(v interface{}) int {
+	return create.StringHashcode(v.(map[string]interface{})[names.AttrLocation].(string))
+}
+
+func locationNames(tfList []interface{}) []string {
+	var locations []string
+
+	for _, tfMapRaw := range tfList {
+		locations = append(locations, tfMapRaw.(map[string]interface{})[names.AttrLocation].(string))
+	}
+
+	return locations
+}
+
+func expandLocationConfigurations(tfList []interface{}) []*gamelift.LocationConfiguration {
+	var apiObjects []*gamelift.LocationConfiguration
+
+	for _, location := range locationNames(tfList) {
+		apiObjects = append(apiObjects, &gamelift.LocationConfiguration{
+			Location: aws.String(location),
+		})
+	}
+
+	return apiObjects
+}
+
+// expandFleetLocationCapacities returns the capacity settings explicitly configured for each location.
+// The raw configuration is used so that unset (computed) values are not sent.
+func expandFleetLocationCapacities(d *schema.ResourceData) map[string]*gamelift.UpdateFleetCapacityInput {
+	inputs := make(map[string]*gamelift.UpdateFleetCapacityInput)
+
+	v := d.GetRawConfig().GetAttr(names.AttrLocation)
+	if v.IsNull() || !v.IsKnown() {
+		return inputs
+	}
+
+	int64Value := func(v cty.Value) *int64 {
+		if v.IsNull() || !v.IsKnown() {
+			return nil
+		}
+
+		i, _ := v.AsBigFloat().Int64()
+
+		return aws.Int64(i)
+	}
+
+	for _, v := range v.AsValueSlice() {
+		location := v.GetAttr(names.AttrLocation).AsString()
+		input := &gamelift.UpdateFleetCapacityInput{
+			DesiredInstances: int64Value(v.GetAttr("desired_ec2_instances")),
+			Location:         aws.String(location),
+			MaxSize:          int64Value(v.GetAttr("max_size")),
+			MinSize:          int64Value(v.GetAttr("min_size")),
+		}
+
+		if input.DesiredInstances == nil && input.MaxSize == nil && input.MinSize == nil {
+			continue
+		}
+
+		inputs[location] = input
+	}
+
+	return inputs
+}
+
+func expandContainerGroupsConfiguration(tfList []interface{}) *gamelift.ContainerGroupsConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &gamelift.ContainerGroupsConfiguration{
+		ContainerGroupDefinitionNames: flex.ExpandStringList(tfMap["container_group_definition_names"].([]interface{})),
+	}
+
+	if v, ok := tfMap["connection_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ConnectionPortRange = &gamelift.ConnectionPortRange{
+			FromPort: aws.Int64(int64(tfMap["from_port"].(int))),
+			ToPort:   aws.Int64(int64(tfMap["to_port"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["desired_replica_container_groups_per_instance"].(int); ok && v != 0 {
+		apiObject.DesiredReplicaContainerGroupsPerInstance = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenContainerGroupsAttributes(apiObject *gamelift.ContainerGroupsAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var definitionNames []string
+	for _, v := range apiObject.ContainerGroupDefinitionProperties {
+		definitionNames = append(definitionNames, aws.StringValue(v.ContainerGroupDefinitionName))
+	}
+
+	tfMap := map[string]interface{}{
+		"container_group_definition_names": definitionNames,
+	}
+
+	if v := apiObject.ConnectionPortRange; v != nil {
+		tfMap["connection_port_range"] = []interface{}{map[string]interface{}{
+			"from_port": aws.Int64Value(v.FromPort),
+			"to_port":   aws.Int64Value(v.ToPort),
+		}}
+	}
+
+	if v := apiObject.ContainerGroupsPerInstance; v != nil {
+		tfMap["desired_replica_container_groups_per_instance"] = aws.Int64Value(v.DesiredReplicaContainerGroupsPerInstance)
+		tfMap["max_replica_container_groups_per_instance"] = aws.Int64Value(v.MaxReplicaContainerGroupsPerInstance)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftContainerFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.FleetAttributes
+	resourceName := "aws_gamelift_container_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`fleet/fleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.0.connection_port_range.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.0.container_group_definition_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "container_groups_configuration.0.container_group_definition_names.0", "aws_gamelift_container_group_definition.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "ec2_instance_type", "c5.large"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", gamelift.FleetTypeOnDemand),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.FleetAttributes
+	resourceName := "aws_gamelift_container_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_location(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.FleetAttributes
+	resourceName := "aws_gamelift_container_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_location(rName, imageURI, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						names.AttrLocation:      acctest.Region(),
+						"desired_ec2_instances": acctest.Ct1,
+						"max_size":              acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						names.AttrLocation: acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerFleetConfig_location(rName, imageURI, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						names.AttrLocation:      acctest.Region(),
+						"desired_ec2_instances": acctest.Ct2,
+						"max_size":              acctest.Ct3,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerFleetExists(ctx context.Context, n string, v *gamelift.FleetAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		output, err := tfgamelift.FindFleetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_container_fleet" {
+				continue
+			}
+
+			_, err := tfgamelift.FindFleetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Container Fleet %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerFleetConfig_base(rName, imageURI string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name               = %[1]q
+  operating_system   = "AMAZON_LINUX_2023"
+  total_cpu_limit    = 512
+  total_memory_limit = 1024
+
+  container_definitions {
+    container_name = "server"
+    essential      = true
+    image_uri      = %[2]q
+
+    port_configuration {
+      container_port_range {
+        from_port = 7777
+        protocol  = "UDP"
+        to_port   = 7777
+      }
+    }
+  }
+}
+`, rName, imageURI)
+}
+
+func testAccContainerFleetConfig_basic(rName, imageURI string) string {
+	return acctest.ConfigCompose(testAccContainerFleetConfig_base(rName, imageURI), fmt.Sprintf(`
+resource "aws_gamelift_container_fleet" "test" {
+  name              = %[1]q
+  ec2_instance_type = "c5.large"
+
+  container_groups_configuration {
+    container_group_definition_names = [aws_gamelift_container_group_definition.test.name]
+
+    connection_port_range {
+      from_port = 10000
+      to_port   = 10100
+    }
+  }
+}
+`, rName))
+}
+
+func testAccContainerFleetConfig_location(rName, imageURI string, desired, maxSize int) string {
+	return acctest.ConfigCompose(testAccContainerFleetConfig_base(rName, imageURI), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_gamelift_container_fleet" "test" {
+  name              = %[1]q
+  ec2_instance_type = "c5.large"
+
+  container_groups_configuration {
+    container_group_definition_names = [aws_gamelift_container_group_definition.test.name]
+
+    connection_port_range {
+      from_port = 10000
+      to_port   = 10100
+    }
+  }
+
+  location {
+    location              = data.aws_region.current.name
+    desired_ec2_instances = %[2]d
+    max_size              = %[3]d
+    min_size              = 0
+  }
+
+  location {
+    location = %[4]q
+  }
+}
+`, rName, desired, maxSize, acctest.AlternateRegion()))
+}
@@ -0,0 +1,616 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_container_group_definition", name="Container Group Definition")
+// @Tags(identifierAttribute="arn")
+func ResourceContainerGroupDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerGroupDefinitionCreate,
+		ReadWithoutTimeout:   resourceContainerGroupDefinitionRead,
+		UpdateWithoutTimeout: resourceContainerGroupDefinitionUpdate,
+		DeleteWithoutTimeout: resourceContainerGroupDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_definitions": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
+						"container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"cpu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 10240),
+						},
+						"depends_on": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(gamelift.ContainerDependencyCondition_Values(), false),
+									},
+									"container_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+						"entry_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+						names.AttrEnvironment: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									names.AttrValue: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrHealthCheck: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 20,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+									names.AttrInterval: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(60, 300),
+									},
+									"retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(5, 10),
+									},
+									"start_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 300),
+									},
+									names.AttrTimeout: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(30, 60),
+									},
+								},
+							},
+						},
+						"image_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"memory_limits": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hard_limit": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(4, 1024000),
+									},
+									"soft_limit": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(4, 1024000),
+									},
+								},
+							},
+						},
+						"port_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_port_range": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"from_port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+												names.AttrProtocol: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(gamelift.IpProtocol_Values(), false),
+												},
+												"to_port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"resolved_image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"working_directory": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"operating_system": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ContainerOperatingSystem_Values(), false),
+			},
+			"scheduling_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ContainerSchedulingStrategy_Values(), false),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"total_cpu_limit": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(128, 10240),
+			},
+			"total_memory_limit": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(4, 1024000),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContainerGroupDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateContainerGroupDefinitionInput{
+		ContainerDefinitions: expandContainerDefinitionInputs(d.Get("container_definitions").([]interface{})),
+		Name:                 aws.String(name),
+		OperatingSystem:      aws.String(d.Get("operating_system").(string)),
+		Tags:                 getTagsIn(ctx),
+		TotalCpuLimit:        aws.Int64(int64(d.Get("total_cpu_limit").(int))),
+		TotalMemoryLimit:     aws.Int64(int64(d.Get("total_memory_limit").(int))),
+	}
+
+	if v, ok := d.GetOk("scheduling_strategy"); ok {
+		input.SchedulingStrategy = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateContainerGroupDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Container Group Definition (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitContainerGroupDefinitionReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Group Definition (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContainerGroupDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceContainerGroupDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	definition, err := FindContainerGroupDefinitionByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Container Group Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Group Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, definition.ContainerGroupDefinitionArn)
+	if err := d.Set("container_definitions", flattenContainerDefinitions(definition.ContainerDefinitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_definitions: %s", err)
+	}
+	d.Set(names.AttrName, definition.Name)
+	d.Set("operating_system", definition.OperatingSystem)
+	d.Set("scheduling_strategy", definition.SchedulingStrategy)
+	d.Set(names.AttrStatus, definition.Status)
+	d.Set("total_cpu_limit", definition.TotalCpuLimit)
+	d.Set("total_memory_limit", definition.TotalMemoryLimit)
+
+	return diags
+}
+
+func resourceContainerGroupDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceContainerGroupDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceContainerGroupDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	log.Printf("[INFO] Deleting GameLift Container Group Definition: %s", d.Id())
+	_, err := conn.DeleteContainerGroupDefinitionWithContext(ctx, &gamelift.DeleteContainerGroupDefinitionInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Container Group Definition (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandContainerDefinitionInputs(tfList []interface{}) []*gamelift.ContainerDefinitionInput_ {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*gamelift.ContainerDefinitionInput_
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &gamelift.ContainerDefinitionInput_{
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+			ImageUri:      aws.String(tfMap["image_uri"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandContainerDependencies(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap[names.AttrEnvironment].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Environment = expandContainerEnvironments(v.List())
+		}
+
+		if v, ok := tfMap["essential"].(bool); ok {
+			apiObject.Essential = aws.Bool(v)
+		}
+
+		if v, ok := tfMap[names.AttrHealthCheck].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandContainerHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["memory_limits"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MemoryLimits = expandContainerMemoryLimits(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["port_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.PortConfiguration = expandContainerPortConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDependencies(tfList []interface{}) []*gamelift.ContainerDependency {
+	var apiObjects []*gamelift.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &gamelift.ContainerDependency{
+			Condition:     aws.String(tfMap[names.AttrCondition].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerEnvironments(tfList []interface{}) []*gamelift.ContainerEnvironment {
+	var apiObjects []*gamelift.ContainerEnvironment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &gamelift.ContainerEnvironment{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerHealthCheck(tfMap map[string]interface{}) *gamelift.ContainerHealthCheck {
+	apiObject := &gamelift.ContainerHealthCheck{
+		Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap[names.AttrInterval].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap[names.AttrTimeout].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerMemoryLimits(tfMap map[string]interface{}) *gamelift.ContainerMemoryLimits {
+	apiObject := &gamelift.ContainerMemoryLimits{}
+
+	if v, ok := tfMap["hard_limit"].(int); ok && v != 0 {
+		apiObject.HardLimit = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["soft_limit"].(int); ok && v != 0 {
+		apiObject.SoftLimit = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerPortConfiguration(tfMap map[string]interface{}) *gamelift.ContainerPortConfiguration {
+	apiObject := &gamelift.ContainerPortConfiguration{}
+
+	if v, ok := tfMap["container_port_range"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.ContainerPortRanges = append(apiObject.ContainerPortRanges, &gamelift.ContainerPortRange{
+				FromPort: aws.Int64(int64(tfMap["from_port"].(int))),
+				Protocol: aws.String(tfMap[names.AttrProtocol].(string)),
+				ToPort:   aws.Int64(int64(tfMap["to_port"].(int))),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenContainerDefinitions(apiObjects []*gamelift.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"command":               aws.StringValueSlice(apiObject.Command),
+			"container_name":        aws.StringValue(apiObject.ContainerName),
+			"cpu":                   aws.Int64Value(apiObject.Cpu),
+			"entry_point":           aws.StringValueSlice(apiObject.EntryPoint),
+			"essential":             aws.BoolValue(apiObject.Essential),
+			"image_uri":             aws.StringValue(apiObject.ImageUri),
+			"resolved_image_digest": aws.StringValue(apiObject.ResolvedImageDigest),
+			"working_directory":     aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		var dependsOn []interface{}
+		for _, v := range apiObject.DependsOn {
+			dependsOn = append(dependsOn, map[string]interface{}{
+				names.AttrCondition: aws.StringValue(v.Condition),
+				"container_name":    aws.StringValue(v.ContainerName),
+			})
+		}
+		tfMap["depends_on"] = dependsOn
+
+		var environment []interface{}
+		for _, v := range apiObject.Environment {
+			environment = append(environment, map[string]interface{}{
+				names.AttrName:  aws.StringValue(v.Name),
+				names.AttrValue: aws.StringValue(v.Value),
+			})
+		}
+		tfMap[names.AttrEnvironment] = environment
+
+		if v := apiObject.HealthCheck; v != nil {
+			tfMap[names.AttrHealthCheck] = []interface{}{map[string]interface{}{
+				"command":          aws.StringValueSlice(v.Command),
+				names.AttrInterval: aws.Int64Value(v.Interval),
+				"retries":          aws.Int64Value(v.Retries),
+				"start_period":     aws.Int64Value(v.StartPeriod),
+				names.AttrTimeout:  aws.Int64Value(v.Timeout),
+			}}
+		}
+
+		if v := apiObject.MemoryLimits; v != nil {
+			tfMap["memory_limits"] = []interface{}{map[string]interface{}{
+				"hard_limit": aws.Int64Value(v.HardLimit),
+				"soft_limit": aws.Int64Value(v.SoftLimit),
+			}}
+		}
+
+		if v := apiObject.PortConfiguration; v != nil {
+			var portRanges []interface{}
+			for _, v := range v.ContainerPortRanges {
+				portRanges = append(portRanges, map[string]interface{}{
+					"from_port":        aws.Int64Value(v.FromPort),
+					names.AttrProtocol: aws.StringValue(v.Protocol),
+					"to_port":          aws.Int64Value(v.ToPort),
+				})
+			}
+
+			tfMap["port_configuration"] = []interface{}{map[string]interface{}{
+				"container_port_range": portRanges,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -38,6 +38,31 @@ func FindBuildByID(ctx context.Context, conn *gamelift.GameLift, id string) (*ga
 	return output.Build, nil
 }
 
+func FindContainerGroupDefinitionByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.ContainerGroupDefinition, error) {
+	input := &gamelift.DescribeContainerGroupDefinitionInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeContainerGroupDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerGroupDefinition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerGroupDefinition, nil
+}
+
 func FindFleetByID(ctx context.Context, conn *gamelift.GameLift, id string) (*gamelift.FleetAttributes, error) {
 	input := &gamelift.DescribeFleetAttributesInput{
 		FleetIds: aws.StringSlice([]string{id}),
@@ -73,6 +98,92 @@ func FindFleetByID(ctx context.Context, conn *gamelift.GameLift, id string) (*ga
 	return fleet, nil
 }
 
+func FindFleetLocationAttributesByTwoPartKey(ctx context.Context, conn *gamelift.GameLift, fleetID, location string) (*gamelift.LocationAttributes, error) {
+	input := &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId:   aws.String(fleetID),
+		Locations: aws.StringSlice([]string{location}),
+	}
+
+	output, err := findFleetLocationAttributes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if v.LocationState != nil && aws.StringValue(v.LocationState.Location) == location {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func findFleetLocationAttributes(ctx context.Context, conn *gamelift.GameLift, input *gamelift.DescribeFleetLocationAttributesInput) ([]*gamelift.LocationAttributes, error) {
+	var output []*gamelift.LocationAttributes
+
+	for {
+		page, err := conn.DescribeFleetLocationAttributesWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.LocationAttributes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func FindFleetLocationCapacityByTwoPartKey(ctx context.Context, conn *gamelift.GameLift, fleetID, location string) (*gamelift.EC2InstanceCounts, error) {
+	input := &gamelift.DescribeFleetLocationCapacityInput{
+		FleetId:  aws.String(fleetID),
+		Location: aws.String(location),
+	}
+
+	output, err := conn.DescribeFleetLocationCapacityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FleetCapacity == nil || output.FleetCapacity.InstanceCounts == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FleetCapacity.InstanceCounts, nil
+}
+
 func FindGameServerGroupByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.GameServerGroup, error) {
 	input := &gamelift.DescribeGameServerGroupInput{
 		GameServerGroupName: aws.String(name),
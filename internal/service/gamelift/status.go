@@ -28,6 +28,22 @@ func statusBuild(ctx context.Context, conn *gamelift.GameLift, id string) retry.
 	}
 }
 
+func statusContainerGroupDefinition(ctx context.Context, conn *gamelift.GameLift, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerGroupDefinitionByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func statusFleet(ctx context.Context, conn *gamelift.GameLift, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindFleetByID(ctx, conn, id)
@@ -44,6 +60,22 @@ func statusFleet(ctx context.Context, conn *gamelift.GameLift, id string) retry.
 	}
 }
 
+func statusFleetLocation(ctx context.Context, conn *gamelift.GameLift, fleetID, location string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFleetLocationAttributesByTwoPartKey(ctx, conn, fleetID, location)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LocationState.Status), nil
+	}
+}
+
 func statusGameServerGroup(ctx context.Context, conn *gamelift.GameLift, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindGameServerGroupByName(ctx, conn, name)
@@ -5,6 +5,7 @@ package gamelift
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -36,6 +38,25 @@ func waitBuildReady(ctx context.Context, conn *gamelift.GameLift, id string) (*g
 	return nil, err
 }
 
+func waitContainerGroupDefinitionReady(ctx context.Context, conn *gamelift.GameLift, name string, timeout time.Duration) (*gamelift.ContainerGroupDefinition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{gamelift.ContainerGroupDefinitionStatusCopying},
+		Target:  []string{gamelift.ContainerGroupDefinitionStatusReady},
+		Refresh: statusContainerGroupDefinition(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*gamelift.ContainerGroupDefinition); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFleetActive(ctx context.Context, conn *gamelift.GameLift, id string, timeout time.Duration) (*gamelift.FleetAttributes, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -91,6 +112,50 @@ func waitFleetTerminated(ctx context.Context, conn *gamelift.GameLift, id string
 	return nil, err
 }
 
+func waitFleetLocationActive(ctx context.Context, conn *gamelift.GameLift, fleetID, location string, timeout time.Duration) (*gamelift.LocationAttributes, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			gamelift.FleetStatusActivating,
+			gamelift.FleetStatusBuilding,
+			gamelift.FleetStatusDownloading,
+			gamelift.FleetStatusNew,
+			gamelift.FleetStatusValidating,
+		},
+		Target:  []string{gamelift.FleetStatusActive},
+		Refresh: statusFleetLocation(ctx, conn, fleetID, location),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*gamelift.LocationAttributes); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetLocationDeleted(ctx context.Context, conn *gamelift.GameLift, fleetID, location string, timeout time.Duration) (*gamelift.LocationAttributes, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			gamelift.FleetStatusActive,
+			gamelift.FleetStatusDeleting,
+			gamelift.FleetStatusError,
+		},
+		Target:  []string{},
+		Refresh: statusFleetLocation(ctx, conn, fleetID, location),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*gamelift.LocationAttributes); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func getFleetFailures(ctx context.Context, conn *gamelift.GameLift, id string) ([]*gamelift.Event, error) {
 	var events []*gamelift.Event
 	err := _getFleetFailures(ctx, conn, id, nil, &events)
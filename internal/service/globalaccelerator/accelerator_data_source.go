@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -106,7 +107,7 @@ func (d *acceleratorDataSource) Read(ctx context.Context, request datasource.Rea
 		page, err := pages.NextPage(ctx)
 
 		if err != nil {
-			response.Diagnostics.AddError("listing Global Accelerator Accelerators", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing Global Accelerator Accelerators", err))
 
 			return
 		}
@@ -149,7 +150,7 @@ func (d *acceleratorDataSource) Read(ctx context.Context, request datasource.Rea
 	attributes, err := findAcceleratorAttributesByARN(ctx, conn, acceleratorARN)
 
 	if err != nil {
-		response.Diagnostics.AddError("reading Global Accelerator Accelerator attributes", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading Global Accelerator Accelerator attributes", err))
 
 		return
 	}
@@ -159,7 +160,7 @@ func (d *acceleratorDataSource) Read(ctx context.Context, request datasource.Rea
 	tags, err := listTags(ctx, conn, acceleratorARN)
 
 	if err != nil {
-		response.Diagnostics.AddError("listing tags for Global Accelerator Accelerator", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing tags for Global Accelerator Accelerator", err))
 
 		return
 	}
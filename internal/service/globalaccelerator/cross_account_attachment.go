@@ -113,7 +113,7 @@ func (r *crossAccountAttachmentResource) Create(ctx context.Context, request res
 	output, err := conn.CreateCrossAccountAttachment(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Global Accelerator Cross-account Attachment", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Global Accelerator Cross-account Attachment", err))
 
 		return
 	}
@@ -135,7 +135,7 @@ func (r *crossAccountAttachmentResource) Read(ctx context.Context, request resou
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -152,7 +152,7 @@ func (r *crossAccountAttachmentResource) Read(ctx context.Context, request resou
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Global Accelerator Cross-account Attachment (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Global Accelerator Cross-account Attachment (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -233,7 +233,7 @@ func (r *crossAccountAttachmentResource) Update(ctx context.Context, request res
 		output, err := conn.UpdateCrossAccountAttachment(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Global Accelerator Cross-account Attachment (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Global Accelerator Cross-account Attachment (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -264,7 +264,7 @@ func (r *crossAccountAttachmentResource) Delete(ctx context.Context, request res
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Global Accelerator Cross-account Attachment (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Global Accelerator Cross-account Attachment (%s)", data.ID.ValueString()), err))
 
 		return
 	}
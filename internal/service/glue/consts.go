@@ -14,6 +14,11 @@ const (
 	devEndpointStatusTerminating  = "TERMINATING"
 )
 
+const (
+	jobCommandNameGlueETL       = "glueetl"
+	jobCommandNameGlueStreaming = "gluestreaming"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
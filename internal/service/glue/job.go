@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,7 +39,11 @@ func ResourceJob() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			jobExecutionClassCustomizeDiff,
+			jobMaintenanceWindowCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -119,6 +126,11 @@ func ResourceJob() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"number_of_workers", "worker_type"},
 			},
+			"maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(Sun|Mon|Tue|Wed|Thu|Fri|Sat):([01]?[0-9]|2[0-3])$`), "must be a day of the week and an hour in the format Day:HH, e.g. Sun:10"),
+			},
 			"max_retries": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -239,6 +251,10 @@ func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.NotificationProperty = expandNotificationProperty(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("number_of_workers"); ok {
 		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
 	}
@@ -303,6 +319,7 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting execution_property: %s", err)
 	}
 	d.Set("glue_version", job.GlueVersion)
+	d.Set("maintenance_window", job.MaintenanceWindow)
 	d.Set(names.AttrMaxCapacity, job.MaxCapacity)
 	d.Set("max_retries", job.MaxRetries)
 	d.Set(names.AttrName, job.Name)
@@ -359,6 +376,10 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			jobUpdate.MaxRetries = aws.Int64(int64(v.(int)))
 		}
 
+		if v, ok := d.GetOk("maintenance_window"); ok {
+			jobUpdate.MaintenanceWindow = aws.String(v.(string))
+		}
+
 		if kv, ok := d.GetOk("non_overridable_arguments"); ok {
 			jobUpdate.NonOverridableArguments = flex.ExpandStringMap(kv.(map[string]interface{}))
 		}
@@ -432,6 +453,42 @@ func expandExecutionProperty(l []interface{}) *glue.ExecutionProperty {
 	return executionProperty
 }
 
+// jobExecutionClassCustomizeDiff rejects FLEX execution for job types and worker types that don't support it.
+func jobExecutionClassCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v := diff.Get("execution_class").(string); !strings.EqualFold(v, glue.ExecutionClassFlex) {
+		return nil
+	}
+
+	if v := diff.Get("command.0.name").(string); v != "" && v != jobCommandNameGlueETL {
+		return fmt.Errorf("execution_class %q is only supported for %q jobs, got %q", glue.ExecutionClassFlex, jobCommandNameGlueETL, v)
+	}
+
+	if !diff.NewValueKnown("worker_type") {
+		return nil
+	}
+
+	switch v := diff.Get("worker_type").(string); v {
+	case "", glue.WorkerTypeG1x, glue.WorkerTypeG2x:
+	default:
+		return fmt.Errorf("execution_class %q is only supported with worker_type %q or %q, got %q", glue.ExecutionClassFlex, glue.WorkerTypeG1x, glue.WorkerTypeG2x, v)
+	}
+
+	return nil
+}
+
+// jobMaintenanceWindowCustomizeDiff rejects maintenance windows on non-streaming jobs.
+func jobMaintenanceWindowCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v := diff.Get("maintenance_window").(string); v == "" {
+		return nil
+	}
+
+	if v := diff.Get("command.0.name").(string); v != jobCommandNameGlueStreaming {
+		return fmt.Errorf("maintenance_window is only supported for %q jobs, got %q", jobCommandNameGlueStreaming, v)
+	}
+
+	return nil
+}
+
 func expandJobCommand(l []interface{}) *glue.JobCommand {
 	m := l[0].(map[string]interface{})
 
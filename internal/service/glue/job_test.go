@@ -347,6 +347,59 @@ func TestAccGlueJob_executionClass(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_executionClassFlexInvalidWorkerType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_executionClassWorkerType(rName, "FLEX", "G.4X"),
+				ExpectError: regexache.MustCompile(`execution_class "FLEX" is only supported with worker_type`),
+			},
+		},
+	})
+}
+
+func TestAccGlueJob_maintenanceWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job glue.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_maintenanceWindow(rName, "Sun:10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "Sun:10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_maintenanceWindow(rName, "Wed:22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "Wed:22"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_executionProperty(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job glue.Job
@@ -1022,6 +1075,45 @@ resource "aws_glue_job" "test" {
 `, rName, executionClass))
 }
 
+func testAccJobConfig_executionClassWorkerType(rName, executionClass, workerType string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  execution_class   = %[2]q
+  name              = %[1]q
+  number_of_workers = 2
+  role_arn          = aws_iam_role.test.arn
+  worker_type       = %[3]q
+  glue_version      = "4.0"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, executionClass, workerType))
+}
+
+func testAccJobConfig_maintenanceWindow(rName, maintenanceWindow string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  maintenance_window = %[2]q
+  name               = %[1]q
+  number_of_workers  = 2
+  role_arn           = aws_iam_role.test.arn
+  worker_type        = "G.1X"
+  glue_version       = "4.0"
+
+  command {
+    name            = "gluestreaming"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, maintenanceWindow))
+}
+
 func testAccJobConfig_executionProperty(rName string, maxConcurrentRuns int) string {
 	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
 resource "aws_glue_job" "test" {
@@ -32,14 +32,13 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrName: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
 						},
 						names.AttrStatus: {
@@ -84,15 +83,26 @@ func resourceDetectorFeaturePut(ctx context.Context, d *schema.ResourceData, met
 		feature.AdditionalConfiguration = expandDetectorAdditionalConfigurations(v.([]interface{}))
 	}
 
-	input := &guardduty.UpdateDetectorInput{
-		DetectorId: aws.String(detectorID),
-		Features:   []*guardduty.DetectorFeatureConfiguration{feature},
-	}
+	// Skip the update if the feature is already configured as desired, e.g. when it is managed
+	// for an organization member account by the delegated administrator.
+	current, err := FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+	switch {
+	case err == nil && detectorFeatureConfigurationEqual(feature, current):
+		log.Printf("[DEBUG] GuardDuty Detector (%s) Feature (%s) already configured", detectorID, name)
+	case err == nil || tfresource.NotFound(err):
+		input := &guardduty.UpdateDetectorInput{
+			DetectorId: aws.String(detectorID),
+			Features:   []*guardduty.DetectorFeatureConfiguration{feature},
+		}
 
-	_, err := conn.UpdateDetectorWithContext(ctx, input)
+		_, err := conn.UpdateDetectorWithContext(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Detector (%s) Feature (%s): %s", detectorID, name, err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GuardDuty Detector (%s) Feature (%s): %s", detectorID, name, err)
+		}
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector (%s) Feature (%s): %s", detectorID, name, err)
 	}
 
 	if d.IsNewResource() {
@@ -123,7 +133,12 @@ func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+	additionalConfiguration := feature.AdditionalConfiguration
+	// Only report configured additional configurations, in configured order.
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		additionalConfiguration = filterDetectorAdditionalConfigurationResults(expandDetectorAdditionalConfigurations(v.([]interface{})), additionalConfiguration)
+	}
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(additionalConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
 	}
 	d.Set("detector_id", detectorID)
@@ -164,6 +179,45 @@ func FindDetectorFeatureByTwoPartKey(ctx context.Context, conn *guardduty.GuardD
 	}))
 }
 
+// detectorFeatureConfigurationEqual returns whether the current feature configuration already
+// has the desired status and additional configuration statuses.
+func detectorFeatureConfigurationEqual(desired *guardduty.DetectorFeatureConfiguration, current *guardduty.DetectorFeatureConfigurationResult) bool {
+	if aws.StringValue(desired.Status) != aws.StringValue(current.Status) {
+		return false
+	}
+
+	filtered := filterDetectorAdditionalConfigurationResults(desired.AdditionalConfiguration, current.AdditionalConfiguration)
+
+	if len(filtered) != len(desired.AdditionalConfiguration) {
+		return false
+	}
+
+	for i, v := range desired.AdditionalConfiguration {
+		if aws.StringValue(v.Status) != aws.StringValue(filtered[i].Status) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterDetectorAdditionalConfigurationResults returns the results matching the configured additional configurations, in configured order.
+func filterDetectorAdditionalConfigurationResults(configured []*guardduty.DetectorAdditionalConfiguration, results []*guardduty.DetectorAdditionalConfigurationResult) []*guardduty.DetectorAdditionalConfigurationResult {
+	var apiObjects []*guardduty.DetectorAdditionalConfigurationResult
+
+	for _, v := range configured {
+		name := aws.StringValue(v.Name)
+
+		if result, err := tfresource.AssertSinglePtrResult(tfslices.Filter(results, func(v *guardduty.DetectorAdditionalConfigurationResult) bool {
+			return aws.StringValue(v.Name) == name
+		})); err == nil {
+			apiObjects = append(apiObjects, result)
+		}
+	}
+
+	return apiObjects
+}
+
 func expandDetectorAdditionalConfiguration(tfMap map[string]interface{}) *guardduty.DetectorAdditionalConfiguration {
 	if tfMap == nil {
 		return nil
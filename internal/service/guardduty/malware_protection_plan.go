@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -169,10 +170,10 @@ func (r *resourceMalwareProtectionPlan) Create(ctx context.Context, req resource
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionCreating, ResNameMalwareProtectionPlan, "malware protection", nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -182,10 +183,10 @@ func (r *resourceMalwareProtectionPlan) Create(ctx context.Context, req resource
 	// Read after create to get computed attributes omitted from the create response
 	readOut, err := FindMalwareProtectionPlanByID(ctx, conn, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameMalwareProtectionPlan, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	resp.Diagnostics.Append(flex.Flatten(ctx, readOut, &state)...)
@@ -215,10 +216,10 @@ func (r *resourceMalwareProtectionPlan) Read(ctx context.Context, req resource.R
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionSetting, ResNameMalwareProtectionPlan, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -261,10 +262,10 @@ func (r *resourceMalwareProtectionPlan) Update(ctx context.Context, req resource
 		_, err := conn.UpdateMalwareProtectionPlan(ctx, input)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.GuardDuty, create.ErrActionUpdating, ResNameMalwareProtectionPlan, state.ID.ValueString(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
@@ -272,10 +273,10 @@ func (r *resourceMalwareProtectionPlan) Update(ctx context.Context, req resource
 	out, err := FindMalwareProtectionPlanByID(ctx, conn, state.ID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionUpdating, ResNameMalwareProtectionPlan, state.ID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -305,10 +306,10 @@ func (r *resourceMalwareProtectionPlan) Delete(ctx context.Context, req resource
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionDeleting, ResNameMalwareProtectionPlan, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_health_events", name="Events")
+func dataSourceEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(health.EventStatusCode_Values(), false),
+				},
+			},
+			"event_type_categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(health.EventTypeCategory_Values(), false),
+				},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_scope_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusCode: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthConn(ctx)
+
+	filter := &health.EventFilter{}
+
+	if v, ok := d.GetOk("event_status_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventStatusCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_categories"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCategories = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Regions = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("services"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Services = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	input := &health.DescribeEventsInput{
+		Filter: filter,
+	}
+
+	events, err := findEvents(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Health Events: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("events", flattenEvents(events)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting events: %s", err)
+	}
+
+	return diags
+}
+
+func findEvents(ctx context.Context, conn *health.Health, input *health.DescribeEventsInput) ([]*health.Event, error) {
+	var output []*health.Event
+
+	err := conn.DescribeEventsPagesWithContext(ctx, input, func(page *health.DescribeEventsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Events {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenEvents(apiObjects []*health.Event) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:              aws.StringValue(apiObject.Arn),
+			names.AttrAvailabilityZone: aws.StringValue(apiObject.AvailabilityZone),
+			"event_scope_code":         aws.StringValue(apiObject.EventScopeCode),
+			"event_type_category":      aws.StringValue(apiObject.EventTypeCategory),
+			"event_type_code":          aws.StringValue(apiObject.EventTypeCode),
+			names.AttrRegion:           aws.StringValue(apiObject.Region),
+			"service":                  aws.StringValue(apiObject.Service),
+			names.AttrStatusCode:       aws.StringValue(apiObject.StatusCode),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastUpdatedTime; v != nil {
+			tfMap["last_updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap[names.AttrStartTime] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthEventsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthEventsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_filter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "event_status_codes.*", "upcoming"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "event_type_categories.*", "scheduledChange"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEventsDataSourceConfig_basic = `
+data "aws_health_events" "test" {}
+`
+
+const testAccEventsDataSourceConfig_filter = `
+data "aws_region" "current" {}
+
+data "aws_health_events" "test" {
+  event_status_codes    = ["upcoming"]
+  event_type_categories = ["scheduledChange"]
+  regions               = [data.aws_region.current.name]
+  services              = ["EC2"]
+}
+`
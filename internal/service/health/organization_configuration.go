@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	organizationConfigurationStatusDisabled = "DISABLED"
+	organizationConfigurationStatusEnabled  = "ENABLED"
+	organizationConfigurationStatusPending  = "PENDING"
+)
+
+// @SDKResource("aws_health_organization_configuration", name="Organization Configuration")
+func resourceOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationCreate,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationRead,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthConn(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	_, err := conn.EnableHealthServiceAccessForOrganizationWithContext(ctx, &health.EnableHealthServiceAccessForOrganizationInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling Health organizational view (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Health organizational view (%s) enable: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthConn(ctx)
+
+	status, err := findOrganizationConfigurationStatus(ctx, conn)
+
+	if err == nil && status != organizationConfigurationStatusEnabled {
+		err = &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Health organizational view (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Health organizational view (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrStatus, status)
+
+	return diags
+}
+
+func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthConn(ctx)
+
+	log.Printf("[DEBUG] Disabling Health organizational view: %s", d.Id())
+	_, err := conn.DisableHealthServiceAccessForOrganizationWithContext(ctx, &health.DisableHealthServiceAccessForOrganizationInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Health organizational view (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationConfigurationDisabled(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Health organizational view (%s) disable: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findOrganizationConfigurationStatus(ctx context.Context, conn *health.Health) (string, error) {
+	input := &health.DescribeHealthServiceStatusForOrganizationInput{}
+
+	output, err := conn.DescribeHealthServiceStatusForOrganizationWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.HealthServiceAccessStatusForOrganization == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.HealthServiceAccessStatusForOrganization), nil
+}
+
+func statusOrganizationConfiguration(ctx context.Context, conn *health.Health) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOrganizationConfigurationStatus(ctx, conn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, output, nil
+	}
+}
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *health.Health, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationConfigurationStatusDisabled, organizationConfigurationStatusPending},
+		Target:  []string{organizationConfigurationStatusEnabled},
+		Refresh: statusOrganizationConfiguration(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
+
+func waitOrganizationConfigurationDisabled(ctx context.Context, conn *health.Health, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationConfigurationStatusEnabled, organizationConfigurationStatusPending},
+		Target:  []string{organizationConfigurationStatusDisabled},
+		Refresh: statusOrganizationConfiguration(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
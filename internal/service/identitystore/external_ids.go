@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// appendExternallyProvisionedWarning adds a warning if the identity has external IDs, meaning that it is provisioned
+// from an external identity provider (usually via SCIM). Changes made by Terraform are then reverted by the next synchronization.
+func appendExternallyProvisionedWarning(diags diag.Diagnostics, resourceName, id string, externalIDs []interface{}) diag.Diagnostics {
+	if len(externalIDs) == 0 {
+		return diags
+	}
+
+	var issuers []string
+	for _, v := range externalIDs {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			if v, ok := tfMap[names.AttrIssuer].(string); ok && v != "" {
+				issuers = append(issuers, v)
+			}
+		}
+	}
+
+	return sdkdiag.AppendWarningf(diags, "IdentityStore %s (%s) is provisioned by an external identity provider (issuers: %s); "+
+		"changes made by Terraform may be overwritten by the next SCIM synchronization. Manage it in the identity provider instead.",
+		resourceName, id, strings.Join(issuers, ", "))
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMemberships = "GroupMemberships"
+)
+
+// @SDKResource("aws_identitystore_group_memberships")
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipsRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsUpdate,
+		DeleteWithoutTimeout: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id := groupMembershipsCreateResourceID(identityStoreID, groupID)
+
+	diags = appendGroupExternallyProvisionedWarning(ctx, conn, diags, identityStoreID, groupID)
+
+	// Adopt any existing memberships rather than failing on them.
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, id, err)
+	}
+
+	for _, memberID := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		if _, ok := memberships[memberID]; ok {
+			continue
+		}
+
+		if err := createGroupMembership(ctx, conn, identityStoreID, groupID, memberID); err != nil {
+			return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, id, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceGroupMembershipsRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID, err := groupMembershipsParseResourceID(d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	// All of the group's memberships are listed in pages of up to 100, rather than described one at a time.
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMemberships (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberIDs := make([]string, 0, len(memberships))
+	for memberID := range memberships {
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("member_ids", memberIDs)
+
+	return diags
+}
+
+func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	diags = appendGroupExternallyProvisionedWarning(ctx, conn, diags, identityStoreID, groupID)
+
+	if d.HasChange("member_ids") {
+		o, n := d.GetChange("member_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if len(del) > 0 {
+			memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+			if err != nil {
+				return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+			}
+
+			for _, memberID := range del {
+				membershipID, ok := memberships[memberID]
+				if !ok {
+					continue
+				}
+
+				if err := deleteGroupMembership(ctx, conn, identityStoreID, membershipID); err != nil {
+					return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+				}
+			}
+		}
+
+		for _, memberID := range add {
+			if err := createGroupMembership(ctx, conn, identityStoreID, groupID, memberID); err != nil {
+				return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceGroupMembershipsRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMemberships %s", d.Id())
+
+	for _, memberID := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		membershipID, ok := memberships[memberID]
+		if !ok {
+			continue
+		}
+
+		if err := deleteGroupMembership(ctx, conn, identityStoreID, membershipID); err != nil {
+			return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+const groupMembershipsResourceIDSeparator = "/"
+
+func groupMembershipsCreateResourceID(identityStoreID, groupID string) string {
+	return strings.Join([]string{identityStoreID, groupID}, groupMembershipsResourceIDSeparator)
+}
+
+func groupMembershipsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipsResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("expected a resource id in the form: identity-store-id/group-id")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func createGroupMembership(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID, memberID string) error {
+	input := &identitystore.CreateGroupMembershipInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		MemberId:        &types.MemberIdMemberUserId{Value: memberID},
+	}
+
+	_, err := conn.CreateGroupMembership(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("adding member (%s): %w", memberID, err)
+	}
+
+	return nil
+}
+
+func deleteGroupMembership(ctx context.Context, conn *identitystore.Client, identityStoreID, membershipID string) error {
+	input := &identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	}
+
+	_, err := conn.DeleteGroupMembership(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing membership (%s): %w", membershipID, err)
+	}
+
+	return nil
+}
+
+// findGroupMembershipsByGroupID returns a map of member (user) ID to membership ID for the specified group.
+func findGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (map[string]string, error) {
+	// Ensure that a missing group is reported as not found rather than as an empty membership set.
+	if _, err := FindGroupByTwoPartKey(ctx, conn, identityStoreID, groupID); err != nil {
+		return nil, err
+	}
+
+	input := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+	output := make(map[string]string)
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			memberID, err := getMemberIdMemberUserId(v.MemberId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			output[aws.ToString(memberID)] = aws.ToString(v.MembershipId)
+		}
+	}
+
+	return output, nil
+}
+
+func appendGroupExternallyProvisionedWarning(ctx context.Context, conn *identitystore.Client, diags diag.Diagnostics, identityStoreID, groupID string) diag.Diagnostics {
+	group, err := FindGroupByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		// Any error is reported by the subsequent operations.
+		return diags
+	}
+
+	return appendExternallyProvisionedWarning(diags, ResNameGroup, groupMembershipsCreateResourceID(identityStoreID, groupID), flattenExternalIds(group.ExternalIds))
+}
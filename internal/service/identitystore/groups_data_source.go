@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -63,7 +64,7 @@ func (d *groupsDataSource) Read(ctx context.Context, request datasource.ReadRequ
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if err != nil {
-			response.Diagnostics.AddError("listing IdentityStore Groups", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing IdentityStore Groups", err))
 
 			return
 		}
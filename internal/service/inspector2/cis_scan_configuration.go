@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func newCISScanConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &cisScanConfigurationResource{}, nil
+}
+
+type cisScanConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *cisScanConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_cis_scan_configuration"
+}
+
+func (r *cisScanConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	startTimeBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[timeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"time_of_day": schema.StringAttribute{
+					Required: true,
+				},
+				"timezone": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"scan_name": schema.StringAttribute{
+				Required: true,
+			},
+			"security_level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CisSecurityLevel](),
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"daily": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dailyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("monthly"),
+									path.MatchRelative().AtParent().AtName("one_time"),
+									path.MatchRelative().AtParent().AtName("weekly"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"monthly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[monthlyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"day": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"one_time": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oneTimeScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"weekly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[weeklyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.Day]](ctx),
+										ElementType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"targets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisTargetsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"target_resource_tags": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.StringType},
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cisScanConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	schedule, diags := expandSchedule(ctx, data.Schedule)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accountIDs, targetResourceTags, diags := expandCISTargets(ctx, data.Targets)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &inspector2.CreateCisScanConfigurationInput{
+		ScanName:      fwflex.StringFromFramework(ctx, data.ScanName),
+		Schedule:      schedule,
+		SecurityLevel: data.SecurityLevel.ValueEnum(),
+		Tags:          getTagsIn(ctx),
+		Targets: &awstypes.CreateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		},
+	}
+
+	output, err := conn.CreateCisScanConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Inspector2 CIS Scan Configuration (%s)", data.ScanName.ValueString()), err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.ScanConfigurationArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cisScanConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
+
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCISScanConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Inspector2 CIS Scan Configuration (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	data.ScanName = fwflex.StringToFramework(ctx, output.ScanName)
+	data.SecurityLevel = fwtypes.StringEnumValue(output.SecurityLevel)
+
+	schedule, diags := flattenSchedule(ctx, output.Schedule)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Schedule = schedule
+
+	targets, diags := flattenCISTargets(ctx, output.Targets)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Targets = targets
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cisScanConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.ScanName.Equal(old.ScanName) ||
+		!new.Schedule.Equal(old.Schedule) ||
+		!new.SecurityLevel.Equal(old.SecurityLevel) ||
+		!new.Targets.Equal(old.Targets) {
+		schedule, diags := expandSchedule(ctx, new.Schedule)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		accountIDs, targetResourceTags, diags := expandCISTargets(ctx, new.Targets)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &inspector2.UpdateCisScanConfigurationInput{
+			ScanConfigurationArn: fwflex.StringFromFramework(ctx, new.ID),
+			ScanName:             fwflex.StringFromFramework(ctx, new.ScanName),
+			Schedule:             schedule,
+			SecurityLevel:        new.SecurityLevel.ValueEnum(),
+			Targets: &awstypes.UpdateCisTargets{
+				AccountIds:         accountIDs,
+				TargetResourceTags: targetResourceTags,
+			},
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Inspector2 CIS Scan Configuration (%s)", new.ID.ValueString()), err))
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *cisScanConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteCisScanConfiguration(ctx, &inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Inspector2 CIS Scan Configuration (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+}
+
+func (r *cisScanConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.CisScanConfiguration, error) {
+	input := &inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &awstypes.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []awstypes.CisStringFilter{{
+				Comparison: awstypes.CisStringComparisonEquals,
+				Value:      aws.String(arn),
+			}},
+		},
+	}
+
+	output, err := findCISScanConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCISScanConfigurations(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) ([]awstypes.CisScanConfiguration, error) {
+	var output []awstypes.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	return output, nil
+}
+
+func expandSchedule(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[scheduleModel]) (awstypes.Schedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.Daily.IsNull():
+		dailyData, d := data.Daily.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.ScheduleMemberDaily{}
+		diags.Append(fwflex.Expand(ctx, dailyData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !data.Monthly.IsNull():
+		monthlyData, d := data.Monthly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.ScheduleMemberMonthly{}
+		diags.Append(fwflex.Expand(ctx, monthlyData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !data.OneTime.IsNull():
+		return &awstypes.ScheduleMemberOneTime{}, diags
+
+	case !data.Weekly.IsNull():
+		weeklyData, d := data.Weekly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.ScheduleMemberWeekly{}
+		diags.Append(fwflex.Expand(ctx, weeklyData, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func flattenSchedule(ctx context.Context, apiObject awstypes.Schedule) (fwtypes.ListNestedObjectValueOf[scheduleModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &scheduleModel{
+		Daily:   fwtypes.NewListNestedObjectValueOfNull[dailyScheduleModel](ctx),
+		Monthly: fwtypes.NewListNestedObjectValueOfNull[monthlyScheduleModel](ctx),
+		OneTime: fwtypes.NewListNestedObjectValueOfNull[oneTimeScheduleModel](ctx),
+		Weekly:  fwtypes.NewListNestedObjectValueOfNull[weeklyScheduleModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ScheduleMemberDaily:
+		var dailyData dailyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &dailyData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[scheduleModel](ctx), diags
+		}
+
+		data.Daily = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dailyData)
+
+	case *awstypes.ScheduleMemberMonthly:
+		var monthlyData monthlyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &monthlyData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[scheduleModel](ctx), diags
+		}
+
+		data.Monthly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &monthlyData)
+
+	case *awstypes.ScheduleMemberOneTime:
+		data.OneTime = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &oneTimeScheduleModel{})
+
+	case *awstypes.ScheduleMemberWeekly:
+		var weeklyData weeklyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &weeklyData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[scheduleModel](ctx), diags
+		}
+
+		data.Weekly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &weeklyData)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[scheduleModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
+
+func expandCISTargets(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[cisTargetsModel]) ([]string, map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, nil, diags
+	}
+
+	targetResourceTags := make(map[string][]string)
+	diags.Append(data.TargetResourceTags.ElementsAs(ctx, &targetResourceTags, false)...)
+
+	return fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs), targetResourceTags, diags
+}
+
+func flattenCISTargets(ctx context.Context, apiObject *awstypes.CisTargets) (fwtypes.ListNestedObjectValueOf[cisTargetsModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx), diags
+	}
+
+	targetResourceTags, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, apiObject.TargetResourceTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cisTargetsModel{
+		AccountIDs:         fwflex.FlattenFrameworkStringValueSet(ctx, apiObject.AccountIds),
+		TargetResourceTags: targetResourceTags,
+	}), diags
+}
+
+type cisScanConfigurationResourceModel struct {
+	ARN           types.String                                     `tfsdk:"arn"`
+	ID            types.String                                     `tfsdk:"id"`
+	ScanName      types.String                                     `tfsdk:"scan_name"`
+	Schedule      fwtypes.ListNestedObjectValueOf[scheduleModel]   `tfsdk:"schedule"`
+	SecurityLevel fwtypes.StringEnum[awstypes.CisSecurityLevel]    `tfsdk:"security_level"`
+	Tags          types.Map                                        `tfsdk:"tags"`
+	TagsAll       types.Map                                        `tfsdk:"tags_all"`
+	Targets       fwtypes.ListNestedObjectValueOf[cisTargetsModel] `tfsdk:"targets"`
+}
+
+func (data *cisScanConfigurationResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *cisScanConfigurationResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type scheduleModel struct {
+	Daily   fwtypes.ListNestedObjectValueOf[dailyScheduleModel]   `tfsdk:"daily"`
+	Monthly fwtypes.ListNestedObjectValueOf[monthlyScheduleModel] `tfsdk:"monthly"`
+	OneTime fwtypes.ListNestedObjectValueOf[oneTimeScheduleModel] `tfsdk:"one_time"`
+	Weekly  fwtypes.ListNestedObjectValueOf[weeklyScheduleModel]  `tfsdk:"weekly"`
+}
+
+type dailyScheduleModel struct {
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel] `tfsdk:"start_time"`
+}
+
+type monthlyScheduleModel struct {
+	Day       fwtypes.StringEnum[awstypes.Day]           `tfsdk:"day"`
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel] `tfsdk:"start_time"`
+}
+
+type oneTimeScheduleModel struct{}
+
+type weeklyScheduleModel struct {
+	Days      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Day]] `tfsdk:"days"`
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel]           `tfsdk:"start_time"`
+}
+
+type timeModel struct {
+	TimeOfDay types.String `tfsdk:"time_of_day"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+type cisTargetsModel struct {
+	AccountIDs         types.Set `tfsdk:"account_ids"`
+	TargetResourceTags types.Map `tfsdk:"target_resource_tags"`
+}
@@ -5,6 +5,11 @@ package inspector2
 
 // Exports for use in tests only.
 var (
-	EnablerID      = enablerID
-	ParseEnablerID = parseEnablerID
+	ResourceCISScanConfiguration = newCISScanConfigurationResource
+	ResourceFilter               = newFilterResource
+
+	EnablerID                     = enablerID
+	FindCISScanConfigurationByARN = findCISScanConfigurationByARN
+	FindFilterByARN               = findFilterByARN
+	ParseEnablerID                = parseEnablerID
 )
@@ -54,6 +54,10 @@ func resourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("kafka_version", func(_ context.Context, old, new, meta interface{}) bool {
 				return semver.LessThan(new.(string), old.(string))
 			}),
+			customdiff.ForceNewIfChange("broker_node_group_info.0.instance_type", func(_ context.Context, old, new, meta interface{}) bool {
+				// UpdateBrokerType cannot move brokers between the Standard and Express broker families.
+				return isExpressBrokerInstanceType(old.(string)) != isExpressBrokerInstanceType(new.(string))
+			}),
 			verify.SetTagsDiff,
 		),
 
@@ -766,7 +770,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 		clusterOperationARN := aws.ToString(output.ClusterOperationArn)
 
-		if _, err := waitClusterOperationCompleted(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitBrokerTypeUpdated(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for MSK Cluster (%s) operation (%s) complete: %s", d.Id(), clusterOperationARN, err)
 		}
 
@@ -776,7 +780,41 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChanges("broker_node_group_info.0.storage_info") {
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    types.StorageMode(d.Get("storage_mode").(string)),
+		}
+
+		// Apply any EBS volume changes in the same operation as the storage mode transition.
+		if d.HasChange("broker_node_group_info.0.storage_info") {
+			if v, ok := d.GetOk("broker_node_group_info.0.storage_info.0.ebs_storage_info.0.volume_size"); ok {
+				input.VolumeSizeGB = aws.Int32(int32(v.(int)))
+			}
+
+			if v, ok := d.GetOk("broker_node_group_info.0.storage_info.0.ebs_storage_info.0.provisioned_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ProvisionedThroughput = expandProvisionedThroughput(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		output, err := conn.UpdateStorage(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MSK Cluster (%s) storage: %s", d.Id(), err)
+		}
+
+		clusterOperationARN := aws.ToString(output.ClusterOperationArn)
+
+		if _, err := waitClusterOperationCompleted(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MSK Cluster (%s) operation (%s) complete: %s", d.Id(), clusterOperationARN, err)
+		}
+
+		// refresh the current_version attribute after each update
+		if err := refreshClusterVersion(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	} else if d.HasChanges("broker_node_group_info.0.storage_info") {
 		input := &kafka.UpdateBrokerStorageInput{
 			ClusterArn:     aws.String(d.Id()),
 			CurrentVersion: aws.String(d.Get("current_version").(string)),
@@ -1203,6 +1241,56 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Client, arn
 	return nil, err
 }
 
+// waitBrokerTypeUpdated waits for an UpdateBrokerType operation to complete.
+// Brokers are replaced one at a time, so each step transition is logged to give progress on long-running updates.
+func waitBrokerTypeUpdated(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*types.ClusterOperationInfo, error) {
+	refresh := statusClusterOperationState(ctx, conn, arn)
+	steps := make(map[string]string)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{clusterOperationStatePending, clusterOperationStateUpdateInProgress},
+		Target:  []string{clusterOperationStateUpdateComplete},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, state, err := refresh()
+
+			if output, ok := outputRaw.(*types.ClusterOperationInfo); ok {
+				for _, v := range output.OperationSteps {
+					name := aws.ToString(v.StepName)
+					var status string
+					if v.StepInfo != nil {
+						status = aws.ToString(v.StepInfo.StepStatus)
+					}
+
+					if steps[name] != status {
+						steps[name] = status
+						log.Printf("[DEBUG] MSK Cluster operation (%s) step %s: %s", arn, name, status)
+					}
+				}
+			}
+
+			return outputRaw, state, err
+		},
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.ClusterOperationInfo); ok {
+		if state, errorInfo := aws.ToString(output.OperationState), output.ErrorInfo; state == clusterOperationStateUpdateFailed && errorInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(errorInfo.ErrorCode), aws.ToString(errorInfo.ErrorString)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// isExpressBrokerInstanceType returns whether the specified instance type is an MSK Express broker type (e.g. express.m7g.large).
+func isExpressBrokerInstanceType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, "express.")
+}
+
 func clusterUUIDFromARN(clusterARN string) (string, error) {
 	parsedARN, err := arn.Parse(clusterARN)
 	if err != nil {
@@ -110,7 +110,6 @@ func resourceReplicator() *schema.Resource {
 			"replication_info_list": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -159,6 +158,24 @@ func resourceReplicator() *schema.Resource {
 										Optional: true,
 										Default:  true,
 									},
+									"starting_position": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrType: {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.ReplicationStartingPositionType](),
+												},
+											},
+										},
+									},
 									"topics_to_exclude": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -277,17 +294,7 @@ func resourceReplicatorRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading MSK Replicator (%s): %s", d.Id(), err)
 	}
 
-	sourceAlias := aws.ToString(output.ReplicationInfoList[0].SourceKafkaClusterAlias)
-	targetAlias := aws.ToString(output.ReplicationInfoList[0].TargetKafkaClusterAlias)
-	var sourceARN, targetARN *string
-
-	for _, cluster := range output.KafkaClusters {
-		if clusterAlias := aws.ToString(cluster.KafkaClusterAlias); clusterAlias == sourceAlias {
-			sourceARN = cluster.AmazonMskCluster.MskClusterArn
-		} else if clusterAlias == targetAlias {
-			targetARN = cluster.AmazonMskCluster.MskClusterArn
-		}
-	}
+	sourceARN, targetARN := replicatorClusterARNs(output)
 
 	d.Set(names.AttrARN, output.ReplicatorArn)
 	d.Set("current_version", output.CurrentVersion)
@@ -466,6 +473,28 @@ func findReplicatorByARN(ctx context.Context, conn *kafka.Client, arn string) (*
 	return output, nil
 }
 
+// replicatorClusterARNs returns the ARNs of the replicator's source and target MSK clusters.
+func replicatorClusterARNs(output *kafka.DescribeReplicatorOutput) (*string, *string) {
+	var sourceARN, targetARN *string
+
+	if len(output.ReplicationInfoList) == 0 {
+		return sourceARN, targetARN
+	}
+
+	sourceAlias := aws.ToString(output.ReplicationInfoList[0].SourceKafkaClusterAlias)
+	targetAlias := aws.ToString(output.ReplicationInfoList[0].TargetKafkaClusterAlias)
+
+	for _, cluster := range output.KafkaClusters {
+		if clusterAlias := aws.ToString(cluster.KafkaClusterAlias); clusterAlias == sourceAlias {
+			sourceARN = cluster.AmazonMskCluster.MskClusterArn
+		} else if clusterAlias == targetAlias {
+			targetARN = cluster.AmazonMskCluster.MskClusterArn
+		}
+	}
+
+	return sourceARN, targetARN
+}
+
 func flattenReplicationInfoDescriptions(apiObjects []types.ReplicationInfoDescription, sourceCluster, targetCluster *string) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -567,6 +596,22 @@ func flattenTopicReplication(apiObject *types.TopicReplication) map[string]inter
 		tfMap["detect_and_copy_new_topics"] = apiObject.DetectAndCopyNewTopics
 	}
 
+	if v := apiObject.StartingPosition; v != nil {
+		tfMap["starting_position"] = []interface{}{flattenReplicationStartingPosition(v)}
+	}
+
+	return tfMap
+}
+
+func flattenReplicationStartingPosition(apiObject *types.ReplicationStartingPosition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
 	return tfMap
 }
 
@@ -769,6 +814,20 @@ func expandTopicReplication(tfMap map[string]interface{}) *types.TopicReplicatio
 		apiObject.DetectAndCopyNewTopics = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["starting_position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StartingPosition = expandReplicationStartingPosition(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandReplicationStartingPosition(tfMap map[string]interface{}) *types.ReplicationStartingPosition {
+	apiObject := &types.ReplicationStartingPosition{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.ReplicationStartingPositionType(v)
+	}
+
 	return apiObject
 }
 
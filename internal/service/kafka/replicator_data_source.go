@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_msk_replicator", name="Replicator")
+// @Tags
+func dataSourceReplicator() *schema.Resource {
+	rs := resourceReplicator().Schema
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReplicatorRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kafka_cluster":         sdkv2.DataSourcePropertyFromResourceProperty(rs["kafka_cluster"]),
+			"replication_info_list": sdkv2.DataSourcePropertyFromResourceProperty(rs["replication_info_list"]),
+			"replicator_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceReplicatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	output, err := findReplicatorByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MSK Replicator (%s): %s", arn, err)
+	}
+
+	sourceARN, targetARN := replicatorClusterARNs(output)
+
+	d.SetId(aws.ToString(output.ReplicatorArn))
+	d.Set(names.AttrARN, output.ReplicatorArn)
+	d.Set("current_version", output.CurrentVersion)
+	d.Set(names.AttrDescription, output.ReplicatorDescription)
+	if err := d.Set("kafka_cluster", flattenKafkaClusterDescriptions(output.KafkaClusters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting kafka_cluster: %s", err)
+	}
+	if err := d.Set("replication_info_list", flattenReplicationInfoDescriptions(output.ReplicationInfoList, sourceARN, targetARN)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_info_list: %s", err)
+	}
+	d.Set("replicator_name", output.ReplicatorName)
+	d.Set("service_execution_role_arn", output.ServiceExecutionRoleArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
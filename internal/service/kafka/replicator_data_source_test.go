@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKafkaReplicatorDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceCluster := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	targetCluster := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_replicator.test"
+	dataSourceName := "data.aws_msk_replicator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Kafka)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Kafka),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicatorDataSourceConfig_basic(rName, sourceCluster, targetCluster),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "current_version", resourceName, "current_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kafka_cluster.#", resourceName, "kafka_cluster.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_info_list.#", resourceName, "replication_info_list.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_info_list.0.source_kafka_cluster_arn", resourceName, "replication_info_list.0.source_kafka_cluster_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_info_list.0.target_kafka_cluster_arn", resourceName, "replication_info_list.0.target_kafka_cluster_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.#", resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replicator_name", resourceName, "replicator_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_execution_role_arn", resourceName, "service_execution_role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicatorDataSourceConfig_basic(rName, sourceCluster, targetCluster string) string {
+	return acctest.ConfigCompose(testAccReplicatorConfig_basic(rName, sourceCluster, targetCluster), `
+data "aws_msk_replicator" "test" {
+  arn = aws_msk_replicator.test.arn
+}
+`)
+}
@@ -47,6 +47,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_msk_kafka_version",
 			Name:     "Kafka Version",
 		},
+		{
+			Factory:  dataSourceReplicator,
+			TypeName: "aws_msk_replicator",
+			Name:     "Replicator",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  dataSourceVPCConnection,
 			TypeName: "aws_msk_vpc_connection",
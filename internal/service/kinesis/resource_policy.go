@@ -79,7 +79,7 @@ func (r *resourcePolicyResource) Create(ctx context.Context, request resource.Cr
 	_, err := conn.PutResourcePolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Resource Policy (%s)", data.ResourceARN.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Kinesis Resource Policy (%s)", data.ResourceARN.ValueString()), err))
 
 		return
 	}
@@ -100,7 +100,7 @@ func (r *resourcePolicyResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -117,7 +117,7 @@ func (r *resourcePolicyResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Resource Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Kinesis Resource Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -154,7 +154,7 @@ func (r *resourcePolicyResource) Update(ctx context.Context, request resource.Up
 	_, err := conn.PutResourcePolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Resource Policy (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Kinesis Resource Policy (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -181,7 +181,7 @@ func (r *resourcePolicyResource) Delete(ctx context.Context, request resource.De
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Resource Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Kinesis Resource Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -111,9 +111,17 @@ func resourceStreamConsumerDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
 
+	const (
+		timeout = 5 * time.Minute
+	)
 	log.Printf("[DEBUG] Deregistering Kinesis Stream Consumer: (%s)", d.Id())
-	_, err := conn.DeregisterStreamConsumer(ctx, &kinesis.DeregisterStreamConsumerInput{
-		ConsumerARN: aws.String(d.Id()),
+	// A consumer that is still being created, or that is already being deregistered
+	// because its stream is being deleted, returns ResourceInUseException.
+	// Keep retrying until the consumer is gone.
+	_, err := tfresource.RetryWhenIsA[*types.ResourceInUseException](ctx, timeout, func() (interface{}, error) {
+		return conn.DeregisterStreamConsumer(ctx, &kinesis.DeregisterStreamConsumerInput{
+			ConsumerARN: aws.String(d.Id()),
+		})
 	})
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
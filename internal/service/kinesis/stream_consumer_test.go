@@ -73,6 +73,30 @@ func TestAccKinesisStreamConsumer_disappears(t *testing.T) {
 	})
 }
 
+func TestAccKinesisStreamConsumer_streamDisappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamResourceName := "aws_kinesis_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamConsumerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumerConfig_enforceConsumerDeletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStreamConsumerExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkinesis.ResourceStream(), streamResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccKinesisStreamConsumer_maxConcurrentConsumers(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_kinesis_stream_consumer.test"
@@ -189,6 +213,21 @@ resource "aws_kinesis_stream_consumer" "test" {
 `, rName))
 }
 
+func testAccStreamConsumerConfig_enforceConsumerDeletion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name                      = %[1]q
+  shard_count               = 2
+  enforce_consumer_deletion = true
+}
+
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %[1]q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName)
+}
+
 func testAccStreamConsumerConfig_multiple(rName string, count int) string {
 	return acctest.ConfigCompose(testAccStreamConsumerConfig_base(rName), fmt.Sprintf(`
 resource "aws_kinesis_stream_consumer" "test" {
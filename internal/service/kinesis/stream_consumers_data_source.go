@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kinesis_stream_consumers", name="Stream Consumers")
+func dataSourceStreamConsumers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStreamConsumersRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ConsumerStatus](),
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceStreamConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
+
+	streamARN := d.Get(names.AttrStreamARN).(string)
+	input := &kinesis.ListStreamConsumersInput{
+		StreamARN: aws.String(streamARN),
+	}
+
+	output, err := findStreamConsumers(ctx, conn, input, func(c *types.Consumer) bool {
+		if v, ok := d.GetOk(names.AttrStatus); ok && v.(string) != string(c.ConsumerStatus) {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Stream (%s) Consumers: %s", streamARN, err)
+	}
+
+	var arns, consumerNames []string
+	consumers := make([]interface{}, 0, len(output))
+
+	for _, v := range output {
+		arns = append(arns, aws.ToString(v.ConsumerARN))
+		consumerNames = append(consumerNames, aws.ToString(v.ConsumerName))
+		consumers = append(consumers, map[string]interface{}{
+			names.AttrARN:        aws.ToString(v.ConsumerARN),
+			"creation_timestamp": aws.ToTime(v.ConsumerCreationTimestamp).Format(time.RFC3339),
+			names.AttrName:       aws.ToString(v.ConsumerName),
+			names.AttrStatus:     string(v.ConsumerStatus),
+		})
+	}
+
+	d.SetId(streamARN)
+	d.Set("arns", arns)
+	if err := d.Set("consumers", consumers); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting consumers: %s", err)
+	}
+	d.Set(names.AttrNames, consumerNames)
+
+	return diags
+}
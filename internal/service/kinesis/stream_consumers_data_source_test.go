@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisStreamConsumersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kinesis_stream_consumers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersDataSourceConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "consumers.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_kinesis_stream_consumer.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_kinesis_stream_consumer.test.1", names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "consumers.*", map[string]string{
+						names.AttrName:   rName + "-0",
+						names.AttrStatus: "ACTIVE",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStreamARN, "aws_kinesis_stream.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisStreamConsumersDataSource_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kinesis_stream_consumers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersDataSourceConfig_status(rName, "DELETING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "consumers.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccStreamConsumersDataSourceConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccStreamConsumerDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  count      = %[2]d
+  name       = "%[1]s-${count.index}"
+  stream_arn = aws_kinesis_stream.test.arn
+}
+
+data "aws_kinesis_stream_consumers" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+
+  depends_on = [aws_kinesis_stream_consumer.test]
+}
+`, rName, count))
+}
+
+func testAccStreamConsumersDataSourceConfig_status(rName, status string) string {
+	return acctest.ConfigCompose(testAccStreamConsumerDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %[1]q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+
+data "aws_kinesis_stream_consumers" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+  status     = %[2]q
+
+  depends_on = [aws_kinesis_stream_consumer.test]
+}
+`, rName, status))
+}
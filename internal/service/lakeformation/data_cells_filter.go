@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -187,10 +188,10 @@ func (r *resourceDataCellsFilter) Create(ctx context.Context, req resource.Creat
 
 	_, err := conn.CreateDataCellsFilter(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameDataCellsFilter, planTD.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -203,10 +204,10 @@ func (r *resourceDataCellsFilter) Create(ctx context.Context, req resource.Creat
 	id, err := intflex.FlattenResourceId(idParts, dataCellsFilterIDPartCount, false)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionFlatteningResourceId, ResNameDataCellsFilter, planTD.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -219,10 +220,10 @@ func (r *resourceDataCellsFilter) Create(ctx context.Context, req resource.Creat
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameDataCellsFilter, planTD.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -255,10 +256,10 @@ func (r *resourceDataCellsFilter) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionSetting, ResNameDataCellsFilter, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -293,22 +294,35 @@ func (r *resourceDataCellsFilter) Update(ctx context.Context, req resource.Updat
 			return
 		}
 
+		// Row filter and column changes are applied to the current filter version.
+		if in.TableData != nil && in.TableData.VersionId == nil {
+			td, d := state.TableData.ToPtr(ctx)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if td != nil {
+				in.TableData.VersionId = td.VersionID.ValueStringPointer()
+			}
+		}
+
 		_, err := conn.UpdateDataCellsFilter(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNameDataCellsFilter, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
 		output, err := findDataCellsFilterByID(ctx, conn, state.ID.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNameDataCellsFilter, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -343,10 +357,10 @@ func (r *resourceDataCellsFilter) Delete(ctx context.Context, req resource.Delet
 	idParts, err := intflex.ExpandResourceId(state.ID.ValueString(), dataCellsFilterIDPartCount, false)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionExpandingResourceId, ResNameDataCellsFilter, td.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -364,10 +378,10 @@ func (r *resourceDataCellsFilter) Delete(ctx context.Context, req resource.Delet
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionDeleting, ResNameDataCellsFilter, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
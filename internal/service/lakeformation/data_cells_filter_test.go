@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -130,6 +131,9 @@ func testAccDataCellsFilter_rowFilter(t *testing.T) {
 
 	filterExpression := `
   filter_expression = "my_column_23='testing'"
+`
+	updatedFilterExpression := `
+  filter_expression = "my_column_23='updated'"
 `
 	allRowsildcard := `
   all_rows_wildcard {}
@@ -156,8 +160,27 @@ func testAccDataCellsFilter_rowFilter(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "my_column_23='testing'"),
 				),
 			},
+			{
+				Config: testAccDataCellsFilterConfig_rowFilter(rName, updatedFilterExpression),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &datacellsfilter),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "table_data.0.version_id"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "my_column_23='updated'"),
+				),
+			},
 			{
 				Config: testAccDataCellsFilterConfig_rowFilter(rName, allRowsildcard),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataCellsFilterExists(ctx, resourceName, &datacellsfilter),
 					resource.TestCheckResourceAttr(resourceName, "table_data.0.database_name", rName),
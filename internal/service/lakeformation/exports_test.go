@@ -8,6 +8,8 @@ var (
 	ResourceDataCellsFilter = newResourceDataCellsFilter
 	ResourceResourceLFTag   = newResourceResourceLFTag
 
-	FindDataCellsFilterByID = findDataCellsFilterByID
-	FindResourceLFTagByID   = findResourceLFTagByID
+	FindDataCellsFilterByID             = findDataCellsFilterByID
+	FindIdentityCenterConfigurationByID = findIdentityCenterConfigurationByID
+	FindOptIn                           = findOptIn
+	FindResourceLFTagByID               = findResourceLFTagByID
 )
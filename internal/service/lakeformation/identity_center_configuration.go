@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lakeformation_identity_center_configuration", name="Identity Center Configuration")
+func ResourceIdentityCenterConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIdentityCenterConfigurationCreate,
+		ReadWithoutTimeout:   resourceIdentityCenterConfigurationRead,
+		UpdateWithoutTimeout: resourceIdentityCenterConfigurationUpdate,
+		DeleteWithoutTimeout: resourceIdentityCenterConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCatalogID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"external_filtering": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorized_targets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EnableStatus](),
+						},
+					},
+				},
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_share": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"share_recipients": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIdentityCenterConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	catalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrCatalogID); ok {
+		catalogID = v.(string)
+	}
+
+	input := &lakeformation.CreateLakeFormationIdentityCenterConfigurationInput{
+		CatalogId:   aws.String(catalogID),
+		InstanceArn: aws.String(d.Get("instance_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("external_filtering"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExternalFiltering = expandExternalFilteringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("share_recipients"); ok && v.(*schema.Set).Len() > 0 {
+		input.ShareRecipients = expandDataLakePrincipals(v.(*schema.Set))
+	}
+
+	_, err := conn.CreateLakeFormationIdentityCenterConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Identity Center Configuration (%s): %s", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return append(diags, resourceIdentityCenterConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceIdentityCenterConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	output, err := findIdentityCenterConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Identity Center Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Identity Center Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", output.ApplicationArn)
+	d.Set(names.AttrCatalogID, output.CatalogId)
+	if output.ExternalFiltering != nil {
+		if err := d.Set("external_filtering", []interface{}{flattenExternalFilteringConfiguration(output.ExternalFiltering)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting external_filtering: %s", err)
+		}
+	} else {
+		d.Set("external_filtering", nil)
+	}
+	d.Set("instance_arn", output.InstanceArn)
+	d.Set("resource_share", output.ResourceShare)
+	d.Set("share_recipients", flattenDataLakePrincipals(output.ShareRecipients))
+
+	return diags
+}
+
+func resourceIdentityCenterConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	input := &lakeformation.UpdateLakeFormationIdentityCenterConfigurationInput{
+		CatalogId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("external_filtering") {
+		if v, ok := d.GetOk("external_filtering"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ExternalFiltering = expandExternalFilteringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("share_recipients") {
+		// An empty list removes all share recipients.
+		input.ShareRecipients = expandDataLakePrincipals(d.Get("share_recipients").(*schema.Set))
+		if input.ShareRecipients == nil {
+			input.ShareRecipients = []awstypes.DataLakePrincipal{}
+		}
+	}
+
+	_, err := conn.UpdateLakeFormationIdentityCenterConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Identity Center Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIdentityCenterConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceIdentityCenterConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Lake Formation Identity Center Configuration: %s", d.Id())
+	_, err := conn.DeleteLakeFormationIdentityCenterConfiguration(ctx, &lakeformation.DeleteLakeFormationIdentityCenterConfigurationInput{
+		CatalogId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Identity Center Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIdentityCenterConfigurationByID(ctx context.Context, conn *lakeformation.Client, catalogID string) (*lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput, error) {
+	input := &lakeformation.DescribeLakeFormationIdentityCenterConfigurationInput{
+		CatalogId: aws.String(catalogID),
+	}
+
+	output, err := conn.DescribeLakeFormationIdentityCenterConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandExternalFilteringConfiguration(tfMap map[string]interface{}) *awstypes.ExternalFilteringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ExternalFilteringConfiguration{}
+
+	if v, ok := tfMap["authorized_targets"].(*schema.Set); ok {
+		apiObject.AuthorizedTargets = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = awstypes.EnableStatus(v)
+	}
+
+	return apiObject
+}
+
+func flattenExternalFilteringConfiguration(apiObject *awstypes.ExternalFilteringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"authorized_targets": apiObject.AuthorizedTargets,
+		names.AttrStatus:     string(apiObject.Status),
+	}
+}
+
+func expandDataLakePrincipals(tfSet *schema.Set) []awstypes.DataLakePrincipal {
+	var apiObjects []awstypes.DataLakePrincipal
+
+	for _, v := range flex.ExpandStringValueSet(tfSet) {
+		apiObjects = append(apiObjects, awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDataLakePrincipals(apiObjects []awstypes.DataLakePrincipal) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.ToString(apiObject.DataLakePrincipalIdentifier))
+	}
+
+	return tfList
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIdentityCenterConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lakeformation_identity_center_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityCenterConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterConfigurationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "application_arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrCatalogID),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+					resource.TestCheckResourceAttr(resourceName, "share_recipients.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityCenterConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lakeformation_identity_center_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityCenterConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterConfigurationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceIdentityCenterConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityCenterConfiguration_shareRecipients(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lakeformation_identity_center_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityCenterConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterConfigurationConfig_shareRecipients(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "share_recipients.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_share"),
+				),
+			},
+			{
+				Config: testAccIdentityCenterConfigurationConfig_basic(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "share_recipients.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityCenterConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_identity_center_configuration" {
+				continue
+			}
+
+			_, err := tflakeformation.FindIdentityCenterConfigurationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Identity Center Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityCenterConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		_, err := tflakeformation.FindIdentityCenterConfigurationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccIdentityCenterConfigurationConfig_basic() string {
+	return `
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_lakeformation_identity_center_configuration" "test" {
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+`
+}
+
+func testAccIdentityCenterConfigurationConfig_shareRecipients() string {
+	return `
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_identity_center_configuration" "test" {
+  instance_arn     = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  share_recipients = [data.aws_caller_identity.current.account_id]
+}
+`
+}
@@ -29,6 +29,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			acctest.CtBasic:  testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"OptIn": {
+			acctest.CtBasic:      testAccOptIn_basic,
+			acctest.CtDisappears: testAccOptIn_disappears,
+		},
 		"PermissionsBasic": {
 			acctest.CtBasic:       testAccPermissions_basic,
 			"database":            testAccPermissions_database,
@@ -69,6 +73,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"IdentityCenterConfiguration": {
+			acctest.CtBasic:      testAccIdentityCenterConfiguration_basic,
+			acctest.CtDisappears: testAccIdentityCenterConfiguration_disappears,
+			"shareRecipients":    testAccIdentityCenterConfiguration_shareRecipients,
+		},
 		"LFTags": {
 			acctest.CtBasic:      testAccLFTag_basic,
 			acctest.CtDisappears: testAccLFTag_disappears,
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -322,10 +323,10 @@ func (r *resourceResourceLFTag) Create(ctx context.Context, req resource.CreateR
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameResourceLFTag, prettify(in), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -358,10 +359,10 @@ func (r *resourceResourceLFTag) Create(ctx context.Context, req resource.CreateR
 	out := outputRaw.(*lakeformation.GetResourceLFTagsOutput)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionSetting, ResNameResourceLFTag, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -401,10 +402,10 @@ func (r *resourceResourceLFTag) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionSetting, ResNameResourceLFTag, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -499,10 +500,10 @@ func (r *resourceResourceLFTag) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionWaitingForDeletion, ResNameResourceLFTag, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -89,10 +90,10 @@ func (r *resourceRuntimeManagementConfig) Create(ctx context.Context, req resour
 
 	out, err := conn.PutRuntimeManagementConfig(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Lambda, create.ErrActionCreating, ResNameRuntimeManagementConfig, plan.FunctionName.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -122,10 +123,10 @@ func (r *resourceRuntimeManagementConfig) Read(ctx context.Context, req resource
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Lambda, create.ErrActionSetting, ResNameRuntimeManagementConfig, state.FunctionName.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -150,10 +151,10 @@ func (r *resourceRuntimeManagementConfig) Update(ctx context.Context, req resour
 
 		out, err := conn.PutRuntimeManagementConfig(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.Lambda, create.ErrActionUpdating, ResNameRuntimeManagementConfig, plan.FunctionName.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -192,10 +193,10 @@ func (r *resourceRuntimeManagementConfig) Delete(ctx context.Context, req resour
 		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.Lambda, create.ErrActionDeleting, ResNameRuntimeManagementConfig, state.FunctionName.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
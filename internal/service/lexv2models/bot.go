@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -186,10 +187,10 @@ func (r *resourceBot) Create(ctx context.Context, req resource.CreateRequest, re
 
 	out, err := conn.CreateBot(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBot, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.BotId == nil {
@@ -216,10 +217,10 @@ func (r *resourceBot) Create(ctx context.Context, req resource.CreateRequest, re
 	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
 	_, err = waitBotCreated(ctx, conn, state.ID.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBot, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -238,10 +239,10 @@ func (r *resourceBot) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBot, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -326,19 +327,19 @@ func (r *resourceBot) Update(ctx context.Context, req resource.UpdateRequest, re
 
 		_, err := conn.UpdateBot(ctx, &in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBot, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
 		out, err := waitBotUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameBot, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -374,20 +375,20 @@ func (r *resourceBot) Delete(ctx context.Context, req resource.DeleteRequest, re
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBot, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitBotDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBot, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -165,10 +166,10 @@ func (r *resourceBotLocale) Create(ctx context.Context, req resource.CreateReque
 
 	out, err := conn.CreateBotLocale(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocale, plan.LocaleID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.LocaleId == nil {
@@ -210,10 +211,10 @@ func (r *resourceBotLocale) Create(ctx context.Context, req resource.CreateReque
 	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
 	_, err = waitBotLocaleCreated(ctx, conn, state.Id.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotLocale, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -234,10 +235,10 @@ func (r *resourceBotLocale) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotLocale, state.LocaleID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -296,19 +297,19 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 
 		_, err := conn.UpdateBotLocale(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, plan.LocaleID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
 		out, err := waitBotLocaleUpdated(ctx, conn, plan.Id.ValueString(), updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameBotLocale, plan.LocaleID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -346,20 +347,20 @@ func (r *resourceBotLocale) Delete(ctx context.Context, req resource.DeleteReque
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotLocale, state.LocaleID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitBotLocaleDeleted(ctx, conn, state.Id.ValueString(), deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotLocale, state.LocaleID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -124,10 +125,10 @@ func (r *resourceBotVersion) Create(ctx context.Context, req resource.CreateRequ
 
 	out, err := conn.CreateBotVersion(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotVersion, plan.BotID.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.BotVersion == nil {
@@ -144,10 +145,10 @@ func (r *resourceBotVersion) Create(ctx context.Context, req resource.CreateRequ
 	}
 	id, err := fwflex.FlattenResourceId(idParts, botVersionIDPartCount, false)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotVersion, id, err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -159,10 +160,10 @@ func (r *resourceBotVersion) Create(ctx context.Context, req resource.CreateRequ
 	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
 	_, err = waitBotVersionCreated(ctx, conn, state.Id.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotVersion, state.Id.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -184,10 +185,10 @@ func (r *resourceBotVersion) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotVersion, state.Id.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -223,20 +224,20 @@ func (r *resourceBotVersion) Delete(ctx context.Context, req resource.DeleteRequ
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotVersion, state.Id.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitBotVersionDeleted(ctx, conn, state.Id.ValueString(), deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotVersion, state.Id.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -916,10 +917,10 @@ func (r *resourceIntent) Create(ctx context.Context, req resource.CreateRequest,
 
 	out, err := conn.CreateIntent(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameIntent, data.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -935,10 +936,10 @@ func (r *resourceIntent) Create(ctx context.Context, req resource.CreateRequest,
 
 	intent, err := waitIntentNormal(ctx, conn, data.IntentID.ValueString(), data.BotID.ValueString(), data.BotVersion.ValueString(), data.LocaleID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameIntent, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -966,7 +967,7 @@ func (r *resourceIntent) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if err := data.InitFromID(); err != nil {
-		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 		return
 	}
 
@@ -978,10 +979,10 @@ func (r *resourceIntent) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameIntent, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -1060,19 +1061,19 @@ func (r *resourceIntent) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, err := conn.UpdateIntent(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameIntent, new.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	_, err = waitIntentNormal(ctx, conn, new.IntentID.ValueString(), new.BotID.ValueString(), new.BotVersion.ValueString(), new.LocaleID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameIntent, new.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -1107,19 +1108,19 @@ func (r *resourceIntent) Delete(ctx context.Context, req resource.DeleteRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameIntent, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	_, err = waitIntentDeleted(ctx, conn, state.IntentID.ValueString(), state.BotID.ValueString(), state.BotVersion.ValueString(), state.LocaleID.ValueString(), r.DeleteTimeout(ctx, state.Timeouts))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameIntent, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -261,10 +262,10 @@ func (r *resourceSlotType) Create(ctx context.Context, req resource.CreateReques
 
 	out, err := conn.CreateSlotType(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameSlotType, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -283,10 +284,10 @@ func (r *resourceSlotType) Create(ctx context.Context, req resource.CreateReques
 	}
 	id, err := intflex.FlattenResourceId(idParts, slotTypeIDPartCount, false)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameSlotType, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -315,10 +316,10 @@ func (r *resourceSlotType) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameSlotType, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -349,10 +350,10 @@ func (r *resourceSlotType) Update(ctx context.Context, req resource.UpdateReques
 
 		out, err := conn.UpdateSlotType(ctx, input)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameSlotType, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -399,10 +400,10 @@ func (r *resourceSlotType) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameSlotType, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// BatchPutGeofence and BatchDeleteGeofence accept at most 10 entries per call.
+	geofenceBatchSize = 10
+
+	geoJSONTypePolygon = "Polygon"
+)
+
+// @SDKResource("aws_location_geofence_collection_entries", name="Geofence Collection Entries")
+func ResourceGeofenceCollectionEntries() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeofenceCollectionEntriesCreate,
+		ReadWithoutTimeout:   resourceGeofenceCollectionEntriesRead,
+		UpdateWithoutTimeout: resourceGeofenceCollectionEntriesUpdate,
+		DeleteWithoutTimeout: resourceGeofenceCollectionEntriesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"geofence": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      geofenceHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"geofence_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"geometry": {
+							Type:                  schema.TypeString,
+							Required:              true,
+							ValidateFunc:          validation.StringIsJSON,
+							DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+							DiffSuppressOnRefresh: true,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGeofenceCollectionEntriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	name := d.Get("collection_name").(string)
+	entries, err := expandBatchPutGeofenceRequestEntries(d.Get("geofence").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := batchPutGeofences(ctx, conn, name, entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Location Service Geofence Collection (%s) entries: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceGeofenceCollectionEntriesRead(ctx, d, meta)...)
+}
+
+func resourceGeofenceCollectionEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	geofences, err := findGeofencesByCollectionName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Location Service Geofence Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Location Service Geofence Collection (%s) entries: %s", d.Id(), err)
+	}
+
+	// Only track the geofences managed by this resource, unless importing.
+	if v := d.Get("geofence").(*schema.Set); v.Len() > 0 {
+		ids := make(map[string]struct{})
+		for _, tfMapRaw := range v.List() {
+			ids[tfMapRaw.(map[string]interface{})["geofence_id"].(string)] = struct{}{}
+		}
+
+		geofences = tfslices.Filter(geofences, func(v *locationservice.ListGeofenceResponseEntry) bool {
+			_, ok := ids[aws.StringValue(v.GeofenceId)]
+			return ok
+		})
+	}
+
+	if !d.IsNewResource() && len(geofences) == 0 {
+		log.Printf("[WARN] Location Service Geofence Collection (%s) entries not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	tfList, err := flattenListGeofenceResponseEntries(geofences)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("collection_name", d.Id())
+	if err := d.Set("geofence", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting geofence: %s", err)
+	}
+
+	return diags
+}
+
+func resourceGeofenceCollectionEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	if d.HasChange("geofence") {
+		o, n := d.GetChange("geofence")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Geofences are hashed on ID, so the difference yields added and removed IDs.
+		// Updated geofences are re-put along with the added ones.
+		var put []interface{}
+		for _, tfMapRaw := range ns.List() {
+			if !os.Contains(tfMapRaw) || geofenceChanged(os, tfMapRaw.(map[string]interface{})) {
+				put = append(put, tfMapRaw)
+			}
+		}
+
+		var del []string
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			del = append(del, tfMapRaw.(map[string]interface{})["geofence_id"].(string))
+		}
+
+		if len(del) > 0 {
+			if err := batchDeleteGeofences(ctx, conn, d.Id(), del); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Location Service Geofence Collection (%s) entries: %s", d.Id(), err)
+			}
+		}
+
+		if len(put) > 0 {
+			entries, err := expandBatchPutGeofenceRequestEntries(put)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := batchPutGeofences(ctx, conn, d.Id(), entries); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Location Service Geofence Collection (%s) entries: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceGeofenceCollectionEntriesRead(ctx, d, meta)...)
+}
+
+func resourceGeofenceCollectionEntriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	var ids []string
+	for _, tfMapRaw := range d.Get("geofence").(*schema.Set).List() {
+		ids = append(ids, tfMapRaw.(map[string]interface{})["geofence_id"].(string))
+	}
+
+	log.Printf("[INFO] Deleting Location Service Geofence Collection (%s) entries", d.Id())
+	err := batchDeleteGeofences(ctx, conn, d.Id(), ids)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Location Service Geofence Collection (%s) entries: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func geofenceHash(v interface{}) int {
+	return create.StringHashcode(v.(map[string]interface{})["geofence_id"].(string))
+}
+
+func geofenceChanged(old *schema.Set, tfMap map[string]interface{}) bool {
+	v := old.F(tfMap)
+
+	for _, tfMapRaw := range old.List() {
+		if old.F(tfMapRaw) != v {
+			continue
+		}
+
+		oldMap := tfMapRaw.(map[string]interface{})
+		if !verify.JSONStringsEqual(oldMap["geometry"].(string), tfMap["geometry"].(string)) {
+			return true
+		}
+
+		return !maps.Equal(flex.ExpandStringValueMap(oldMap["properties"].(map[string]interface{})), flex.ExpandStringValueMap(tfMap["properties"].(map[string]interface{})))
+	}
+
+	return true
+}
+
+func findGeofencesByCollectionName(ctx context.Context, conn *locationservice.LocationService, name string) ([]*locationservice.ListGeofenceResponseEntry, error) {
+	input := &locationservice.ListGeofencesInput{
+		CollectionName: aws.String(name),
+	}
+	var output []*locationservice.ListGeofenceResponseEntry
+
+	err := conn.ListGeofencesPagesWithContext(ctx, input, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			if v == nil {
+				continue
+			}
+
+			if status := aws.StringValue(v.Status); status == "DELETED" || status == "DELETING" {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func batchPutGeofences(ctx context.Context, conn *locationservice.LocationService, name string, entries []*locationservice.BatchPutGeofenceRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(entries, geofenceBatchSize) {
+		input := &locationservice.BatchPutGeofenceInput{
+			CollectionName: aws.String(name),
+			Entries:        chunk,
+		}
+
+		output, err := conn.BatchPutGeofenceWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && len(output.Errors) > 0 {
+			return batchItemErrors(output.Errors)
+		}
+	}
+
+	return nil
+}
+
+func batchDeleteGeofences(ctx context.Context, conn *locationservice.LocationService, name string, ids []string) error {
+	for _, chunk := range tfslices.Chunks(ids, geofenceBatchSize) {
+		input := &locationservice.BatchDeleteGeofenceInput{
+			CollectionName: aws.String(name),
+			GeofenceIds:    aws.StringSlice(chunk),
+		}
+
+		output, err := conn.BatchDeleteGeofenceWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && len(output.Errors) > 0 {
+			var errs []error
+			for _, v := range output.Errors {
+				if v == nil || v.Error == nil {
+					continue
+				}
+
+				if aws.StringValue(v.Error.Code) == locationservice.BatchItemErrorCodeResourceNotFoundError {
+					continue
+				}
+
+				errs = append(errs, fmt.Errorf("geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+			}
+
+			if err := errors.Join(errs...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func batchItemErrors(apiObjects []*locationservice.BatchPutGeofenceError) error {
+	var errs []error
+
+	for _, v := range apiObjects {
+		if v == nil || v.Error == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// geoJSONGeometry is the subset of a GeoJSON geometry object supported by Amazon Location Service.
+type geoJSONGeometry struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+func expandBatchPutGeofenceRequestEntries(tfList []interface{}) ([]*locationservice.BatchPutGeofenceRequestEntry, error) {
+	var apiObjects []*locationservice.BatchPutGeofenceRequestEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id := tfMap["geofence_id"].(string)
+
+		var geometry geoJSONGeometry
+		if err := json.Unmarshal([]byte(tfMap["geometry"].(string)), &geometry); err != nil {
+			return nil, fmt.Errorf("parsing geofence (%s) geometry: %w", id, err)
+		}
+
+		if geometry.Type != geoJSONTypePolygon {
+			return nil, fmt.Errorf("geofence (%s) geometry: unsupported GeoJSON type %q, expected %q", id, geometry.Type, geoJSONTypePolygon)
+		}
+
+		polygon := make([][][]*float64, 0, len(geometry.Coordinates))
+		for _, ring := range geometry.Coordinates {
+			r := make([][]*float64, 0, len(ring))
+			for _, position := range ring {
+				r = append(r, aws.Float64Slice(position))
+			}
+			polygon = append(polygon, r)
+		}
+
+		apiObject := &locationservice.BatchPutGeofenceRequestEntry{
+			GeofenceId: aws.String(id),
+			Geometry: &locationservice.GeofenceGeometry{
+				Polygon: polygon,
+			},
+		}
+
+		if v, ok := tfMap["properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.GeofenceProperties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenListGeofenceResponseEntries(apiObjects []*locationservice.ListGeofenceResponseEntry) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		geometry := geoJSONGeometry{
+			Type: geoJSONTypePolygon,
+		}
+
+		if apiObject.Geometry != nil {
+			for _, ring := range apiObject.Geometry.Polygon {
+				r := make([][]float64, 0, len(ring))
+				for _, position := range ring {
+					r = append(r, aws.Float64ValueSlice(position))
+				}
+				geometry.Coordinates = append(geometry.Coordinates, r)
+			}
+		}
+
+		b, err := json.Marshal(geometry)
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap := map[string]interface{}{
+			"geofence_id": aws.StringValue(apiObject.GeofenceId),
+			"geometry":    string(b),
+			"properties":  aws.StringValueMap(apiObject.GeofenceProperties),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
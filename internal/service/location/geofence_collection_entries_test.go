@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationGeofenceCollectionEntries_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence_collection_entries.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceCollectionEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceCollectionEntriesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceCollectionEntriesExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_name", "aws_location_geofence_collection.test", "collection_name"),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "geofence.*", map[string]string{
+						"geofence_id":     "one",
+						"properties.%":    acctest.Ct1,
+						"properties.zone": "north",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofenceCollectionEntries_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence_collection_entries.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceCollectionEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceCollectionEntriesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceCollectionEntriesExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccGeofenceCollectionEntriesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceCollectionEntriesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "geofence.*", map[string]string{
+						"geofence_id":     "one",
+						"properties.zone": "south",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "geofence.*", map[string]string{
+						"geofence_id":  "two",
+						"properties.%": acctest.Ct0,
+					}),
+				),
+			},
+			{
+				Config: testAccGeofenceCollectionEntriesConfig_removed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceCollectionEntriesExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "geofence.*", map[string]string{
+						"geofence_id": "two",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGeofenceCollectionEntriesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_geofence_collection_entries" {
+				continue
+			}
+
+			n, err := testAccCountActiveGeofences(ctx, conn, rs.Primary.ID)
+
+			if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error listing Location Service Geofence Collection (%s) entries: %w", rs.Primary.ID, err)
+			}
+
+			if n > 0 {
+				return fmt.Errorf("Location Service Geofence Collection (%s) entries still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGeofenceCollectionEntriesExists(ctx context.Context, resourceName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		n, err := testAccCountActiveGeofences(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error listing Location Service Geofence Collection (%s) entries: %w", rs.Primary.ID, err)
+		}
+
+		if n != want {
+			return fmt.Errorf("Location Service Geofence Collection (%s) has %d geofences, expected %d", rs.Primary.ID, n, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCountActiveGeofences(ctx context.Context, conn *locationservice.LocationService, name string) (int, error) {
+	input := &locationservice.ListGeofencesInput{
+		CollectionName: aws.String(name),
+	}
+	var n int
+
+	err := conn.ListGeofencesPagesWithContext(ctx, input, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+		for _, v := range page.Entries {
+			if aws.StringValue(v.Status) == "ACTIVE" {
+				n++
+			}
+		}
+
+		return !lastPage
+	})
+
+	return n, err
+}
+
+func testAccGeofenceCollectionEntriesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+`, rName)
+}
+
+func testAccGeofenceCollectionEntriesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGeofenceCollectionEntriesConfig_base(rName), `
+resource "aws_location_geofence_collection_entries" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geofence {
+    geofence_id = "one"
+    geometry = jsonencode({
+      type        = "Polygon"
+      coordinates = [[[-5.716, 54.676], [-5.715, 54.676], [-5.715, 54.677], [-5.716, 54.677], [-5.716, 54.676]]]
+    })
+
+    properties = {
+      zone = "north"
+    }
+  }
+}
+`)
+}
+
+func testAccGeofenceCollectionEntriesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccGeofenceCollectionEntriesConfig_base(rName), `
+resource "aws_location_geofence_collection_entries" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geofence {
+    geofence_id = "one"
+    geometry = jsonencode({
+      type        = "Polygon"
+      coordinates = [[[-5.716, 54.676], [-5.715, 54.676], [-5.715, 54.677], [-5.716, 54.677], [-5.716, 54.676]]]
+    })
+
+    properties = {
+      zone = "south"
+    }
+  }
+
+  geofence {
+    geofence_id = "two"
+    geometry = jsonencode({
+      type        = "Polygon"
+      coordinates = [[[-5.726, 54.686], [-5.725, 54.686], [-5.725, 54.687], [-5.726, 54.687], [-5.726, 54.686]]]
+    })
+  }
+}
+`)
+}
+
+func testAccGeofenceCollectionEntriesConfig_removed(rName string) string {
+	return acctest.ConfigCompose(testAccGeofenceCollectionEntriesConfig_base(rName), `
+resource "aws_location_geofence_collection_entries" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geofence {
+    geofence_id = "two"
+    geometry = jsonencode({
+      type        = "Polygon"
+      coordinates = [[[-5.726, 54.686], [-5.725, 54.686], [-5.725, 54.687], [-5.726, 54.687], [-5.726, 54.686]]]
+    })
+  }
+}
+`)
+}
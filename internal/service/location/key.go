@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_key", name="Key")
+// @Tags(identifierAttribute="key_arn")
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"expire_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			names.AttrKey: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"no_expiry": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(5, 200),
+							},
+						},
+						"allow_referers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 253),
+							},
+						},
+						"allow_resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	input := &locationservice.CreateKeyInput{
+		KeyName:      aws.String(d.Get("key_name").(string)),
+		Restrictions: expandAPIKeyRestrictions(d.Get("restrictions").([]interface{})),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expire_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpireTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("no_expiry"); ok {
+		input.NoExpiry = aws.Bool(v.(bool))
+	}
+
+	output, err := conn.CreateKeyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Location Service Key: %s", err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Location Service Key: empty result")
+	}
+
+	d.SetId(aws.StringValue(output.KeyName))
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	input := &locationservice.DescribeKeyInput{
+		KeyName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeKeyWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Location Service Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Location Service Key (%s): empty response", d.Id())
+	}
+
+	d.Set(names.AttrCreateTime, aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, output.Description)
+	// A key created without an expiry still reports an expire time far in the future.
+	if !d.Get("no_expiry").(bool) {
+		d.Set("expire_time", aws.TimeValue(output.ExpireTime).Format(time.RFC3339))
+	}
+	d.Set(names.AttrKey, output.Key)
+	d.Set("key_arn", output.KeyArn)
+	d.Set("key_name", output.KeyName)
+	if err := d.Set("restrictions", flattenAPIKeyRestrictions(output.Restrictions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting restrictions: %s", err)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, "expire_time", "no_expiry", "restrictions") {
+		input := &locationservice.UpdateKeyInput{
+			// Keys that have been used in the past 7 days can only be updated when forced.
+			ForceUpdate: aws.Bool(true),
+			KeyName:     aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges("expire_time", "no_expiry") {
+			if v, ok := d.GetOk("expire_time"); ok && !d.Get("no_expiry").(bool) {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+				input.ExpireTime = aws.Time(v)
+			} else {
+				input.NoExpiry = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("restrictions") {
+			input.Restrictions = expandAPIKeyRestrictions(d.Get("restrictions").([]interface{}))
+		}
+
+		_, err := conn.UpdateKeyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Location Service Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	input := &locationservice.DeleteKeyInput{
+		// Keys that have been used in the past 7 days can only be deleted when forced.
+		ForceDelete: aws.Bool(true),
+		KeyName:     aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteKeyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandAPIKeyRestrictions(tfList []interface{}) *locationservice.ApiKeyRestrictions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &locationservice.ApiKeyRestrictions{}
+
+	if v, ok := tfMap["allow_actions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowActions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_referers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowReferers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowResources = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAPIKeyRestrictions(apiObject *locationservice.ApiKeyRestrictions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_actions":   aws.StringValueSlice(apiObject.AllowActions),
+		"allow_referers":  aws.StringValueSlice(apiObject.AllowReferers),
+		"allow_resources": aws.StringValueSlice(apiObject.AllowResources),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -68,6 +68,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "collection_arn",
 			},
 		},
+		{
+			Factory:  ResourceGeofenceCollectionEntries,
+			TypeName: "aws_location_geofence_collection_entries",
+			Name:     "Geofence Collection Entries",
+		},
+		{
+			Factory:  ResourceKey,
+			TypeName: "aws_location_key",
+			Name:     "Key",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "key_arn",
+			},
+		},
 		{
 			Factory:  ResourceMap,
 			TypeName: "aws_location_map",
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Batch Job Definitions")
+func newBatchJobDefinitionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &batchJobDefinitionsDataSource{}, nil
+}
+
+type batchJobDefinitionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *batchJobDefinitionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_m2_batch_job_definitions"
+}
+
+func (d *batchJobDefinitionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			"batch_job_definitions": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[batchJobDefinitionModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"file_name":   types.StringType,
+						"folder_path": types.StringType,
+						"script_name": types.StringType,
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrPrefix: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *batchJobDefinitionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data batchJobDefinitionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	input := &m2.ListBatchJobDefinitionsInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		Prefix:        fwflex.StringFromFramework(ctx, data.Prefix),
+	}
+
+	output, err := findBatchJobDefinitions(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("listing Mainframe Modernization Application (%s) Batch Job Definitions", data.ApplicationID.ValueString()), err))
+
+		return
+	}
+
+	var definitions []batchJobDefinitionModel
+	for _, v := range output {
+		var definition batchJobDefinitionModel
+
+		switch v := v.(type) {
+		case *awstypes.BatchJobDefinitionMemberFileBatchJobDefinition:
+			definition.FileName = fwflex.StringToFramework(ctx, v.Value.FileName)
+			definition.FolderPath = fwflex.StringToFramework(ctx, v.Value.FolderPath)
+			definition.ScriptName = types.StringNull()
+		case *awstypes.BatchJobDefinitionMemberScriptBatchJobDefinition:
+			definition.FileName = types.StringNull()
+			definition.FolderPath = types.StringNull()
+			definition.ScriptName = fwflex.StringToFramework(ctx, v.Value.ScriptName)
+		default:
+			continue
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	data.BatchJobDefinitions = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, definitions)
+	data.ID = fwflex.StringValueToFramework(ctx, data.ApplicationID.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findBatchJobDefinitions(ctx context.Context, conn *m2.Client, input *m2.ListBatchJobDefinitionsInput) ([]awstypes.BatchJobDefinition, error) {
+	var output []awstypes.BatchJobDefinition
+
+	pages := m2.NewListBatchJobDefinitionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.BatchJobDefinitions...)
+	}
+
+	return output, nil
+}
+
+type batchJobDefinitionsDataSourceModel struct {
+	ApplicationID       types.String                                             `tfsdk:"application_id"`
+	BatchJobDefinitions fwtypes.ListNestedObjectValueOf[batchJobDefinitionModel] `tfsdk:"batch_job_definitions"`
+	ID                  types.String                                             `tfsdk:"id"`
+	Prefix              types.String                                             `tfsdk:"prefix"`
+}
+
+type batchJobDefinitionModel struct {
+	FileName   types.String `tfsdk:"file_name"`
+	FolderPath types.String `tfsdk:"folder_path"`
+	ScriptName types.String `tfsdk:"script_name"`
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Set Import")
+func newDataSetImportResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataSetImportResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type dataSetImportResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (*dataSetImportResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_m2_data_set_import"
+}
+
+func (r *dataSetImportResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"s3_location": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataSetTaskLifecycle](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"summary": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSetImportSummaryModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"failed":      types.Int64Type,
+						"in_progress": types.Int64Type,
+						"pending":     types.Int64Type,
+						"succeeded":   types.Int64Type,
+						"total":       types.Int64Type,
+					},
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *dataSetImportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataSetImportResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	input := &m2.CreateDataSetImportTaskInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		ImportConfig: &awstypes.DataSetImportConfigMemberS3Location{
+			Value: data.S3Location.ValueString(),
+		},
+	}
+
+	output, err := conn.CreateDataSetImportTask(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Mainframe Modernization Data Set Import", err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TaskID = fwflex.StringToFramework(ctx, output.TaskId)
+	data.setID()
+
+	task, err := waitDataSetImportTaskCompleted(ctx, conn, data.ApplicationID.ValueString(), data.TaskID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Mainframe Modernization Data Set Import (%s) create", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, task, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataSetImportResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataSetImportResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
+
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	output, err := findDataSetImportTaskByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.TaskID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Mainframe Modernization Data Set Import (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDataSetImportTaskByTwoPartKey(ctx context.Context, conn *m2.Client, applicationID, taskID string) (*m2.GetDataSetImportTaskOutput, error) {
+	input := &m2.GetDataSetImportTaskInput{
+		ApplicationId: aws.String(applicationID),
+		TaskId:        aws.String(taskID),
+	}
+
+	output, err := conn.GetDataSetImportTask(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataSetImportTask(ctx context.Context, conn *m2.Client, applicationID, taskID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataSetImportTaskByTwoPartKey(ctx, conn, applicationID, taskID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSetImportTaskCompleted(ctx context.Context, conn *m2.Client, applicationID, taskID string, timeout time.Duration) (*m2.GetDataSetImportTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSetTaskLifecycleCreating, awstypes.DataSetTaskLifecycleRunning),
+		Target:  enum.Slice(awstypes.DataSetTaskLifecycleCompleted),
+		Refresh: statusDataSetImportTask(ctx, conn, applicationID, taskID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetDataSetImportTaskOutput); ok {
+		if summary := output.Summary; summary != nil && summary.Failed > 0 {
+			tfresource.SetLastError(err, fmt.Errorf("%d of %d data set imports failed", summary.Failed, summary.Total))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type dataSetImportResourceModel struct {
+	ApplicationID types.String                                               `tfsdk:"application_id"`
+	ID            types.String                                               `tfsdk:"id"`
+	S3Location    types.String                                               `tfsdk:"s3_location"`
+	Status        fwtypes.StringEnum[awstypes.DataSetTaskLifecycle]          `tfsdk:"status"`
+	Summary       fwtypes.ListNestedObjectValueOf[dataSetImportSummaryModel] `tfsdk:"summary"`
+	TaskID        types.String                                               `tfsdk:"task_id"`
+	Timeouts      timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type dataSetImportSummaryModel struct {
+	Failed     types.Int64 `tfsdk:"failed"`
+	InProgress types.Int64 `tfsdk:"in_progress"`
+	Pending    types.Int64 `tfsdk:"pending"`
+	Succeeded  types.Int64 `tfsdk:"succeeded"`
+	Total      types.Int64 `tfsdk:"total"`
+}
+
+const (
+	dataSetImportResourceIDPartCount = 2
+)
+
+func (data *dataSetImportResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, dataSetImportResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.TaskID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *dataSetImportResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.TaskID.ValueString()}, dataSetImportResourceIDPartCount, false)))
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2DataSetImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var task m2.GetDataSetImportTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_data_set_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetImportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetImportExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_m2_application.test", names.AttrApplicationID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Completed"),
+					resource.TestCheckResourceAttr(resourceName, "summary.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.failed", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.succeeded", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_location"},
+			},
+		},
+	})
+}
+
+func testAccCheckDataSetImportExists(ctx context.Context, n string, v *m2.GetDataSetImportTaskOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		output, err := tfm2.FindDataSetImportTaskByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["task_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataSetImportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true), `
+resource "aws_s3_object" "data" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data/AWS.M2.CARDDEMO.ACCTDATA.VSAM.KSDS.DAT"
+  content = format("%-300s", "00000000001")
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data-set-import.json"
+  content = templatefile("test-fixtures/data-set-import.json", { s3_bucket = aws_s3_bucket.test.id, data_set_name = "AWS.M2.CARDDEMO.ACCTDATA.VSAM.KSDS" })
+}
+
+resource "aws_m2_data_set_import" "test" {
+  application_id = aws_m2_application.test.application_id
+  s3_location    = "s3://${aws_s3_bucket.test.id}/${aws_s3_object.manifest.key}"
+
+  depends_on = [aws_m2_deployment.test, aws_s3_object.data]
+}
+`)
+}
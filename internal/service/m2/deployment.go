@@ -111,7 +111,7 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 	output, err := conn.CreateDeployment(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Mainframe Modernization Deployment", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Mainframe Modernization Deployment", err))
 
 		return
 	}
@@ -122,7 +122,7 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 
 	timeout := r.CreateTimeout(ctx, data.Timeouts)
 	if _, err := waitDeploymentCreated(ctx, conn, data.ApplicationID.ValueString(), data.DeploymentID.ValueString(), timeout); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -130,7 +130,7 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 	if data.Start.ValueBool() {
 		applicationID := data.ApplicationID.ValueString()
 		if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err))
 
 			return
 		}
@@ -147,7 +147,7 @@ func (r *deploymentResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -164,7 +164,7 @@ func (r *deploymentResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Deployment (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Mainframe Modernization Deployment (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -178,7 +178,7 @@ func (r *deploymentResource) Read(ctx context.Context, request resource.ReadRequ
 	// Additional fields.
 	outputGA, err := findApplicationByID(ctx, conn, data.ApplicationID.ValueString())
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s)", data.ApplicationID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Mainframe Modernization Application (%s)", data.ApplicationID.ValueString()), err))
 
 		return
 	}
@@ -208,7 +208,7 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 		// Stop the application if it was running.
 		if old.Start.ValueBool() {
 			if _, err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err))
 
 				return
 			}
@@ -226,7 +226,7 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 		output, err := conn.CreateDeployment(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError("creating Mainframe Modernization Deployment", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Mainframe Modernization Deployment", err))
 
 			return
 		}
@@ -236,7 +236,7 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 		new.setID()
 
 		if _, err := waitDeploymentUpdated(ctx, conn, new.ApplicationID.ValueString(), new.DeploymentID.ValueString(), timeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -245,7 +245,7 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 		if new.Start.ValueBool() {
 			applicationID := new.ApplicationID.ValueString()
 			if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err))
 				return
 			}
 		}
@@ -260,11 +260,11 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 		new.DeploymentID = old.DeploymentID
 		if new.Start.ValueBool() {
 			if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err))
 			}
 		} else {
 			if _, err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err))
 			}
 		}
 	}
@@ -285,7 +285,7 @@ func (r *deploymentResource) Delete(ctx context.Context, request resource.Delete
 	if data.Start.ValueBool() {
 		applicationID := data.ApplicationID.ValueString()
 		if _, err := stopApplicationIfRunning(ctx, conn, applicationID, data.ForceStop.ValueBool(), timeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err))
 
 			return
 		}
@@ -301,13 +301,13 @@ func (r *deploymentResource) Delete(ctx context.Context, request resource.Delete
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Mainframe Modernization Deployment (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Mainframe Modernization Deployment (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err := waitApplicationDeletedFromEnvironment(ctx, conn, data.ApplicationID.ValueString(), timeout); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) delete", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) delete", data.ID.ValueString()), err))
 
 		return
 	}
@@ -397,7 +397,7 @@ func waitDeploymentCreated(ctx context.Context, conn *m2.Client, applicationID,
 
 func waitDeploymentUpdated(ctx context.Context, conn *m2.Client, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DeploymentLifecycleDeployUpdate),
+		Pending: enum.Slice(awstypes.DeploymentLifecycleDeploying, awstypes.DeploymentLifecycleDeployUpdate),
 		Target:  enum.Slice(awstypes.DeploymentLifecycleSucceeded),
 		Refresh: statusDeployment(ctx, conn, applicationID, deploymentID),
 		Timeout: timeout,
@@ -5,11 +5,13 @@ package m2
 
 // Exports for use in tests only.
 var (
-	ResourceApplication = newApplicationResource
-	ResourceDeployment  = newDeploymentResource
-	ResourceEnvironment = newEnvironmentResource
+	ResourceApplication   = newApplicationResource
+	ResourceDataSetImport = newDataSetImportResource
+	ResourceDeployment    = newDeploymentResource
+	ResourceEnvironment   = newEnvironmentResource
 
-	FindApplicationByID        = findApplicationByID
-	FindDeploymentByTwoPartKey = findDeploymentByTwoPartKey
-	FindEnvironmentByID        = findEnvironmentByID
+	FindApplicationByID               = findApplicationByID
+	FindDataSetImportTaskByTwoPartKey = findDataSetImportTaskByTwoPartKey
+	FindDeploymentByTwoPartKey        = findDeploymentByTwoPartKey
+	FindEnvironmentByID               = findEnvironmentByID
 )
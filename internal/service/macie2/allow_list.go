@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_allow_list", name="Allow List")
+// @Tags
+func ResourceAllowList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAllowListCreate,
+		ReadWithoutTimeout:   resourceAllowListRead,
+		UpdateWithoutTimeout: resourceAllowListUpdate,
+		DeleteWithoutTimeout: resourceAllowListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"criteria.0.regex", "criteria.0.s3_words_list"},
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"s3_words_list": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"criteria.0.regex", "criteria.0.s3_words_list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucketName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"object_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchemaForceNew(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAllowListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &macie2.CreateAllowListInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Criteria:    expandAllowListCriteria(d.Get("criteria").([]interface{})),
+		Name:        aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	var err error
+	var output *macie2.CreateAllowListOutput
+	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+		output, err = conn.CreateAllowListWithContext(ctx, input)
+		if err != nil {
+			if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.CreateAllowListWithContext(ctx, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Macie AllowList (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceAllowListRead(ctx, d, meta)...)
+}
+
+func resourceAllowListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := findAllowListByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && (tfresource.NotFound(err) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie AllowList (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie AllowList (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrCreatedAt, aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err = d.Set("criteria", flattenAllowListCriteria(output.Criteria)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie AllowList (%s): %s", "criteria", d.Id(), err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+	if err = d.Set(names.AttrStatus, flattenAllowListStatus(output.Status)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie AllowList (%s): %s", names.AttrStatus, d.Id(), err)
+	}
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceAllowListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	if d.HasChanges("criteria", names.AttrDescription, names.AttrName) {
+		// All of criteria and name must be sent on every update.
+		input := &macie2.UpdateAllowListInput{
+			Criteria: expandAllowListCriteria(d.Get("criteria").([]interface{})),
+			Id:       aws.String(d.Id()),
+			Name:     aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAllowListWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie AllowList (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAllowListRead(ctx, d, meta)...)
+}
+
+func resourceAllowListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting Macie AllowList: %s", d.Id())
+	_, err := conn.DeleteAllowListWithContext(ctx, &macie2.DeleteAllowListInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie AllowList (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAllowListByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetAllowListOutput, error) {
+	input := &macie2.GetAllowListInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetAllowListWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAllowListCriteria(tfList []interface{}) *macie2.AllowListCriteria {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.AllowListCriteria{}
+
+	if v, ok := tfMap["regex"].(string); ok && v != "" {
+		apiObject.Regex = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_words_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3WordsList = &macie2.S3WordsList{
+			BucketName: aws.String(tfMap[names.AttrBucketName].(string)),
+			ObjectKey:  aws.String(tfMap["object_key"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAllowListCriteria(apiObject *macie2.AllowListCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"regex": aws.StringValue(apiObject.Regex),
+	}
+
+	if v := apiObject.S3WordsList; v != nil {
+		tfMap["s3_words_list"] = []interface{}{
+			map[string]interface{}{
+				names.AttrBucketName: aws.StringValue(v.BucketName),
+				"object_key":         aws.StringValue(v.ObjectKey),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAllowListStatus(apiObject *macie2.AllowListStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"code":                aws.StringValue(apiObject.Code),
+		names.AttrDescription: aws.StringValue(apiObject.Description),
+	}
+
+	return []interface{}{tfMap}
+}
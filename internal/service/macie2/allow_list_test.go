@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAllowList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetAllowListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_regex(rName, "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "macie2", regexache.MustCompile(`allow-list/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowListConfig_regex(rName, "[0-9]{4}-[0-9]{4}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", "[0-9]{4}-[0-9]{4}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAllowList_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetAllowListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_regex(rName, "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmacie2.ResourceAllowList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAllowList_s3WordsList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetAllowListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_s3WordsList(rName, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", ""),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.object_key", "aws_s3_object.test", names.AttrKey),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "initial"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowListConfig_s3WordsList(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAllowList_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetAllowListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowListDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", names.AttrValue),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAllowListExists(ctx context.Context, n string, v *macie2.GetAllowListOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := tfmacie2.FindAllowListByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAllowListDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_allow_list" {
+				continue
+			}
+
+			_, err := tfmacie2.FindAllowListByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Macie AllowList %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAllowListConfig_regex(rName, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = %[2]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex)
+}
+
+func testAccAllowListConfig_s3WordsList(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "allow-list.txt"
+  content = "example\nwords\n"
+}
+
+resource "aws_macie2_allow_list" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  criteria {
+    s3_words_list {
+      bucket_name = aws_s3_bucket.test.bucket
+      object_key  = aws_s3_object.test.key
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, description)
+}
+
+func testAccAllowListConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  }
+
+  tags = {
+    Key  = "value"
+    Key2 = "value2"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
@@ -39,6 +39,12 @@ func ResourceClassificationJob() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"allow_list_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"custom_data_identifier_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -590,6 +596,9 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("allow_list_ids"); ok {
+		input.AllowListIds = flex.ExpandStringList(v.([]interface{}))
+	}
 	if v, ok := d.GetOk("custom_data_identifier_ids"); ok {
 		input.CustomDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
 	}
@@ -657,6 +666,9 @@ func resourceClassificationJobRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading Macie ClassificationJob (%s): %s", d.Id(), err)
 	}
 
+	if err = d.Set("allow_list_ids", flex.FlattenStringList(resp.AllowListIds)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "allow_list_ids", d.Id(), err)
+	}
 	if err = d.Set("custom_data_identifier_ids", flex.FlattenStringList(resp.CustomDataIdentifierIds)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "custom_data_identifier_ids", d.Id(), err)
 	}
@@ -695,10 +707,6 @@ func resourceClassificationJobUpdate(ctx context.Context, d *schema.ResourceData
 
 	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
 
-	input := &macie2.UpdateClassificationJobInput{
-		JobId: aws.String(d.Id()),
-	}
-
 	if d.HasChange("job_status") {
 		status := d.Get("job_status").(string)
 
@@ -706,12 +714,19 @@ func resourceClassificationJobUpdate(ctx context.Context, d *schema.ResourceData
 			return sdkdiag.AppendErrorf(diags, "updating Macie ClassificationJob (%s): %s", d.Id(), fmt.Sprintf("%s cannot be set", macie2.JobStatusCancelled))
 		}
 
-		input.JobStatus = aws.String(status)
-	}
+		input := &macie2.UpdateClassificationJobInput{
+			JobId:     aws.String(d.Id()),
+			JobStatus: aws.String(status),
+		}
 
-	_, err := conn.UpdateClassificationJobWithContext(ctx, input)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Macie ClassificationJob (%s): %s", d.Id(), err)
+		_, err := conn.UpdateClassificationJobWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie ClassificationJob (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitClassificationJobStatusUpdated(ctx, conn, d.Id(), status); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Macie ClassificationJob (%s) status update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceClassificationJobRead(ctx, d, meta)...)
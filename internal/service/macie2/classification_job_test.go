@@ -171,6 +171,14 @@ func testAccClassificationJob_Status(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccClassificationJobConfig_status(bucketName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output2),
+					testAccCheckClassificationJobNotRecreated(&macie2Output, &macie2Output2),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusRunning),
+				),
+			},
 		},
 	})
 }
@@ -391,6 +399,35 @@ func testAccClassificationJob_BucketCriteria(t *testing.T) {
 	})
 }
 
+func testAccClassificationJob_allowList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationJobConfig_allowList(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "allow_list_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "allow_list_ids.0", "aws_macie2_allow_list.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckClassificationJobExists(ctx context.Context, resourceName string, macie2Session *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -765,3 +802,38 @@ resource "aws_macie2_classification_job" "test" {
 }
 `, jobStatus, description)
 }
+
+func testAccClassificationJobConfig_allowList(nameBucket string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+
+resource "aws_macie2_classification_job" "test" {
+  allow_list_ids = [aws_macie2_allow_list.test.id]
+  job_type       = "ONE_TIME"
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, nameBucket)
+}
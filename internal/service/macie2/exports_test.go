@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+// Exports for use in tests only.
+var (
+	FindAllowListByID                     = findAllowListByID
+	FindSensitivityInspectionTemplateByID = findSensitivityInspectionTemplateByID
+)
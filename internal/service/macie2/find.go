@@ -8,6 +8,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // findMemberNotAssociated Return a list of members not associated and compare with account ID
@@ -38,3 +41,28 @@ func findMemberNotAssociated(ctx context.Context, conn *macie2.Macie2, accountID
 
 	return result, err
 }
+
+func findClassificationJobByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.DescribeClassificationJobOutput, error) {
+	input := &macie2.DescribeClassificationJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeClassificationJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -20,6 +20,12 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			acctest.CtDisappears:           testAccAccount_disappears,
 		},
+		"AllowList": {
+			acctest.CtBasic:      testAccAllowList_basic,
+			acctest.CtDisappears: testAccAllowList_disappears,
+			"s3_words_list":      testAccAllowList_s3WordsList,
+			"tags":               testAccAllowList_tags,
+		},
 		"ClassificationExportConfiguration": {
 			acctest.CtBasic: testAccClassificationExportConfiguration_basic,
 		},
@@ -32,6 +38,7 @@ func TestAccMacie2_serial(t *testing.T) {
 			"complete":           testAccClassificationJob_complete,
 			"tags":               testAccClassificationJob_WithTags,
 			"bucket_criteria":    testAccClassificationJob_BucketCriteria,
+			"allow_list":         testAccClassificationJob_allowList,
 		},
 		"CustomDataIdentifier": {
 			acctest.CtBasic:      testAccCustomDataIdentifier_basic,
@@ -67,6 +74,9 @@ func TestAccMacie2_serial(t *testing.T) {
 		"InvitationAccepter": {
 			acctest.CtBasic: testAccInvitationAccepter_basic,
 		},
+		"SensitivityInspectionTemplate": {
+			acctest.CtBasic: testAccSensitivityInspectionTemplate_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
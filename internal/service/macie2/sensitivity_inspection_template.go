@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_sensitivity_inspection_template", name="Sensitivity Inspection Template")
+func ResourceSensitivityInspectionTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSensitivityInspectionTemplateCreate,
+		ReadWithoutTimeout:   resourceSensitivityInspectionTemplateRead,
+		UpdateWithoutTimeout: resourceSensitivityInspectionTemplateUpdate,
+		DeleteWithoutTimeout: resourceSensitivityInspectionTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"excludes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_list_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"custom_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSensitivityInspectionTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// Macie creates the account's single sensitivity inspection template when automated sensitive data discovery is first enabled.
+	template, err := findSensitivityInspectionTemplate(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie SensitivityInspectionTemplate: %s", err)
+	}
+
+	id := aws.StringValue(template.Id)
+	input := expandUpdateSensitivityInspectionTemplateInput(d)
+	input.Id = aws.String(id)
+
+	_, err = conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie SensitivityInspectionTemplate (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSensitivityInspectionTemplateRead(ctx, d, meta)...)
+}
+
+func resourceSensitivityInspectionTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := findSensitivityInspectionTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && (tfresource.NotFound(err) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie SensitivityInspectionTemplate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie SensitivityInspectionTemplate (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDescription, output.Description)
+	if err := d.Set("excludes", flattenSensitivityInspectionTemplateExcludes(output.Excludes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie SensitivityInspectionTemplate (%s): %s", "excludes", d.Id(), err)
+	}
+	if err := d.Set("includes", flattenSensitivityInspectionTemplateIncludes(output.Includes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie SensitivityInspectionTemplate (%s): %s", "includes", d.Id(), err)
+	}
+	d.Set(names.AttrName, output.Name)
+
+	return diags
+}
+
+func resourceSensitivityInspectionTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	input := expandUpdateSensitivityInspectionTemplateInput(d)
+	input.Id = aws.String(d.Id())
+
+	_, err := conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie SensitivityInspectionTemplate (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSensitivityInspectionTemplateRead(ctx, d, meta)...)
+}
+
+func resourceSensitivityInspectionTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// The template can't be deleted, so reset it to its default settings.
+	log.Printf("[DEBUG] Resetting Macie SensitivityInspectionTemplate: %s", d.Id())
+	_, err := conn.UpdateSensitivityInspectionTemplateWithContext(ctx, &macie2.UpdateSensitivityInspectionTemplateInput{
+		Excludes: &macie2.SensitivityInspectionTemplateExcludes{},
+		Id:       aws.String(d.Id()),
+		Includes: &macie2.SensitivityInspectionTemplateIncludes{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting Macie SensitivityInspectionTemplate (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSensitivityInspectionTemplate(ctx context.Context, conn *macie2.Macie2) (*macie2.SensitivityInspectionTemplatesEntry, error) {
+	input := &macie2.ListSensitivityInspectionTemplatesInput{}
+	var output []*macie2.SensitivityInspectionTemplatesEntry
+
+	err := conn.ListSensitivityInspectionTemplatesPagesWithContext(ctx, input, func(page *macie2.ListSensitivityInspectionTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SensitivityInspectionTemplates {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findSensitivityInspectionTemplateByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetSensitivityInspectionTemplateOutput, error) {
+	input := &macie2.GetSensitivityInspectionTemplateInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSensitivityInspectionTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandUpdateSensitivityInspectionTemplateInput(d *schema.ResourceData) *macie2.UpdateSensitivityInspectionTemplateInput {
+	input := &macie2.UpdateSensitivityInspectionTemplateInput{
+		Excludes: &macie2.SensitivityInspectionTemplateExcludes{},
+		Includes: &macie2.SensitivityInspectionTemplateIncludes{},
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("excludes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v, ok := tfMap["managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+			input.Excludes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+		}
+	}
+
+	if v, ok := d.GetOk("includes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v, ok := tfMap["allow_list_ids"].(*schema.Set); ok && v.Len() > 0 {
+			input.Includes.AllowListIds = flex.ExpandStringSet(v)
+		}
+		if v, ok := tfMap["custom_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+			input.Includes.CustomDataIdentifierIds = flex.ExpandStringSet(v)
+		}
+		if v, ok := tfMap["managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+			input.Includes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+		}
+	}
+
+	return input
+}
+
+func flattenSensitivityInspectionTemplateExcludes(apiObject *macie2.SensitivityInspectionTemplateExcludes) []interface{} {
+	if apiObject == nil || len(apiObject.ManagedDataIdentifierIds) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"managed_data_identifier_ids": aws.StringValueSlice(apiObject.ManagedDataIdentifierIds),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSensitivityInspectionTemplateIncludes(apiObject *macie2.SensitivityInspectionTemplateIncludes) []interface{} {
+	if apiObject == nil || (len(apiObject.AllowListIds) == 0 && len(apiObject.CustomDataIdentifierIds) == 0 && len(apiObject.ManagedDataIdentifierIds) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_list_ids":              aws.StringValueSlice(apiObject.AllowListIds),
+		"custom_data_identifier_ids":  aws.StringValueSlice(apiObject.CustomDataIdentifierIds),
+		"managed_data_identifier_ids": aws.StringValueSlice(apiObject.ManagedDataIdentifierIds),
+	}
+
+	return []interface{}{tfMap}
+}
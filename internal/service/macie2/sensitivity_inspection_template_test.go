@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSensitivityInspectionTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetSensitivityInspectionTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_sensitivity_inspection_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSensitivityInspectionTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensitivityInspectionTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.0.managed_data_identifier_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excludes.0.managed_data_identifier_ids.*", "AWS_CREDENTIALS"),
+					resource.TestCheckResourceAttr(resourceName, "includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.allow_list_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "includes.0.allow_list_ids.*", "aws_macie2_allow_list.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.custom_data_identifier_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "includes.0.custom_data_identifier_ids.*", "aws_macie2_custom_data_identifier.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSensitivityInspectionTemplateConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensitivityInspectionTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "includes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSensitivityInspectionTemplateExists(ctx context.Context, n string, v *macie2.GetSensitivityInspectionTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := tfmacie2.FindSensitivityInspectionTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSensitivityInspectionTemplateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
+
+func testAccSensitivityInspectionTemplateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSensitivityInspectionTemplateConfig_base(rName), `
+resource "aws_macie2_sensitivity_inspection_template" "test" {
+  description = "managed by Terraform"
+
+  excludes {
+    managed_data_identifier_ids = ["AWS_CREDENTIALS"]
+  }
+
+  includes {
+    allow_list_ids             = [aws_macie2_allow_list.test.id]
+    custom_data_identifier_ids = [aws_macie2_custom_data_identifier.test.id]
+  }
+}
+`)
+}
+
+func testAccSensitivityInspectionTemplateConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccSensitivityInspectionTemplateConfig_base(rName), `
+resource "aws_macie2_sensitivity_inspection_template" "test" {
+  depends_on = [aws_macie2_account.test]
+}
+`)
+}
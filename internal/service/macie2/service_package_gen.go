@@ -35,6 +35,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccount,
 			TypeName: "aws_macie2_account",
 		},
+		{
+			Factory:  ResourceAllowList,
+			TypeName: "aws_macie2_allow_list",
+			Name:     "Allow List",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  ResourceClassificationExportConfiguration,
 			TypeName: "aws_macie2_classification_export_configuration",
@@ -71,6 +77,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOrganizationAdminAccount,
 			TypeName: "aws_macie2_organization_admin_account",
 		},
+		{
+			Factory:  ResourceSensitivityInspectionTemplate,
+			TypeName: "aws_macie2_sensitivity_inspection_template",
+			Name:     "Sensitivity Inspection Template",
+		},
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // statusMemberRelationship fetches the Member and its relationship status
@@ -27,3 +28,20 @@ func statusMemberRelationship(ctx context.Context, conn *macie2.Macie2, adminAcc
 		return adminAccount, aws.StringValue(adminAccount.RelationshipStatus), nil
 	}
 }
+
+// statusClassificationJob fetches the ClassificationJob and its status
+func statusClassificationJob(ctx context.Context, conn *macie2.Macie2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClassificationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
@@ -14,6 +14,9 @@ import (
 const (
 	// Maximum amount of time to wait for the statusMemberRelationship to be Invited, Enabled, or Paused
 	memberInvitedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a ClassificationJob to reach the requested status
+	classificationJobStatusUpdatedTimeout = 5 * time.Minute
 )
 
 // waitMemberInvited waits for an AdminAccount to return Invited, Enabled and Paused
@@ -33,3 +36,28 @@ func waitMemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID
 
 	return nil, err
 }
+
+// waitClassificationJobStatusUpdated waits for a ClassificationJob to be paused or resumed
+func waitClassificationJobStatusUpdated(ctx context.Context, conn *macie2.Macie2, id, status string) (*macie2.DescribeClassificationJobOutput, error) {
+	// A resumed job may already have finished or be waiting for its next scheduled run.
+	pending := []string{macie2.JobStatusUserPaused}
+	target := []string{macie2.JobStatusRunning, macie2.JobStatusIdle, macie2.JobStatusComplete, macie2.JobStatusPaused}
+	if status == macie2.JobStatusUserPaused {
+		pending, target = target, pending
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: statusClassificationJob(ctx, conn, id),
+		Timeout: classificationJobStatusUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.DescribeClassificationJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
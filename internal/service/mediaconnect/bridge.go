@@ -0,0 +1,693 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_bridge", name="Bridge")
+func resourceBridge() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBridgeCreate,
+		ReadWithoutTimeout:   resourceBridgeRead,
+		UpdateWithoutTimeout: resourceBridgeUpdate,
+		DeleteWithoutTimeout: resourceBridgeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bridge_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"egress_gateway_bridge": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"egress_gateway_bridge", "ingress_gateway_bridge"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"max_outputs": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_output": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrIPAddress: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsIPAddress,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"network_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrPort: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									names.AttrProtocol: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+									},
+									"ttl": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"placement_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"flow_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"network_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"multicast_ip": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsIPAddress,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"network_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrPort: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									names.AttrProtocol: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.FailoverMode](),
+						},
+						"primary_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.State](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBridgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconnect.CreateBridgeInput{
+		Name:         aws.String(name),
+		PlacementArn: aws.String(d.Get("placement_arn").(string)),
+		Sources:      expandAddBridgeSourceRequests(d.Get(names.AttrSource).([]interface{})),
+	}
+
+	if v, ok := d.GetOk("egress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.EgressGatewayBridge = &awstypes.AddEgressGatewayBridgeRequest{
+			MaxBitrate: aws.Int32(int32(tfMap["max_bitrate"].(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("ingress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.IngressGatewayBridge = &awstypes.AddIngressGatewayBridgeRequest{
+			MaxBitrate: aws.Int32(int32(tfMap["max_bitrate"].(int))),
+			MaxOutputs: aws.Int32(int32(tfMap["max_outputs"].(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddBridgeOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{}))
+	}
+
+	output, err := conn.CreateBridge(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Bridge (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Bridge.BridgeArn))
+
+	if _, err := waitBridgeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Bridge (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBridgeRead(ctx, d, meta)...)
+}
+
+func resourceBridgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	bridge, err := findBridgeByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Bridge (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Bridge (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, bridge.BridgeArn)
+	d.Set("bridge_state", bridge.BridgeState)
+	if v := bridge.EgressGatewayBridge; v != nil {
+		if err := d.Set("egress_gateway_bridge", []interface{}{map[string]interface{}{
+			"max_bitrate": aws.ToInt32(v.MaxBitrate),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting egress_gateway_bridge: %s", err)
+		}
+	} else {
+		d.Set("egress_gateway_bridge", nil)
+	}
+	if v := bridge.IngressGatewayBridge; v != nil {
+		if err := d.Set("ingress_gateway_bridge", []interface{}{map[string]interface{}{
+			"max_bitrate": aws.ToInt32(v.MaxBitrate),
+			"max_outputs": aws.ToInt32(v.MaxOutputs),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ingress_gateway_bridge: %s", err)
+		}
+	} else {
+		d.Set("ingress_gateway_bridge", nil)
+	}
+	d.Set(names.AttrName, bridge.Name)
+	if err := d.Set("output", flattenBridgeOutputs(bridge.Outputs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	d.Set("placement_arn", bridge.PlacementArn)
+	if err := d.Set(names.AttrSource, flattenBridgeSources(bridge.Sources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	if err := d.Set("source_failover_config", flattenFailoverConfig(bridge.SourceFailoverConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_failover_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBridgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	input := &mediaconnect.UpdateBridgeInput{
+		BridgeArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("egress_gateway_bridge") {
+		if v, ok := d.GetOk("egress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+			input.EgressGatewayBridge = &awstypes.UpdateEgressGatewayBridgeRequest{
+				MaxBitrate: aws.Int32(int32(tfMap["max_bitrate"].(int))),
+			}
+		}
+	}
+
+	if d.HasChange("ingress_gateway_bridge") {
+		if v, ok := d.GetOk("ingress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+			input.IngressGatewayBridge = &awstypes.UpdateIngressGatewayBridgeRequest{
+				MaxBitrate: aws.Int32(int32(tfMap["max_bitrate"].(int))),
+				MaxOutputs: aws.Int32(int32(tfMap["max_outputs"].(int))),
+			}
+		}
+	}
+
+	if d.HasChange("source_failover_config") {
+		if v := expandFailoverConfig(d.Get("source_failover_config").([]interface{})); v != nil {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				FailoverMode:   v.FailoverMode,
+				RecoveryWindow: v.RecoveryWindow,
+				SourcePriority: v.SourcePriority,
+				State:          v.State,
+			}
+		}
+	}
+
+	_, err := conn.UpdateBridge(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating MediaConnect Bridge (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBridgeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Bridge (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBridgeRead(ctx, d, meta)...)
+}
+
+func resourceBridgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	log.Printf("[DEBUG] Deleting MediaConnect Bridge: %s", d.Id())
+	_, err := conn.DeleteBridge(ctx, &mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Bridge (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Bridge (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := &mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeBridge(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.Bridge.BridgeState; state == awstypes.BridgeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateCreating),
+		Target:  enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive, awstypes.BridgeStateStartPending),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeleting, awstypes.BridgeStateStopping, awstypes.BridgeStateStandby, awstypes.BridgeStateActive, awstypes.BridgeStateStartPending),
+		Target:  []string{},
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAddBridgeSourceRequests(tfList []interface{}) []awstypes.AddBridgeSourceRequest {
+	var apiObjects []awstypes.AddBridgeSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.AddBridgeSourceRequest{}
+
+		if v, ok := tfMap["flow_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.FlowSource = &awstypes.AddBridgeFlowSourceRequest{
+				FlowArn: aws.String(tfMap["flow_arn"].(string)),
+				Name:    aws.String(tfMap[names.AttrName].(string)),
+			}
+		}
+
+		if v, ok := tfMap["network_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.NetworkSource = &awstypes.AddBridgeNetworkSourceRequest{
+				MulticastIp: aws.String(tfMap["multicast_ip"].(string)),
+				Name:        aws.String(tfMap[names.AttrName].(string)),
+				NetworkName: aws.String(tfMap["network_name"].(string)),
+				Port:        aws.Int32(int32(tfMap[names.AttrPort].(int))),
+				Protocol:    awstypes.Protocol(tfMap[names.AttrProtocol].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAddBridgeOutputRequests(tfList []interface{}) []awstypes.AddBridgeOutputRequest {
+	var apiObjects []awstypes.AddBridgeOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["network_output"].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMap = v[0].(map[string]interface{})
+		apiObjects = append(apiObjects, awstypes.AddBridgeOutputRequest{
+			NetworkOutput: &awstypes.AddBridgeNetworkOutputRequest{
+				IpAddress:   aws.String(tfMap[names.AttrIPAddress].(string)),
+				Name:        aws.String(tfMap[names.AttrName].(string)),
+				NetworkName: aws.String(tfMap["network_name"].(string)),
+				Port:        aws.Int32(int32(tfMap[names.AttrPort].(int))),
+				Protocol:    awstypes.Protocol(tfMap[names.AttrProtocol].(string)),
+				Ttl:         aws.Int32(int32(tfMap["ttl"].(int))),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func expandFailoverConfig(tfList []interface{}) *awstypes.FailoverConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = awstypes.FailoverMode(v)
+	}
+
+	if v, ok := tfMap["primary_source"].(string); ok && v != "" {
+		apiObject.SourcePriority = &awstypes.SourcePriority{
+			PrimarySource: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrState].(string); ok && v != "" {
+		apiObject.State = awstypes.State(v)
+	}
+
+	return apiObject
+}
+
+func flattenBridgeSources(apiObjects []awstypes.BridgeSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.FlowSource; v != nil {
+			tfMap["flow_source"] = []interface{}{map[string]interface{}{
+				"flow_arn":     aws.ToString(v.FlowArn),
+				names.AttrName: aws.ToString(v.Name),
+			}}
+		}
+
+		if v := apiObject.NetworkSource; v != nil {
+			tfMap["network_source"] = []interface{}{map[string]interface{}{
+				"multicast_ip":     aws.ToString(v.MulticastIp),
+				names.AttrName:     aws.ToString(v.Name),
+				"network_name":     aws.ToString(v.NetworkName),
+				names.AttrPort:     aws.ToInt32(v.Port),
+				names.AttrProtocol: v.Protocol,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBridgeOutputs(apiObjects []awstypes.BridgeOutput) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Flow outputs are added to the bridge by MediaConnect and are not configurable.
+		v := apiObject.NetworkOutput
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"network_output": []interface{}{map[string]interface{}{
+				names.AttrIPAddress: aws.ToString(v.IpAddress),
+				names.AttrName:      aws.ToString(v.Name),
+				"network_name":      aws.ToString(v.NetworkName),
+				names.AttrPort:      aws.ToInt32(v.Port),
+				names.AttrProtocol:  v.Protocol,
+				"ttl":               aws.ToInt32(v.Ttl),
+			}},
+		})
+	}
+
+	return tfList
+}
+
+func flattenFailoverConfig(apiObject *awstypes.FailoverConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   apiObject.FailoverMode,
+		"recovery_window": aws.ToInt32(apiObject.RecoveryWindow),
+		names.AttrState:   apiObject.State,
+	}
+
+	if v := apiObject.SourcePriority; v != nil {
+		tfMap["primary_source"] = aws.ToString(v.PrimarySource)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+// Exports for use in tests only.
+var (
+	ResourceBridge          = resourceBridge
+	ResourceFlowEntitlement = resourceFlowEntitlement
+	ResourceFlowOutput      = resourceFlowOutput
+	ResourceFlowSource      = resourceFlowSource
+	ResourceGateway         = resourceGateway
+
+	FindBridgeByARN                 = findBridgeByARN
+	FindFlowEntitlementByTwoPartKey = findFlowEntitlementByTwoPartKey
+	FindFlowOutputByTwoPartKey      = findFlowOutputByTwoPartKey
+	FindFlowSourceByTwoPartKey      = findFlowSourceByTwoPartKey
+	FindGatewayByARN                = findGatewayByARN
+	FlowResourceParseResourceID     = flowResourceParseResourceID
+)
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow_entitlement", name="Flow Entitlement")
+func resourceFlowEntitlement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowEntitlementCreate,
+		ReadWithoutTimeout:   resourceFlowEntitlementRead,
+		UpdateWithoutTimeout: resourceFlowEntitlementUpdate,
+		DeleteWithoutTimeout: resourceFlowEntitlementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_transfer_subscriber_fee_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"encryption": encryptionSchema(),
+			"entitlement_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EntitlementStatus](),
+			},
+			"flow_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subscribers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+		},
+	}
+}
+
+func resourceFlowEntitlementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN := d.Get("flow_arn").(string)
+	name := d.Get(names.AttrName).(string)
+	entitlement := awstypes.GrantEntitlementRequest{
+		Name:        aws.String(name),
+		Subscribers: flex.ExpandStringValueSet(d.Get("subscribers").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("data_transfer_subscriber_fee_percent"); ok {
+		entitlement.DataTransferSubscriberFeePercent = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		entitlement.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption"); ok {
+		entitlement.Encryption = expandEncryption(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("entitlement_status"); ok {
+		entitlement.EntitlementStatus = awstypes.EntitlementStatus(v.(string))
+	}
+
+	input := &mediaconnect.GrantFlowEntitlementsInput{
+		Entitlements: []awstypes.GrantEntitlementRequest{entitlement},
+		FlowArn:      aws.String(flowARN),
+	}
+
+	output, err := conn.GrantFlowEntitlements(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow Entitlement (%s): %s", name, err)
+	}
+
+	if output == nil || len(output.Entitlements) == 0 {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow Entitlement (%s): empty output", name)
+	}
+
+	d.SetId(flowResourceCreateResourceID(flowARN, aws.ToString(output.Entitlements[0].EntitlementArn)))
+
+	return append(diags, resourceFlowEntitlementRead(ctx, d, meta)...)
+}
+
+func resourceFlowEntitlementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, entitlementARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	entitlement, err := findFlowEntitlementByTwoPartKey(ctx, conn, flowARN, entitlementARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow Entitlement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow Entitlement (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, entitlement.EntitlementArn)
+	d.Set("data_transfer_subscriber_fee_percent", entitlement.DataTransferSubscriberFeePercent)
+	d.Set(names.AttrDescription, entitlement.Description)
+	if err := d.Set("encryption", flattenEncryption(entitlement.Encryption)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption: %s", err)
+	}
+	d.Set("entitlement_status", entitlement.EntitlementStatus)
+	d.Set("flow_arn", flowARN)
+	d.Set(names.AttrName, entitlement.Name)
+	d.Set("subscribers", entitlement.Subscribers)
+
+	return diags
+}
+
+func resourceFlowEntitlementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, entitlementARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &mediaconnect.UpdateFlowEntitlementInput{
+		EntitlementArn: aws.String(entitlementARN),
+		FlowArn:        aws.String(flowARN),
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
+	if d.HasChange("encryption") {
+		input.Encryption = expandUpdateEncryption(d.Get("encryption").([]interface{}))
+	}
+
+	if d.HasChange("entitlement_status") {
+		input.EntitlementStatus = awstypes.EntitlementStatus(d.Get("entitlement_status").(string))
+	}
+
+	if d.HasChange("subscribers") {
+		input.Subscribers = flex.ExpandStringValueSet(d.Get("subscribers").(*schema.Set))
+	}
+
+	_, err = conn.UpdateFlowEntitlement(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow Entitlement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlowEntitlementRead(ctx, d, meta)...)
+}
+
+func resourceFlowEntitlementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, entitlementARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow Entitlement: %s", d.Id())
+	_, err = conn.RevokeFlowEntitlement(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+		EntitlementArn: aws.String(entitlementARN),
+		FlowArn:        aws.String(flowARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow Entitlement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const flowResourceResourceIDPartCount = 2
+
+// flowResourceCreateResourceID builds the ID of a resource that is managed as part of a flow,
+// such as an entitlement, output or source, from the flow ARN and the resource's own ARN.
+func flowResourceCreateResourceID(flowARN, resourceARN string) string {
+	parts := []string{flowARN, resourceARN}
+
+	return errs.Must(flex.FlattenResourceId(parts, flowResourceResourceIDPartCount, false))
+}
+
+func flowResourceParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, flowResourceResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findFlowEntitlementByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, entitlementARN string) (*awstypes.Entitlement, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entitlements := tfslices.Filter(flow.Entitlements, func(v awstypes.Entitlement) bool {
+		return aws.ToString(v.EntitlementArn) == entitlementARN
+	})
+
+	return tfresource.AssertSingleValueResult(entitlements)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// There is no resource to manage MediaConnect flows, so the flow resource tests run against an existing flow.
+const envVarFlowARN = "MEDIACONNECT_FLOW_ARN"
+
+func TestAccMediaConnectFlowEntitlement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Entitlement
+	flowARN := acctest.SkipIfEnvVarNotSet(t, envVarFlowARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_entitlement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaConnect) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowEntitlementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowEntitlementConfig_basic(rName, flowARN, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowEntitlementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "entitlement_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "flow_arn", flowARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "subscribers.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowEntitlementConfig_basic(rName, flowARN, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowEntitlementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement_status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowEntitlement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Entitlement
+	flowARN := acctest.SkipIfEnvVarNotSet(t, envVarFlowARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_entitlement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaConnect) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowEntitlementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowEntitlementConfig_basic(rName, flowARN, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowEntitlementExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowEntitlement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowEntitlementExists(ctx context.Context, n string, v *awstypes.Entitlement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		flowARN, entitlementARN, err := tfmediaconnect.FlowResourceParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowEntitlementByTwoPartKey(ctx, conn, flowARN, entitlementARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowEntitlementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_entitlement" {
+				continue
+			}
+
+			flowARN, entitlementARN, err := tfmediaconnect.FlowResourceParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowEntitlementByTwoPartKey(ctx, conn, flowARN, entitlementARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Entitlement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowEntitlementConfig_basic(rName, flowARN, status string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow_entitlement" "test" {
+  flow_arn           = %[2]q
+  name               = %[1]q
+  entitlement_status = %[3]q
+  subscribers        = [data.aws_caller_identity.current.account_id]
+}
+`, rName, flowARN, status)
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow_output", name="Flow Output")
+func resourceFlowOutput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowOutputCreate,
+		ReadWithoutTimeout:   resourceFlowOutputRead,
+		UpdateWithoutTimeout: resourceFlowOutputUpdate,
+		DeleteWithoutTimeout: resourceFlowOutputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_allow_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrDestination: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encryption": encryptionSchema(),
+			"flow_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"listener_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_latency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_latency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			names.AttrProtocol: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+			},
+			"remote_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sender_control_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"smoothing_latency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceFlowOutputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN := d.Get("flow_arn").(string)
+	name := d.Get(names.AttrName).(string)
+	output := awstypes.AddOutputRequest{
+		Name:     aws.String(name),
+		Protocol: awstypes.Protocol(d.Get(names.AttrProtocol).(string)),
+	}
+
+	if v, ok := d.GetOk("cidr_allow_list"); ok && v.(*schema.Set).Len() > 0 {
+		output.CidrAllowList = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		output.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDestination); ok {
+		output.Destination = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption"); ok {
+		output.Encryption = expandEncryption(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("max_latency"); ok {
+		output.MaxLatency = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("min_latency"); ok {
+		output.MinLatency = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrPort); ok {
+		output.Port = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("remote_id"); ok {
+		output.RemoteId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sender_control_port"); ok {
+		output.SenderControlPort = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("smoothing_latency"); ok {
+		output.SmoothingLatency = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("stream_id"); ok {
+		output.StreamId = aws.String(v.(string))
+	}
+
+	input := &mediaconnect.AddFlowOutputsInput{
+		FlowArn: aws.String(flowARN),
+		Outputs: []awstypes.AddOutputRequest{output},
+	}
+
+	out, err := conn.AddFlowOutputs(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow Output (%s): %s", name, err)
+	}
+
+	if out == nil || len(out.Outputs) == 0 {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow Output (%s): empty output", name)
+	}
+
+	d.SetId(flowResourceCreateResourceID(flowARN, aws.ToString(out.Outputs[0].OutputArn)))
+
+	return append(diags, resourceFlowOutputRead(ctx, d, meta)...)
+}
+
+func resourceFlowOutputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, outputARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow Output (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow Output (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.OutputArn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrDestination, output.Destination)
+	if err := d.Set("encryption", flattenEncryption(output.Encryption)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption: %s", err)
+	}
+	d.Set("flow_arn", flowARN)
+	d.Set("listener_address", output.ListenerAddress)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrPort, output.Port)
+	if transport := output.Transport; transport != nil {
+		d.Set("cidr_allow_list", transport.CidrAllowList)
+		d.Set("max_latency", transport.MaxLatency)
+		d.Set("min_latency", transport.MinLatency)
+		d.Set(names.AttrProtocol, transport.Protocol)
+		d.Set("remote_id", transport.RemoteId)
+		d.Set("sender_control_port", transport.SenderControlPort)
+		d.Set("smoothing_latency", transport.SmoothingLatency)
+		d.Set("stream_id", transport.StreamId)
+	}
+
+	return diags
+}
+
+func resourceFlowOutputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, outputARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &mediaconnect.UpdateFlowOutputInput{
+		FlowArn:   aws.String(flowARN),
+		OutputArn: aws.String(outputARN),
+		Protocol:  awstypes.Protocol(d.Get(names.AttrProtocol).(string)),
+	}
+
+	if d.HasChange("cidr_allow_list") {
+		input.CidrAllowList = flex.ExpandStringValueSet(d.Get("cidr_allow_list").(*schema.Set))
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
+	if d.HasChange(names.AttrDestination) {
+		input.Destination = aws.String(d.Get(names.AttrDestination).(string))
+	}
+
+	if d.HasChange("encryption") {
+		input.Encryption = expandUpdateEncryption(d.Get("encryption").([]interface{}))
+	}
+
+	if d.HasChange("max_latency") {
+		input.MaxLatency = aws.Int32(int32(d.Get("max_latency").(int)))
+	}
+
+	if d.HasChange("min_latency") {
+		input.MinLatency = aws.Int32(int32(d.Get("min_latency").(int)))
+	}
+
+	if d.HasChange(names.AttrPort) {
+		input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
+	}
+
+	if d.HasChange("remote_id") {
+		input.RemoteId = aws.String(d.Get("remote_id").(string))
+	}
+
+	if d.HasChange("sender_control_port") {
+		input.SenderControlPort = aws.Int32(int32(d.Get("sender_control_port").(int)))
+	}
+
+	if d.HasChange("smoothing_latency") {
+		input.SmoothingLatency = aws.Int32(int32(d.Get("smoothing_latency").(int)))
+	}
+
+	if d.HasChange("stream_id") {
+		input.StreamId = aws.String(d.Get("stream_id").(string))
+	}
+
+	_, err = conn.UpdateFlowOutput(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow Output (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlowOutputRead(ctx, d, meta)...)
+}
+
+func resourceFlowOutputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, outputARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow Output: %s", d.Id())
+	_, err = conn.RemoveFlowOutput(ctx, &mediaconnect.RemoveFlowOutputInput{
+		FlowArn:   aws.String(flowARN),
+		OutputArn: aws.String(outputARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow Output (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findFlowOutputByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, outputARN string) (*awstypes.Output, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := tfslices.Filter(flow.Outputs, func(v awstypes.Output) bool {
+		return aws.ToString(v.OutputArn) == outputARN
+	})
+
+	return tfresource.AssertSingleValueResult(outputs)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectFlowOutput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Output
+	flowARN := acctest.SkipIfEnvVarNotSet(t, envVarFlowARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaConnect) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, flowARN, 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "198.51.100.10"),
+					resource.TestCheckResourceAttr(resourceName, "flow_arn", flowARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "5000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "rtp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowOutputConfig_basic(rName, flowARN, 5010),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "5010"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowOutput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Output
+	flowARN := acctest.SkipIfEnvVarNotSet(t, envVarFlowARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaConnect) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, flowARN, 5000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowOutput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowOutputExists(ctx context.Context, n string, v *awstypes.Output) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		flowARN, outputARN, err := tfmediaconnect.FlowResourceParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowOutputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_output" {
+				continue
+			}
+
+			flowARN, outputARN, err := tfmediaconnect.FlowResourceParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Output %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowOutputConfig_basic(rName, flowARN string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow_output" "test" {
+  flow_arn    = %[2]q
+  name        = %[1]q
+  protocol    = "rtp"
+  destination = "198.51.100.10"
+  port        = %[3]d
+}
+`, rName, flowARN, port)
+}
@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow_source", name="Flow Source")
+func resourceFlowSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowSourceCreate,
+		ReadWithoutTimeout:   resourceFlowSourceRead,
+		UpdateWithoutTimeout: resourceFlowSourceUpdate,
+		DeleteWithoutTimeout: resourceFlowSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"decryption": encryptionSchema(),
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"entitlement_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"flow_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ingest_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingest_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"max_bitrate": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"max_latency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"max_sync_buffer": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_latency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrProtocol: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+			},
+			"sender_control_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"sender_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"source_listener_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_listener_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"whitelist_cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceFlowSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN := d.Get("flow_arn").(string)
+	name := d.Get(names.AttrName).(string)
+	source := awstypes.SetSourceRequest{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("decryption"); ok {
+		source.Decryption = expandEncryption(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		source.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement_arn"); ok {
+		source.EntitlementArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ingest_port"); ok {
+		source.IngestPort = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_bitrate"); ok {
+		source.MaxBitrate = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_latency"); ok {
+		source.MaxLatency = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_sync_buffer"); ok {
+		source.MaxSyncBuffer = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("min_latency"); ok {
+		source.MinLatency = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrProtocol); ok {
+		source.Protocol = awstypes.Protocol(v.(string))
+	}
+
+	if v, ok := d.GetOk("sender_control_port"); ok {
+		source.SenderControlPort = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("sender_ip_address"); ok {
+		source.SenderIpAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_listener_address"); ok {
+		source.SourceListenerAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_listener_port"); ok {
+		source.SourceListenerPort = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("stream_id"); ok {
+		source.StreamId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("whitelist_cidr"); ok {
+		source.WhitelistCidr = aws.String(v.(string))
+	}
+
+	input := &mediaconnect.AddFlowSourcesInput{
+		FlowArn: aws.String(flowARN),
+		Sources: []awstypes.SetSourceRequest{source},
+	}
+
+	output, err := conn.AddFlowSources(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow Source (%s): %s", name, err)
+	}
+
+	if output == nil || len(output.Sources) == 0 {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow Source (%s): empty output", name)
+	}
+
+	d.SetId(flowResourceCreateResourceID(flowARN, aws.ToString(output.Sources[0].SourceArn)))
+
+	return append(diags, resourceFlowSourceRead(ctx, d, meta)...)
+}
+
+func resourceFlowSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, sourceARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	source, err := findFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, source.SourceArn)
+	if err := d.Set("decryption", flattenEncryption(source.Decryption)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting decryption: %s", err)
+	}
+	d.Set(names.AttrDescription, source.Description)
+	d.Set("entitlement_arn", source.EntitlementArn)
+	d.Set("flow_arn", flowARN)
+	d.Set("ingest_ip", source.IngestIp)
+	d.Set("ingest_port", source.IngestPort)
+	d.Set(names.AttrName, source.Name)
+	d.Set("sender_control_port", source.SenderControlPort)
+	d.Set("sender_ip_address", source.SenderIpAddress)
+	d.Set("whitelist_cidr", source.WhitelistCidr)
+	if transport := source.Transport; transport != nil {
+		d.Set("max_bitrate", transport.MaxBitrate)
+		d.Set("max_latency", transport.MaxLatency)
+		d.Set("max_sync_buffer", transport.MaxSyncBuffer)
+		d.Set("min_latency", transport.MinLatency)
+		d.Set(names.AttrProtocol, transport.Protocol)
+		d.Set("source_listener_address", transport.SourceListenerAddress)
+		d.Set("source_listener_port", transport.SourceListenerPort)
+		d.Set("stream_id", transport.StreamId)
+	}
+
+	return diags
+}
+
+func resourceFlowSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, sourceARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &mediaconnect.UpdateFlowSourceInput{
+		FlowArn:   aws.String(flowARN),
+		SourceArn: aws.String(sourceARN),
+	}
+
+	if d.HasChange("decryption") {
+		input.Decryption = expandUpdateEncryption(d.Get("decryption").([]interface{}))
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
+	if d.HasChange("entitlement_arn") {
+		input.EntitlementArn = aws.String(d.Get("entitlement_arn").(string))
+	}
+
+	if d.HasChange("ingest_port") {
+		input.IngestPort = aws.Int32(int32(d.Get("ingest_port").(int)))
+	}
+
+	if d.HasChange("max_bitrate") {
+		input.MaxBitrate = aws.Int32(int32(d.Get("max_bitrate").(int)))
+	}
+
+	if d.HasChange("max_latency") {
+		input.MaxLatency = aws.Int32(int32(d.Get("max_latency").(int)))
+	}
+
+	if d.HasChange("max_sync_buffer") {
+		input.MaxSyncBuffer = aws.Int32(int32(d.Get("max_sync_buffer").(int)))
+	}
+
+	if d.HasChange("min_latency") {
+		input.MinLatency = aws.Int32(int32(d.Get("min_latency").(int)))
+	}
+
+	if d.HasChange(names.AttrProtocol) {
+		input.Protocol = awstypes.Protocol(d.Get(names.AttrProtocol).(string))
+	}
+
+	if d.HasChange("sender_control_port") {
+		input.SenderControlPort = aws.Int32(int32(d.Get("sender_control_port").(int)))
+	}
+
+	if d.HasChange("sender_ip_address") {
+		input.SenderIpAddress = aws.String(d.Get("sender_ip_address").(string))
+	}
+
+	if d.HasChange("source_listener_address") {
+		input.SourceListenerAddress = aws.String(d.Get("source_listener_address").(string))
+	}
+
+	if d.HasChange("source_listener_port") {
+		input.SourceListenerPort = aws.Int32(int32(d.Get("source_listener_port").(int)))
+	}
+
+	if d.HasChange("stream_id") {
+		input.StreamId = aws.String(d.Get("stream_id").(string))
+	}
+
+	if d.HasChange("whitelist_cidr") {
+		input.WhitelistCidr = aws.String(d.Get("whitelist_cidr").(string))
+	}
+
+	_, err = conn.UpdateFlowSource(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlowSourceRead(ctx, d, meta)...)
+}
+
+func resourceFlowSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flowARN, sourceARN, err := flowResourceParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow Source: %s", d.Id())
+	_, err = conn.RemoveFlowSource(ctx, &mediaconnect.RemoveFlowSourceInput{
+		FlowArn:   aws.String(flowARN),
+		SourceArn: aws.String(sourceARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findFlowSourceByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, sourceARN string) (*awstypes.Source, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A flow with a single source reports it only in Source; with failover enabled all sources are in Sources.
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []awstypes.Source{*flow.Source}
+	}
+
+	sources = tfslices.Filter(sources, func(v awstypes.Source) bool {
+		return aws.ToString(v.SourceArn) == sourceARN
+	})
+
+	return tfresource.AssertSingleValueResult(sources)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The flow must have source failover enabled for a second source to be added.
+
+func TestAccMediaConnectFlowSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Source
+	flowARN := acctest.SkipIfEnvVarNotSet(t, envVarFlowARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaConnect) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, flowARN, "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "flow_arn", flowARN),
+					resource.TestCheckResourceAttrSet(resourceName, "ingest_ip"),
+					resource.TestCheckResourceAttr(resourceName, "ingest_port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "rtp"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidr", "10.0.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowSourceConfig_basic(rName, flowARN, "10.1.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidr", "10.1.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Source
+	flowARN := acctest.SkipIfEnvVarNotSet(t, envVarFlowARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaConnect) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, flowARN, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowSourceExists(ctx context.Context, n string, v *awstypes.Source) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		flowARN, sourceARN, err := tfmediaconnect.FlowResourceParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_source" {
+				continue
+			}
+
+			flowARN, sourceARN, err := tfmediaconnect.FlowResourceParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowSourceConfig_basic(rName, flowARN, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow_source" "test" {
+  flow_arn       = %[2]q
+  name           = %[1]q
+  protocol       = "rtp"
+  ingest_port    = 5000
+  whitelist_cidr = %[3]q
+}
+`, rName, flowARN, cidr)
+}
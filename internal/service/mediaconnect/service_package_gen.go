@@ -28,7 +28,33 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBridge,
+			TypeName: "aws_mediaconnect_bridge",
+			Name:     "Bridge",
+		},
+		{
+			Factory:  resourceFlowEntitlement,
+			TypeName: "aws_mediaconnect_flow_entitlement",
+			Name:     "Flow Entitlement",
+		},
+		{
+			Factory:  resourceFlowOutput,
+			TypeName: "aws_mediaconnect_flow_output",
+			Name:     "Flow Output",
+		},
+		{
+			Factory:  resourceFlowSource,
+			TypeName: "aws_mediaconnect_flow_source",
+			Name:     "Flow Source",
+		},
+		{
+			Factory:  resourceGateway,
+			TypeName: "aws_mediaconnect_gateway",
+			Name:     "Gateway",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -918,13 +918,9 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.Get("start_channel").(bool) {
-		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
-			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Get(names.AttrName).(string), err)
-		}
-	}
-
-	if d.HasChange("start_channel") {
+	// The channel may have been stopped to apply the update above, so reconcile its
+	// state with start_channel whenever the channel should be running.
+	if d.Get("start_channel").(bool) || d.HasChange("start_channel") {
 		channel, err := FindChannelByID(ctx, conn, d.Id())
 
 		if err != nil {
@@ -0,0 +1,724 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_medialive_channel_schedule", name="Channel Schedule")
+func ResourceChannelSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelScheduleCreate,
+		ReadWithoutTimeout:   resourceChannelScheduleRead,
+		UpdateWithoutTimeout: resourceChannelScheduleUpdate,
+		DeleteWithoutTimeout: resourceChannelScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schedule_action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"schedule_action_settings": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_switch_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_attachment_name_reference": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"input_clipping_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"input_timecode_source": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.InputTimecodeSource](),
+															},
+															"start_timecode": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"timecode": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+															"stop_timecode": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"last_frame_clipping_behavior": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ValidateDiagFunc: enum.Validate[types.LastFrameClippingBehavior](),
+																		},
+																		"timecode": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"url_path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"static_image_activate_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"fade_in": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"fade_out": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"height": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"image": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"password_param": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															names.AttrURI: {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															names.AttrUsername: {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"image_x": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"image_y": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"layer": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 7),
+												},
+												"opacity": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 100),
+												},
+												"width": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"static_image_deactivate_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"fade_out": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"layer": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 7),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"schedule_action_start_settings": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fixed_mode_schedule_action_start_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"time": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"follow_mode_schedule_action_start_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"follow_point": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.FollowPoint](),
+												},
+												"reference_action_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"immediate_mode_schedule_action_start_settings": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameChannelSchedule = "Channel Schedule"
+)
+
+func resourceChannelScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	channelID := d.Get("channel_id").(string)
+	in := &medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(channelID),
+		Creates: &types.BatchScheduleActionCreateRequest{
+			ScheduleActions: expandScheduleActions(d.Get("schedule_action").(*schema.Set).List()),
+		},
+	}
+
+	_, err := conn.BatchUpdateSchedule(ctx, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameChannelSchedule, channelID, err)
+	}
+
+	d.SetId(channelID)
+
+	return append(diags, resourceChannelScheduleRead(ctx, d, meta)...)
+}
+
+func resourceChannelScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	actions, err := FindChannelScheduleActionsByChannelID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionReading, ResNameChannelSchedule, d.Id(), err)
+	}
+
+	d.Set("channel_id", d.Id())
+	if err := d.Set("schedule_action", flattenScheduleActions(actions, d.Get("schedule_action").(*schema.Set).List())); err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannelSchedule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceChannelScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	if d.HasChange("schedule_action") {
+		o, n := d.GetChange("schedule_action")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Schedule actions can't be modified, so changed actions are deleted and re-created.
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			_, err := conn.BatchUpdateSchedule(ctx, &medialive.BatchUpdateScheduleInput{
+				ChannelId: aws.String(d.Id()),
+				Deletes: &types.BatchScheduleActionDeleteRequest{
+					ActionNames: scheduleActionNames(del),
+				},
+			})
+
+			if err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannelSchedule, d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			_, err := conn.BatchUpdateSchedule(ctx, &medialive.BatchUpdateScheduleInput{
+				ChannelId: aws.String(d.Id()),
+				Creates: &types.BatchScheduleActionCreateRequest{
+					ScheduleActions: expandScheduleActions(add),
+				},
+			})
+
+			if err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannelSchedule, d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceChannelScheduleRead(ctx, d, meta)...)
+}
+
+func resourceChannelScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	actionNames := scheduleActionNames(d.Get("schedule_action").(*schema.Set).List())
+
+	if len(actionNames) == 0 {
+		return diags
+	}
+
+	log.Printf("[INFO] Deleting MediaLive Channel Schedule %s", d.Id())
+	_, err := conn.BatchUpdateSchedule(ctx, &medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(d.Id()),
+		Deletes: &types.BatchScheduleActionDeleteRequest{
+			ActionNames: actionNames,
+		},
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameChannelSchedule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindChannelScheduleActionsByChannelID(ctx context.Context, conn *medialive.Client, id string) ([]types.ScheduleAction, error) {
+	in := &medialive.DescribeScheduleInput{
+		ChannelId: aws.String(id),
+	}
+	var out []types.ScheduleAction
+
+	pages := medialive.NewDescribeSchedulePaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.ScheduleActions...)
+	}
+
+	return out, nil
+}
+
+func scheduleActionNames(tfList []interface{}) []string {
+	var actionNames []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		actionNames = append(actionNames, tfMap["action_name"].(string))
+	}
+
+	return actionNames
+}
+
+func expandScheduleActions(tfList []interface{}) []types.ScheduleAction {
+	var apiObjects []types.ScheduleAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.ScheduleAction{
+			ActionName:                  aws.String(tfMap["action_name"].(string)),
+			ScheduleActionSettings:      expandScheduleActionSettings(tfMap["schedule_action_settings"].([]interface{})),
+			ScheduleActionStartSettings: expandScheduleActionStartSettings(tfMap["schedule_action_start_settings"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandScheduleActionStartSettings(tfList []interface{}) *types.ScheduleActionStartSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.ScheduleActionStartSettings{}
+
+	if v, ok := tfMap["fixed_mode_schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.FixedModeScheduleActionStartSettings = &types.FixedModeScheduleActionStartSettings{
+			Time: aws.String(tfMap["time"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["follow_mode_schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.FollowModeScheduleActionStartSettings = &types.FollowModeScheduleActionStartSettings{
+			FollowPoint:         types.FollowPoint(tfMap["follow_point"].(string)),
+			ReferenceActionName: aws.String(tfMap["reference_action_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["immediate_mode_schedule_action_start_settings"].(bool); ok && v {
+		apiObject.ImmediateModeScheduleActionStartSettings = &types.ImmediateModeScheduleActionStartSettings{}
+	}
+
+	return apiObject
+}
+
+func expandScheduleActionSettings(tfList []interface{}) *types.ScheduleActionSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.ScheduleActionSettings{}
+
+	if v, ok := tfMap["input_switch_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.InputSwitchSettings = &types.InputSwitchScheduleActionSettings{
+			InputAttachmentNameReference: aws.String(tfMap["input_attachment_name_reference"].(string)),
+		}
+
+		if v, ok := tfMap["input_clipping_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			clipping := &types.InputClippingSettings{
+				InputTimecodeSource: types.InputTimecodeSource(tfMap["input_timecode_source"].(string)),
+			}
+
+			if v, ok := tfMap["start_timecode"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				clipping.StartTimecode = &types.StartTimecode{}
+
+				if v, ok := tfMap["timecode"].(string); ok && v != "" {
+					clipping.StartTimecode.Timecode = aws.String(v)
+				}
+			}
+
+			if v, ok := tfMap["stop_timecode"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				clipping.StopTimecode = &types.StopTimecode{}
+
+				if v, ok := tfMap["last_frame_clipping_behavior"].(string); ok && v != "" {
+					clipping.StopTimecode.LastFrameClippingBehavior = types.LastFrameClippingBehavior(v)
+				}
+				if v, ok := tfMap["timecode"].(string); ok && v != "" {
+					clipping.StopTimecode.Timecode = aws.String(v)
+				}
+			}
+
+			apiObject.InputSwitchSettings.InputClippingSettings = clipping
+		}
+
+		if v, ok := tfMap["url_path"].([]interface{}); ok && len(v) > 0 {
+			apiObject.InputSwitchSettings.UrlPath = flex.ExpandStringValueList(v)
+		}
+	}
+
+	if v, ok := tfMap["static_image_activate_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		settings := &types.StaticImageActivateScheduleActionSettings{}
+
+		if v, ok := tfMap["image"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			settings.Image = &types.InputLocation{
+				Uri: aws.String(tfMap[names.AttrURI].(string)),
+			}
+
+			if v, ok := tfMap["password_param"].(string); ok && v != "" {
+				settings.Image.PasswordParam = aws.String(v)
+			}
+			if v, ok := tfMap[names.AttrUsername].(string); ok && v != "" {
+				settings.Image.Username = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["duration"].(int); ok && v != 0 {
+			settings.Duration = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["fade_in"].(int); ok && v != 0 {
+			settings.FadeIn = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["fade_out"].(int); ok && v != 0 {
+			settings.FadeOut = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["height"].(int); ok && v != 0 {
+			settings.Height = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["image_x"].(int); ok && v != 0 {
+			settings.ImageX = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["image_y"].(int); ok && v != 0 {
+			settings.ImageY = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["layer"].(int); ok && v != 0 {
+			settings.Layer = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["opacity"].(int); ok && v != 0 {
+			settings.Opacity = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["width"].(int); ok && v != 0 {
+			settings.Width = aws.Int32(int32(v))
+		}
+
+		apiObject.StaticImageActivateSettings = settings
+	}
+
+	if v, ok := tfMap["static_image_deactivate_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		settings := &types.StaticImageDeactivateScheduleActionSettings{}
+
+		if v, ok := tfMap["fade_out"].(int); ok && v != 0 {
+			settings.FadeOut = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["layer"].(int); ok && v != 0 {
+			settings.Layer = aws.Int32(int32(v))
+		}
+
+		apiObject.StaticImageDeactivateSettings = settings
+	}
+
+	return apiObject
+}
+
+// flattenScheduleActions flattens the channel's schedule. Schedule actions are immutable and
+// MediaLive rewrites the start settings of actions once they have run, so actions that are
+// already in state are kept as configured and only their presence in the schedule is refreshed.
+func flattenScheduleActions(apiObjects []types.ScheduleAction, old []interface{}) []interface{} {
+	configured := make(map[string]interface{})
+
+	for _, tfMapRaw := range old {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configured[tfMap["action_name"].(string)] = tfMap
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		actionName := aws.ToString(apiObject.ActionName)
+
+		if v, ok := configured[actionName]; ok {
+			tfList = append(tfList, v)
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action_name":                    actionName,
+			"schedule_action_settings":       flattenScheduleActionSettings(apiObject.ScheduleActionSettings),
+			"schedule_action_start_settings": flattenScheduleActionStartSettings(apiObject.ScheduleActionStartSettings),
+		})
+	}
+
+	return tfList
+}
+
+func flattenScheduleActionStartSettings(apiObject *types.ScheduleActionStartSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"immediate_mode_schedule_action_start_settings": apiObject.ImmediateModeScheduleActionStartSettings != nil,
+	}
+
+	if v := apiObject.FixedModeScheduleActionStartSettings; v != nil {
+		tfMap["fixed_mode_schedule_action_start_settings"] = []interface{}{map[string]interface{}{
+			"time": aws.ToString(v.Time),
+		}}
+	}
+
+	if v := apiObject.FollowModeScheduleActionStartSettings; v != nil {
+		tfMap["follow_mode_schedule_action_start_settings"] = []interface{}{map[string]interface{}{
+			"follow_point":          string(v.FollowPoint),
+			"reference_action_name": aws.ToString(v.ReferenceActionName),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScheduleActionSettings(apiObject *types.ScheduleActionSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InputSwitchSettings; v != nil {
+		settings := map[string]interface{}{
+			"input_attachment_name_reference": aws.ToString(v.InputAttachmentNameReference),
+			"url_path":                        v.UrlPath,
+		}
+
+		if v := v.InputClippingSettings; v != nil {
+			clipping := map[string]interface{}{
+				"input_timecode_source": string(v.InputTimecodeSource),
+			}
+
+			if v := v.StartTimecode; v != nil {
+				clipping["start_timecode"] = []interface{}{map[string]interface{}{
+					"timecode": aws.ToString(v.Timecode),
+				}}
+			}
+
+			if v := v.StopTimecode; v != nil {
+				clipping["stop_timecode"] = []interface{}{map[string]interface{}{
+					"last_frame_clipping_behavior": string(v.LastFrameClippingBehavior),
+					"timecode":                     aws.ToString(v.Timecode),
+				}}
+			}
+
+			settings["input_clipping_settings"] = []interface{}{clipping}
+		}
+
+		tfMap["input_switch_settings"] = []interface{}{settings}
+	}
+
+	if v := apiObject.StaticImageActivateSettings; v != nil {
+		settings := map[string]interface{}{
+			"duration": aws.ToInt32(v.Duration),
+			"fade_in":  aws.ToInt32(v.FadeIn),
+			"fade_out": aws.ToInt32(v.FadeOut),
+			"height":   aws.ToInt32(v.Height),
+			"image_x":  aws.ToInt32(v.ImageX),
+			"image_y":  aws.ToInt32(v.ImageY),
+			"layer":    aws.ToInt32(v.Layer),
+			"opacity":  aws.ToInt32(v.Opacity),
+			"width":    aws.ToInt32(v.Width),
+		}
+
+		if v := v.Image; v != nil {
+			settings["image"] = []interface{}{map[string]interface{}{
+				"password_param":   aws.ToString(v.PasswordParam),
+				names.AttrURI:      aws.ToString(v.Uri),
+				names.AttrUsername: aws.ToString(v.Username),
+			}}
+		}
+
+		tfMap["static_image_activate_settings"] = []interface{}{settings}
+	}
+
+	if v := apiObject.StaticImageDeactivateSettings; v != nil {
+		tfMap["static_image_deactivate_settings"] = []interface{}{map[string]interface{}{
+			"fade_out": aws.ToInt32(v.FadeOut),
+			"layer":    aws.ToInt32(v.Layer),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
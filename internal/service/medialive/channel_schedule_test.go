@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveChannelSchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule.test"
+	startTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "aws_medialive_channel.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "schedule_action.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule_action.*", map[string]string{
+						"action_name": "input-switch",
+						"schedule_action_settings.0.input_switch_settings.0.input_attachment_name_reference": "example-input1",
+						"schedule_action_start_settings.0.fixed_mode_schedule_action_start_settings.0.time":  startTime,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannelSchedule_staticImage(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule.test"
+	startTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(25 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_action.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccChannelScheduleConfig_staticImage(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_action.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule_action.*", map[string]string{
+						"action_name": "overlay-on",
+						"schedule_action_settings.0.static_image_activate_settings.0.layer":   acctest.Ct1,
+						"schedule_action_settings.0.static_image_activate_settings.0.opacity": "50",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule_action.*", map[string]string{
+						"action_name": "overlay-off",
+						"schedule_action_settings.0.static_image_deactivate_settings.0.layer":               acctest.Ct1,
+						"schedule_action_start_settings.0.fixed_mode_schedule_action_start_settings.0.time": endTime,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannelSchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule.test"
+	startTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmedialive.ResourceChannelSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChannelScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_channel_schedule" {
+				continue
+			}
+
+			actions, err := tfmedialive.FindChannelScheduleActionsByChannelID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, err)
+			}
+
+			if len(actions) > 0 {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelScheduleExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		actions, err := tfmedialive.FindChannelScheduleActionsByChannelID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, err)
+		}
+
+		if len(actions) == 0 {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, errors.New("empty schedule"))
+		}
+
+		return nil
+	}
+}
+
+func testAccChannelScheduleConfig_basic(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_medialive_channel_schedule" "test" {
+  channel_id = aws_medialive_channel.test.id
+
+  schedule_action {
+    action_name = "input-switch"
+
+    schedule_action_start_settings {
+      fixed_mode_schedule_action_start_settings {
+        time = %[1]q
+      }
+    }
+
+    schedule_action_settings {
+      input_switch_settings {
+        input_attachment_name_reference = "example-input1"
+      }
+    }
+  }
+}
+`, startTime))
+}
+
+func testAccChannelScheduleConfig_staticImage(rName, startTime, endTime string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_medialive_channel_schedule" "test" {
+  channel_id = aws_medialive_channel.test.id
+
+  schedule_action {
+    action_name = "input-switch"
+
+    schedule_action_start_settings {
+      fixed_mode_schedule_action_start_settings {
+        time = %[1]q
+      }
+    }
+
+    schedule_action_settings {
+      input_switch_settings {
+        input_attachment_name_reference = "example-input1"
+      }
+    }
+  }
+
+  schedule_action {
+    action_name = "overlay-on"
+
+    schedule_action_start_settings {
+      follow_mode_schedule_action_start_settings {
+        follow_point          = "END"
+        reference_action_name = "input-switch"
+      }
+    }
+
+    schedule_action_settings {
+      static_image_activate_settings {
+        duration = 10000
+        layer    = 1
+        opacity  = 50
+
+        image {
+          uri = "s3ssl://${aws_s3_bucket.test1.id}/overlay.png"
+        }
+      }
+    }
+  }
+
+  schedule_action {
+    action_name = "overlay-off"
+
+    schedule_action_start_settings {
+      fixed_mode_schedule_action_start_settings {
+        time = %[2]q
+      }
+    }
+
+    schedule_action_settings {
+      static_image_deactivate_settings {
+        layer = 1
+      }
+    }
+  }
+}
+`, startTime, endTime))
+}
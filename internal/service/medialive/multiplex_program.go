@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -227,10 +228,10 @@ func (m *multiplexProgram) Read(ctx context.Context, req resource.ReadRequest, r
 	programName, multiplexId, err := ParseMultiplexProgramID(state.ID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.MediaLive, create.ErrActionReading, ResNameMultiplexProgram, state.ProgramName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -247,10 +248,10 @@ func (m *multiplexProgram) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.MediaLive, create.ErrActionReading, ResNameMultiplexProgram, state.ProgramName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -277,10 +278,10 @@ func (m *multiplexProgram) Update(ctx context.Context, req resource.UpdateReques
 	programName, multiplexId, err := ParseMultiplexProgramID(plan.ID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.MediaLive, create.ErrActionReading, ResNameMultiplexProgram, plan.ProgramName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -342,10 +343,10 @@ func (m *multiplexProgram) Delete(ctx context.Context, req resource.DeleteReques
 	programName, multiplexId, err := ParseMultiplexProgramID(state.ID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.MediaLive, create.ErrActionDeleting, ResNameMultiplexProgram, state.ProgramName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -359,10 +360,10 @@ func (m *multiplexProgram) Delete(ctx context.Context, req resource.DeleteReques
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.MediaLive, create.ErrActionDeleting, ResNameMultiplexProgram, state.ProgramName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The channel group and channel must already exist as there is no resource to manage them.
+const (
+	envVarChannelGroupName   = "MEDIAPACKAGEV2_CHANNEL_GROUP_NAME"
+	envVarChannelName        = "MEDIAPACKAGEV2_CHANNEL_NAME"
+	envVarOriginEndpointName = "MEDIAPACKAGEV2_ORIGIN_ENDPOINT_NAME"
+)
+
+func TestAccMediaPackageV2ChannelPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	channelGroupName := acctest.SkipIfEnvVarNotSet(t, envVarChannelGroupName)
+	channelName := acctest.SkipIfEnvVarNotSet(t, envVarChannelName)
+	resourceName := "aws_mediapackagev2_channel_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelPolicyConfig_basic(channelGroupName, channelName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "channel_group_name", channelGroupName),
+					resource.TestCheckResourceAttr(resourceName, "channel_name", channelName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2ChannelPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	channelGroupName := acctest.SkipIfEnvVarNotSet(t, envVarChannelGroupName)
+	channelName := acctest.SkipIfEnvVarNotSet(t, envVarChannelName)
+	resourceName := "aws_mediapackagev2_channel_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelPolicyConfig_basic(channelGroupName, channelName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceChannelPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChannelPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		channelGroupName, channelName, err := tfmediapackagev2.ChannelPolicyParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		_, err = tfmediapackagev2.FindChannelPolicyByTwoPartKey(ctx, conn, channelGroupName, channelName)
+
+		return err
+	}
+}
+
+func testAccCheckChannelPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_channel_policy" {
+				continue
+			}
+
+			channelGroupName, channelName, err := tfmediapackagev2.ChannelPolicyParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediapackagev2.FindChannelPolicyByTwoPartKey(ctx, conn, channelGroupName, channelName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Channel Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccChannelPolicyConfig_basic(channelGroupName, channelName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_mediapackagev2_channel_policy" "test" {
+  channel_group_name = %[1]q
+  channel_name       = %[2]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowIngest"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+      Action    = "mediapackagev2:PutObject"
+      Resource  = "arn:${data.aws_partition.current.partition}:mediapackagev2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:channelGroup/%[1]s/channel/%[2]s"
+    }]
+  })
+}
+`, channelGroupName, channelName)
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+// Exports for use in tests only.
+var (
+	ResourceChannelPolicy        = resourceChannelPolicy
+	ResourceOriginEndpointPolicy = resourceOriginEndpointPolicy
+
+	ChannelPolicyParseResourceID           = channelPolicyParseResourceID
+	FindChannelPolicyByTwoPartKey          = findChannelPolicyByTwoPartKey
+	FindOriginEndpointPolicyByThreePartKey = findOriginEndpointPolicyByThreePartKey
+	OriginEndpointPolicyParseResourceID    = originEndpointPolicyParseResourceID
+)
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2OriginEndpointPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	channelGroupName := acctest.SkipIfEnvVarNotSet(t, envVarChannelGroupName)
+	channelName := acctest.SkipIfEnvVarNotSet(t, envVarChannelName)
+	originEndpointName := acctest.SkipIfEnvVarNotSet(t, envVarOriginEndpointName)
+	resourceName := "aws_mediapackagev2_origin_endpoint_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointPolicyConfig_basic(channelGroupName, channelName, originEndpointName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "channel_group_name", channelGroupName),
+					resource.TestCheckResourceAttr(resourceName, "channel_name", channelName),
+					resource.TestCheckResourceAttr(resourceName, "origin_endpoint_name", originEndpointName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpointPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	channelGroupName := acctest.SkipIfEnvVarNotSet(t, envVarChannelGroupName)
+	channelName := acctest.SkipIfEnvVarNotSet(t, envVarChannelName)
+	originEndpointName := acctest.SkipIfEnvVarNotSet(t, envVarOriginEndpointName)
+	resourceName := "aws_mediapackagev2_origin_endpoint_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointPolicyConfig_basic(channelGroupName, channelName, originEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpointPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		channelGroupName, channelName, originEndpointName, err := tfmediapackagev2.OriginEndpointPolicyParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		_, err = tfmediapackagev2.FindOriginEndpointPolicyByThreePartKey(ctx, conn, channelGroupName, channelName, originEndpointName)
+
+		return err
+	}
+}
+
+func testAccCheckOriginEndpointPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_origin_endpoint_policy" {
+				continue
+			}
+
+			channelGroupName, channelName, originEndpointName, err := tfmediapackagev2.OriginEndpointPolicyParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediapackagev2.FindOriginEndpointPolicyByThreePartKey(ctx, conn, channelGroupName, channelName, originEndpointName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Origin Endpoint Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointPolicyConfig_basic(channelGroupName, channelName, originEndpointName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_mediapackagev2_origin_endpoint_policy" "test" {
+  channel_group_name   = %[1]q
+  channel_name         = %[2]q
+  origin_endpoint_name = %[3]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowGet"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+      Action    = "mediapackagev2:GetObject"
+      Resource  = "arn:${data.aws_partition.current.partition}:mediapackagev2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:channelGroup/%[1]s/channel/%[2]s/originEndpoint/%[3]s"
+    }]
+  })
+}
+`, channelGroupName, channelName, originEndpointName)
+}
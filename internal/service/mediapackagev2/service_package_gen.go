@@ -28,7 +28,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceChannelPolicy,
+			TypeName: "aws_mediapackagev2_channel_policy",
+			Name:     "Channel Policy",
+		},
+		{
+			Factory:  resourceOriginEndpointPolicy,
+			TypeName: "aws_mediapackagev2_origin_endpoint_policy",
+			Name:     "Origin Endpoint Policy",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
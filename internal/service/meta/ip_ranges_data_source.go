@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -101,7 +102,7 @@ func (d *dataSourceIPRanges) Read(ctx context.Context, request datasource.ReadRe
 	bytes, err := readAll(ctx, url)
 
 	if err != nil {
-		response.Diagnostics.AddError("downloading IP ranges", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("downloading IP ranges", err))
 
 		return
 	}
@@ -109,7 +110,7 @@ func (d *dataSourceIPRanges) Read(ctx context.Context, request datasource.ReadRe
 	ipRanges := new(ipRanges)
 
 	if err := json.Unmarshal(bytes, ipRanges); err != nil {
-		response.Diagnostics.AddError("parsing JSON", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing JSON", err))
 
 		return
 	}
@@ -117,7 +118,7 @@ func (d *dataSourceIPRanges) Read(ctx context.Context, request datasource.ReadRe
 	syncToken, err := strconv.Atoi(ipRanges.SyncToken)
 
 	if err != nil {
-		response.Diagnostics.AddError("parsing SyncToken", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing SyncToken", err))
 
 		return
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -74,7 +75,7 @@ func (d *dataSourceRegion) Read(ctx context.Context, request datasource.ReadRequ
 		matchingRegion, err := FindRegionByEndpoint(data.Endpoint.ValueString())
 
 		if err != nil {
-			response.Diagnostics.AddError("finding Region by endpoint", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding Region by endpoint", err))
 
 			return
 		}
@@ -86,7 +87,7 @@ func (d *dataSourceRegion) Read(ctx context.Context, request datasource.ReadRequ
 		matchingRegion, err := FindRegionByName(data.Name.ValueString())
 
 		if err != nil {
-			response.Diagnostics.AddError("finding Region by name", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding Region by name", err))
 
 			return
 		}
@@ -105,7 +106,7 @@ func (d *dataSourceRegion) Read(ctx context.Context, request datasource.ReadRequ
 		matchingRegion, err := FindRegionByName(d.Meta().Region)
 
 		if err != nil {
-			response.Diagnostics.AddError("finding Region by name", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("finding Region by name", err))
 
 			return
 		}
@@ -116,7 +117,7 @@ func (d *dataSourceRegion) Read(ctx context.Context, request datasource.ReadRequ
 	regionEndpointEC2, err := region.ResolveEndpoint(ec2.EndpointsID)
 
 	if err != nil {
-		response.Diagnostics.AddError("resolving EC2 endpoint", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("resolving EC2 endpoint", err))
 
 		return
 	}
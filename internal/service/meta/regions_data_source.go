@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -77,7 +78,7 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 	output, err := conn.DescribeRegions(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("reading Regions", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading Regions", err))
 
 		return
 	}
@@ -38,6 +38,18 @@ import (
 	"github.com/mitchellh/copystructure"
 )
 
+const (
+	dataReplicationRolePrimary = "PRIMARY"
+	dataReplicationRoleReplica = "REPLICA"
+)
+
+func dataReplicationRole_Values() []string {
+	return []string{
+		dataReplicationRolePrimary,
+		dataReplicationRoleReplica,
+	}
+}
+
 // @SDKResource("aws_mq_broker", name="Broker")
 // @Tags(identifierAttribute="arn")
 func resourceBroker() *schema.Resource {
@@ -104,6 +116,22 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"data_replication_counterpart": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"broker_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"data_replication_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -126,6 +154,17 @@ func resourceBroker() *schema.Resource {
 				ForceNew:     true, // Can only be set on Create
 				ValidateFunc: verify.ValidARN,
 			},
+			"data_replication_promote_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.PromoteMode](),
+			},
+			"data_replication_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dataReplicationRole_Values(), false),
+			},
 			"deployment_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -483,7 +522,15 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("authentication_strategy", output.AuthenticationStrategy)
 	d.Set(names.AttrAutoMinorVersionUpgrade, output.AutoMinorVersionUpgrade)
 	d.Set("broker_name", output.BrokerName)
+	if err := d.Set("data_replication_counterpart", flattenDataReplicationCounterpart(output.DataReplicationMetadata)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_replication_counterpart: %s", err)
+	}
 	d.Set("data_replication_mode", output.DataReplicationMode)
+	if output.DataReplicationMetadata != nil {
+		d.Set("data_replication_role", output.DataReplicationMetadata.DataReplicationRole)
+	} else {
+		d.Set("data_replication_role", nil)
+	}
 	d.Set("deployment_mode", output.DeploymentMode)
 	d.Set("engine_type", output.EngineType)
 	d.Set(names.AttrEngineVersion, output.EngineVersion)
@@ -645,6 +692,19 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		requiresReboot = true
 	}
 
+	if d.HasChange("data_replication_role") {
+		if o, n := d.GetChange("data_replication_role"); o.(string) != "" && n.(string) != "" {
+			mode := types.PromoteModeSwitchover
+			if v, ok := d.GetOk("data_replication_promote_mode"); ok {
+				mode = types.PromoteMode(v.(string))
+			}
+
+			if err := updateBrokerDataReplicationRole(ctx, conn, d.Id(), n.(string), mode, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	if d.Get(names.AttrApplyImmediately).(bool) && requiresReboot {
 		_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{
 			BrokerId: aws.String(d.Id()),
@@ -687,6 +747,60 @@ func resourceBrokerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// updateBrokerDataReplicationRole promotes a replica broker to primary or, for a
+// primary broker, demotes it by promoting its counterpart in the other Region.
+func updateBrokerDataReplicationRole(ctx context.Context, conn *mq.Client, id, role string, mode types.PromoteMode, timeout time.Duration) error {
+	output, err := findBrokerByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading MQ Broker (%s): %w", id, err)
+	}
+
+	metadata := output.DataReplicationMetadata
+
+	if metadata == nil {
+		return fmt.Errorf("MQ Broker (%s) data replication is not enabled", id)
+	}
+
+	if aws.ToString(metadata.DataReplicationRole) == role {
+		return nil
+	}
+
+	brokerID := id
+	var optFns []func(*mq.Options)
+
+	if role == dataReplicationRoleReplica {
+		counterpart := metadata.DataReplicationCounterpart
+
+		if counterpart == nil {
+			return fmt.Errorf("MQ Broker (%s) has no data replication counterpart", id)
+		}
+
+		brokerID = aws.ToString(counterpart.BrokerId)
+		region := aws.ToString(counterpart.Region)
+		optFns = append(optFns, func(o *mq.Options) {
+			o.Region = region
+		})
+	}
+
+	input := &mq.PromoteInput{
+		BrokerId: aws.String(brokerID),
+		Mode:     mode,
+	}
+
+	_, err = conn.Promote(ctx, input, optFns...)
+
+	if err != nil {
+		return fmt.Errorf("promoting MQ Broker (%s): %w", brokerID, err)
+	}
+
+	if _, err := waitBrokerDataReplicationRoleUpdated(ctx, conn, id, role, timeout); err != nil {
+		return fmt.Errorf("waiting for MQ Broker (%s) data replication role update: %w", id, err)
+	}
+
+	return nil
+}
+
 func findBrokerByID(ctx context.Context, conn *mq.Client, id string) (*mq.DescribeBrokerOutput, error) {
 	input := &mq.DescribeBrokerInput{
 		BrokerId: aws.String(id),
@@ -731,7 +845,7 @@ func statusBrokerState(ctx context.Context, conn *mq.Client, id string) retry.St
 func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
 	stateConf := retry.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateCreationInProgress, types.BrokerStateRebootInProgress),
-		Target:  enum.Slice(types.BrokerStateRunning),
+		Target:  enum.Slice(types.BrokerStateRunning, types.BrokerStateReplica),
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
@@ -750,6 +864,7 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout
 			types.BrokerStateCreationFailed,
 			types.BrokerStateDeletionInProgress,
 			types.BrokerStateRebootInProgress,
+			types.BrokerStateReplica,
 			types.BrokerStateRunning,
 		),
 		Target:  []string{},
@@ -768,7 +883,7 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout
 func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
 	stateConf := retry.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateRebootInProgress),
-		Target:  enum.Slice(types.BrokerStateRunning),
+		Target:  enum.Slice(types.BrokerStateRunning, types.BrokerStateReplica),
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
@@ -781,6 +896,48 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 	return nil, err
 }
 
+func statusBrokerDataReplicationRole(ctx context.Context, conn *mq.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBrokerByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.DataReplicationMetadata == nil {
+			return output, "", nil
+		}
+
+		return output, aws.ToString(output.DataReplicationMetadata.DataReplicationRole), nil
+	}
+}
+
+func waitBrokerDataReplicationRoleUpdated(ctx context.Context, conn *mq.Client, id, role string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
+	pending := dataReplicationRolePrimary
+	if role == dataReplicationRolePrimary {
+		pending = dataReplicationRoleReplica
+	}
+
+	stateConf := retry.StateChangeConf{
+		Pending:                   []string{"", pending},
+		Target:                    []string{role},
+		Timeout:                   timeout,
+		Refresh:                   statusBrokerDataReplicationRole(ctx, conn, id),
+		ContinuousTargetOccurence: 2,
+	}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func resourceUserHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1051,6 +1208,19 @@ func flattenUsers(users []*types.User, cfgUsers []interface{}) *schema.Set {
 	return schema.NewSet(resourceUserHash, out)
 }
 
+func flattenDataReplicationCounterpart(apiObject *types.DataReplicationMetadataOutput) []interface{} {
+	if apiObject == nil || apiObject.DataReplicationCounterpart == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"broker_id":      aws.ToString(apiObject.DataReplicationCounterpart.BrokerId),
+		names.AttrRegion: aws.ToString(apiObject.DataReplicationCounterpart.Region),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandWeeklyStartTime(cfg []interface{}) *types.WeeklyStartTime {
 	if len(cfg) < 1 {
 		return nil
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_mq_broker_reboot", name="Broker Reboot")
+func resourceBrokerReboot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBrokerRebootCreate,
+		ReadWithoutTimeout:   resourceBrokerRebootRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"broker_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBrokerRebootCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MQClient(ctx)
+
+	brokerID := d.Get("broker_id").(string)
+	_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{
+		BrokerId: aws.String(brokerID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rebooting MQ Broker (%s): %s", brokerID, err)
+	}
+
+	d.SetId(brokerID)
+
+	if _, err := waitBrokerRebooted(ctx, conn, brokerID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) reboot: %s", brokerID, err)
+	}
+
+	return append(diags, resourceBrokerRebootRead(ctx, d, meta)...)
+}
+
+func resourceBrokerRebootRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MQClient(ctx)
+
+	_, err := findBrokerByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MQ Broker (%s) not found, removing reboot from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MQ Broker (%s): %s", d.Id(), err)
+	}
+
+	d.Set("broker_id", d.Id())
+
+	return diags
+}
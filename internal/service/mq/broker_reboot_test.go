@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMQBrokerReboot_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker_reboot.test"
+	brokerResourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerRebootConfig_basic(rName, testAccBrokerVersionNewer, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrokerExists(ctx, brokerResourceName, &broker),
+					resource.TestCheckResourceAttrPair(resourceName, "broker_id", brokerResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccBrokerRebootConfig_basic(rName, testAccBrokerVersionNewer, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrokerExists(ctx, brokerResourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccBrokerRebootConfig_basic(rName, version, revision string) string {
+	return acctest.ConfigCompose(testAccBrokerConfig_basic(rName, version), fmt.Sprintf(`
+resource "aws_mq_broker_reboot" "test" {
+  broker_id = aws_mq_broker.test.id
+
+  triggers = {
+    revision = %[1]q
+  }
+}
+`, revision))
+}
@@ -275,6 +275,8 @@ func TestAccMQBroker_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "simple"),
 					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_counterpart.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_role", ""),
 					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
 					resource.TestMatchResourceAttr(resourceName, "configuration.0.revision", regexache.MustCompile(`^[0-9]+$`)),
 					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "SINGLE_INSTANCE"),
@@ -1405,6 +1407,7 @@ func TestAccMQBroker_dataReplicationMode(t *testing.T) {
 					// data_replication_mode is not returned until after reboot
 					resource.TestCheckResourceAttr(resourceName, "data_replication_mode", ""),
 					resource.TestCheckResourceAttr(resourceName, "pending_data_replication_mode", string(types.DataReplicationModeCrdr)),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_role", ""),
 					resource.TestCheckResourceAttrPair(resourceName, "data_replication_primary_broker_arn", primaryBrokerResourceName, names.AttrARN),
 				),
 			},
@@ -53,6 +53,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceBrokerReboot,
+			TypeName: "aws_mq_broker_reboot",
+			Name:     "Broker Reboot",
+		},
 		{
 			Factory:  resourceConfiguration,
 			TypeName: "aws_mq_configuration",
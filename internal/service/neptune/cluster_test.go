@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -262,7 +263,7 @@ func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterConfig_serverlessConfiguration(rName),
+				Config: testAccClusterConfig_serverlessConfiguration(rName, 4.5, 12.5),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", acctest.Ct1),
@@ -271,6 +272,20 @@ func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 				),
 			},
 			testAccClusterImportStep(resourceName),
+			{
+				Config: testAccClusterConfig_serverlessConfiguration(rName, 2.5, 32),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.min_capacity", "2.5"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.max_capacity", "32"),
+				),
+			},
 		},
 	})
 }
@@ -877,7 +892,7 @@ resource "aws_neptune_cluster" "test" {
 `, rName, isProtected))
 }
 
-func testAccClusterConfig_serverlessConfiguration(rName string) string {
+func testAccClusterConfig_serverlessConfiguration(rName string, minCapacity, maxCapacity float64) string {
 	return fmt.Sprintf(`
 resource "aws_neptune_cluster" "test" {
   cluster_identifier_prefix            = %[1]q
@@ -887,11 +902,11 @@ resource "aws_neptune_cluster" "test" {
   skip_final_snapshot                  = true
 
   serverless_v2_scaling_configuration {
-    min_capacity = 4.5
-    max_capacity = 12.5
+    min_capacity = %[2]g
+    max_capacity = %[3]g
   }
 }
-`, rName)
+`, rName, minCapacity, maxCapacity)
 }
 
 func testAccClusterConfig_finalSnapshot(rName string) string {
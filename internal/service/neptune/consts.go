@@ -83,12 +83,13 @@ const (
 )
 
 const (
-	globalClusterStatusAvailable = "available"
-	globalClusterStatusCreating  = "creating"
-	globalClusterStatusDeleted   = "deleted"
-	globalClusterStatusDeleting  = "deleting"
-	globalClusterStatusModifying = "modifying"
-	globalClusterStatusUpgrading = "upgrading"
+	globalClusterStatusAvailable   = "available"
+	globalClusterStatusCreating    = "creating"
+	globalClusterStatusDeleted     = "deleted"
+	globalClusterStatusDeleting    = "deleting"
+	globalClusterStatusFailingOver = "failing-over"
+	globalClusterStatusModifying   = "modifying"
+	globalClusterStatusUpgrading   = "upgrading"
 )
 
 const (
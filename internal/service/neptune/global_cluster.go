@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -92,6 +94,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"source_db_cluster_identifier": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -184,6 +192,7 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("primary_db_cluster_arn", globalClusterWriterARN(globalCluster))
 	d.Set(names.AttrStorageEncrypted, globalCluster.StorageEncrypted)
 
 	return diags
@@ -215,6 +224,24 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("primary_db_cluster_arn") {
+		targetClusterARN := d.Get("primary_db_cluster_arn").(string)
+		input := &neptune.FailoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(d.Id()),
+			TargetDbClusterIdentifier: aws.String(targetClusterARN),
+		}
+
+		_, err := conn.FailoverGlobalClusterWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "failing over Neptune Global Cluster (%s) to Neptune Cluster (%s): %s", d.Id(), targetClusterARN, err)
+		}
+
+		if _, err := waitGlobalClusterFailedOver(ctx, conn, d.Id(), targetClusterARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Neptune Global Cluster (%s) failover: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrEngineVersion) {
 		engineVersion := d.Get(names.AttrEngineVersion).(string)
 
@@ -393,6 +420,27 @@ func statusGlobalCluster(ctx context.Context, conn *neptune.Neptune, id string)
 	}
 }
 
+// statusGlobalClusterFailover reports whether the specified cluster has been promoted to the global cluster's writer.
+func statusGlobalClusterFailover(ctx context.Context, conn *neptune.Neptune, id, clusterARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.StringValue(output.Status); status != globalClusterStatusAvailable {
+			return output, status, nil
+		}
+
+		return output, strconv.FormatBool(globalClusterWriterARN(output) == clusterARN), nil
+	}
+}
+
 func waitGlobalClusterCreated(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{globalClusterStatusCreating},
@@ -428,6 +476,24 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Neptune, id str
 	return nil, err
 }
 
+func waitGlobalClusterFailedOver(ctx context.Context, conn *neptune.Neptune, id, clusterARN string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{globalClusterStatusFailingOver, globalClusterStatusModifying, strconv.FormatBool(false)},
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusGlobalClusterFailover(ctx, conn, id, clusterARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        []string{globalClusterStatusAvailable, globalClusterStatusDeleting},
@@ -464,3 +530,13 @@ func flattenGlobalClusterMembers(apiObjects []*neptune.GlobalClusterMember) []in
 
 	return tfList
 }
+
+func globalClusterWriterARN(apiObject *neptune.GlobalCluster) string {
+	for _, v := range apiObject.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			return aws.StringValue(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -312,6 +313,45 @@ func TestAccNeptuneGlobalCluster_StorageEncrypted(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneGlobalCluster_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	var providers []*schema.Provider
+	var v neptune.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix("tf-acc-test-global")
+	rNamePrimary := sdkacctest.RandomWithPrefix("tf-acc-test-primary")
+	rNameSecondary := sdkacctest.RandomWithPrefix("tf-acc-test-secondary")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_failover(rNameGlobal, rNamePrimary, rNameSecondary, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_db_cluster_arn", "aws_neptune_cluster.primary", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_failover(rNameGlobal, rNamePrimary, rNameSecondary, "local.secondary_cluster_arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_db_cluster_arn", "aws_neptune_cluster.secondary", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGlobalClusterExists(ctx context.Context, n string, v *neptune.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -539,3 +579,117 @@ resource "aws_neptune_global_cluster" "test" {
 }
 `, rName, storageEncrypted)
 }
+
+func testAccGlobalClusterConfig_failover(rNameGlobal, rNamePrimary, rNameSecondary, primaryClusterARN string) string {
+	if primaryClusterARN == "" {
+		primaryClusterARN = "null"
+	}
+
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+locals {
+  # The secondary cluster depends on the global cluster so its ARN is constructed to avoid a dependency cycle.
+  secondary_cluster_arn = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:cluster:%[3]s"
+}
+
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+  primary_db_cluster_arn    = %[4]s
+}
+
+resource "aws_neptune_cluster" "primary" {
+  cluster_identifier                   = %[2]q
+  skip_final_snapshot                  = true
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+}
+
+resource "aws_neptune_cluster_instance" "primary" {
+  identifier                   = %[2]q
+  cluster_identifier           = aws_neptune_cluster.primary.id
+  instance_class               = "db.r5.large"
+  neptune_parameter_group_name = "default.neptune1.2"
+  engine_version               = aws_neptune_global_cluster.test.engine_version
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_neptune_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[3]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_neptune_cluster" "secondary" {
+  provider                             = "awsalternate"
+  cluster_identifier                   = %[3]q
+  skip_final_snapshot                  = true
+  neptune_subnet_group_name            = aws_neptune_subnet_group.alternate.name
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+
+  depends_on = [aws_neptune_cluster_instance.primary]
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+}
+
+resource "aws_neptune_cluster_instance" "secondary" {
+  provider                     = "awsalternate"
+  identifier                   = %[3]q
+  cluster_identifier           = aws_neptune_cluster.secondary.id
+  neptune_parameter_group_name = "default.neptune1.2"
+  engine_version               = aws_neptune_global_cluster.test.engine_version
+  instance_class               = "db.r5.large"
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, primaryClusterARN))
+}
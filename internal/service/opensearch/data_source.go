@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_opensearch_data_source", name="Data Source")
+func ResourceDataSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataSourceCreate,
+		ReadWithoutTimeout:   resourceDataSourceRead,
+		UpdateWithoutTimeout: resourceDataSourceUpdate,
+		DeleteWithoutTimeout: resourceDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_source_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_glue_data_catalog": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 80),
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(opensearchservice.DataSourceStatus_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	dataSourceResourceIDPartCount = 2
+)
+
+func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	domainName, name := d.Get(names.AttrDomainName).(string), d.Get(names.AttrName).(string)
+	id, err := flex.FlattenResourceId([]string{domainName, name}, dataSourceResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &opensearchservice.AddDataSourceInput{
+		DataSourceType: expandDataSourceType(d.Get("data_source_type").([]interface{})),
+		DomainName:     aws.String(domainName),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.AddDataSourceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Data Source (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// New data sources are always created ACTIVE.
+	if v, ok := d.GetOk(names.AttrStatus); ok && v.(string) != opensearchservice.DataSourceStatusActive {
+		if err := updateDataSource(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Data Source (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataSourceRead(ctx, d, meta)...)
+}
+
+func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataSourceResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, name := parts[0], parts[1]
+	output, err := findDataSourceByTwoPartKey(ctx, conn, domainName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Data Source (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("data_source_type", flattenDataSourceType(output.DataSourceType)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_source_type: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrDomainName, domainName)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	if err := updateDataSource(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Data Source (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataSourceRead(ctx, d, meta)...)
+}
+
+func resourceDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataSourceResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting OpenSearch Data Source: %s", d.Id())
+	_, err = conn.DeleteDataSourceWithContext(ctx, &opensearchservice.DeleteDataSourceInput{
+		DomainName: aws.String(parts[0]),
+		Name:       aws.String(parts[1]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Data Source (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateDataSource(ctx context.Context, conn *opensearchservice.OpenSearchService, d *schema.ResourceData) error {
+	input := &opensearchservice.UpdateDataSourceInput{
+		DataSourceType: expandDataSourceType(d.Get("data_source_type").([]interface{})),
+		DomainName:     aws.String(d.Get(names.AttrDomainName).(string)),
+		Name:           aws.String(d.Get(names.AttrName).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateDataSourceWithContext(ctx, input)
+
+	return err
+}
+
+func findDataSourceByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, name string) (*opensearchservice.GetDataSourceOutput, error) {
+	input := &opensearchservice.GetDataSourceInput{
+		DomainName: aws.String(domainName),
+		Name:       aws.String(name),
+	}
+
+	output, err := conn.GetDataSourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDataSourceType(tfList []interface{}) *opensearchservice.DataSourceType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &opensearchservice.DataSourceType{}
+
+	if v, ok := tfMap["s3_glue_data_catalog"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3GlueDataCatalog = &opensearchservice.S3GlueDataCatalog{}
+
+		if v, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := v[names.AttrRoleARN].(string); ok && v != "" {
+				apiObject.S3GlueDataCatalog.RoleArn = aws.String(v)
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDataSourceType(apiObject *opensearchservice.DataSourceType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3GlueDataCatalog; v != nil {
+		tfMap["s3_glue_data_catalog"] = []interface{}{map[string]interface{}{
+			names.AttrRoleARN: aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
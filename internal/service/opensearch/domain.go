@@ -1087,7 +1087,7 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.VPCOptions = expandVPCOptions(s)
 		}
 
-		_, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (any, error) {
+		outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (any, error) {
 			return conn.UpdateDomainConfigWithContext(ctx, &input)
 		},
 			domainErrorRetryable)
@@ -1095,7 +1095,30 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): %s", d.Id(), err)
 		}
 
-		if err := waitForDomainUpdate(ctx, conn, d.Get(names.AttrDomainName).(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		// Both waits below share the update timeout.
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+		if output := outputRaw.(*opensearchservice.UpdateDomainConfigOutput); output.DomainConfig != nil && output.DomainConfig.ChangeProgressDetails != nil {
+			domainName := d.Get(names.AttrDomainName).(string)
+
+			if changeID := aws.StringValue(output.DomainConfig.ChangeProgressDetails.ChangeId); changeID != "" {
+				if _, err := waitDomainChangeProgressCompleted(ctx, conn, domainName, changeID, time.Until(deadline)); err != nil {
+					// A change that is still in progress when the wait times out is cancelled.
+					if tfresource.TimedOut(err) {
+						// Roll back a blue/green deployment that is still pending so the domain is not left mid-change.
+						if _, cancelErr := conn.CancelDomainConfigChangeWithContext(ctx, &opensearchservice.CancelDomainConfigChangeInput{
+							DomainName: aws.String(domainName),
+						}); cancelErr != nil {
+							diags = sdkdiag.AppendErrorf(diags, "cancelling OpenSearch Domain (%s) configuration change (%s): %s", d.Id(), changeID, cancelErr)
+						}
+					}
+
+					return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for configuration change (%s): %s", d.Id(), changeID, err)
+				}
+			}
+		}
+
+		if err := waitForDomainUpdate(ctx, conn, d.Get(names.AttrDomainName).(string), time.Until(deadline)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for completion: %s", d.Id(), err)
 		}
 
@@ -75,6 +75,56 @@ func TestEBSVolumeTypePermitsThroughputInput(t *testing.T) {
 	}
 }
 
+func TestDomainChangeProgressFailedStagesError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		stages []*opensearchservice.ChangeProgressStage
+		want   string
+	}{
+		{
+			name: "no stages",
+		},
+		{
+			name: "no failed stages",
+			stages: []*opensearchservice.ChangeProgressStage{
+				{Name: aws.String("Validation"), Status: aws.String(opensearchservice.OverallChangeStatusCompleted)},
+				{Name: aws.String("Creating a new environment"), Status: aws.String(opensearchservice.OverallChangeStatusProcessing)},
+			},
+		},
+		{
+			name: "failed stages",
+			stages: []*opensearchservice.ChangeProgressStage{
+				{Name: aws.String("Validation"), Status: aws.String(opensearchservice.OverallChangeStatusCompleted)},
+				nil,
+				{Name: aws.String("Copying shards to new domain"), Description: aws.String("Shard copy failed"), Status: aws.String(opensearchservice.OverallChangeStatusFailed)},
+				{Name: aws.String("Deleting older resources"), Description: aws.String("Cleanup failed"), Status: aws.String(opensearchservice.OverallChangeStatusFailed)},
+			},
+			want: "Copying shards to new domain: Shard copy failed; Deleting older resources: Cleanup failed",
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfopensearch.DomainChangeProgressFailedStagesError(&opensearchservice.ChangeProgressStatusDetails{
+				ChangeProgressStages: testCase.stages,
+				Status:               aws.String(opensearchservice.OverallChangeStatusFailed),
+			})
+
+			if testCase.want == "" {
+				if err != nil {
+					t.Errorf("DomainChangeProgressFailedStagesError() = %q, want nil", err)
+				}
+			} else if err == nil || err.Error() != testCase.want {
+				t.Errorf("DomainChangeProgressFailedStagesError() = %v, want %q", err, testCase.want)
+			}
+		})
+	}
+}
+
 func TestParseEngineVersion(t *testing.T) {
 	t.Parallel()
 
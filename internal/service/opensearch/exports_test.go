@@ -5,6 +5,8 @@ package opensearch
 
 // Exports for use in tests only.
 var (
-	FindVPCEndpointByID = findVPCEndpointByID
-	VPCEndpointsError   = vpcEndpointsError
+	DomainChangeProgressFailedStagesError = domainChangeProgressFailedStagesError
+	FindDataSourceByTwoPartKey            = findDataSourceByTwoPartKey
+	FindVPCEndpointByID                   = findVPCEndpointByID
+	VPCEndpointsError                     = vpcEndpointsError
 )
@@ -36,6 +36,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceDataSource,
+			TypeName: "aws_opensearch_data_source",
+			Name:     "Data Source",
+		},
 		{
 			Factory:  ResourceDomain,
 			TypeName: "aws_opensearch_domain",
@@ -39,6 +39,29 @@ func statusUpgradeStatus(ctx context.Context, conn *opensearchservice.OpenSearch
 	}
 }
 
+func statusDomainChangeProgress(ctx context.Context, conn *opensearchservice.OpenSearchService, name, changeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeDomainChangeProgressWithContext(ctx, &opensearchservice.DescribeDomainChangeProgressInput{
+			ChangeId:   aws.String(changeID),
+			DomainName: aws.String(name),
+		})
+
+		if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if out == nil || out.ChangeProgressStatus == nil {
+			return nil, "", nil
+		}
+
+		return out.ChangeProgressStatus, aws.StringValue(out.ChangeProgressStatus.Status), nil
+	}
+}
+
 func domainConfigStatus(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := conn.DescribeDomainConfigWithContext(ctx, &opensearchservice.DescribeDomainConfigInput{
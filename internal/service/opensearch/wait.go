@@ -5,7 +5,9 @@ package opensearch
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +19,8 @@ import (
 const (
 	domainUpgradeSuccessMinTimeout = 10 * time.Second
 	domainUpgradeSuccessDelay      = 30 * time.Second
+	domainChangeProgressMinTimeout = 10 * time.Second
+	domainChangeProgressDelay      = 30 * time.Second
 )
 
 // UpgradeSucceeded waits for an Upgrade to return Success
@@ -39,6 +43,45 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearchservice.OpenSearc
 	return nil, err
 }
 
+func waitDomainChangeProgressCompleted(ctx context.Context, conn *opensearchservice.OpenSearchService, name, changeID string, timeout time.Duration) (*opensearchservice.ChangeProgressStatusDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{opensearchservice.OverallChangeStatusPending, opensearchservice.OverallChangeStatusProcessing},
+		Target:     []string{opensearchservice.OverallChangeStatusCompleted},
+		Refresh:    statusDomainChangeProgress(ctx, conn, name, changeID),
+		Timeout:    timeout,
+		MinTimeout: domainChangeProgressMinTimeout,
+		Delay:      domainChangeProgressDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.ChangeProgressStatusDetails); ok {
+		if status := aws.StringValue(output.Status); status == opensearchservice.OverallChangeStatusFailed {
+			tfresource.SetLastError(err, domainChangeProgressFailedStagesError(output))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// domainChangeProgressFailedStagesError returns an error describing the failed stages of a configuration change, if any.
+func domainChangeProgressFailedStagesError(output *opensearchservice.ChangeProgressStatusDetails) error {
+	var stages []string
+	for _, v := range output.ChangeProgressStages {
+		if v != nil && aws.StringValue(v.Status) == opensearchservice.OverallChangeStatusFailed {
+			stages = append(stages, fmt.Sprintf("%s: %s", aws.StringValue(v.Name), aws.StringValue(v.Description)))
+		}
+	}
+
+	if len(stages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(stages, "; "))
+}
+
 func WaitForDomainCreation(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, timeout time.Duration) error {
 	var out *opensearchservice.DomainStatus
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
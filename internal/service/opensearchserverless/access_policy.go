@@ -122,10 +122,10 @@ func (r *resourceAccessPolicy) Create(ctx context.Context, req resource.CreateRe
 
 	out, err := conn.CreateAccessPolicy(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameAccessPolicy, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -191,7 +191,7 @@ func (r *resourceAccessPolicy) Update(ctx context.Context, req resource.UpdateRe
 		out, err := conn.UpdateAccessPolicy(ctx, input)
 
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("updating Security Policy (%s)", plan.Name.ValueString()), err.Error())
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Security Policy (%s)", plan.Name.ValueString()), err))
 			return
 		}
 		resp.Diagnostics.Append(flex.Flatten(ctx, out.AccessPolicyDetail, &state)...)
@@ -224,10 +224,10 @@ func (r *resourceAccessPolicy) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameAccessPolicy, state.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -236,7 +236,7 @@ func (r *resourceAccessPolicy) ImportState(ctx context.Context, req resource.Imp
 	parts := strings.Split(req.ID, idSeparator)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		err := fmt.Errorf("unexpected format for ID (%[1]s), expected security-policy-name%[2]ssecurity-policy-type", req.ID, idSeparator)
-		resp.Diagnostics.AddError(fmt.Sprintf("importing Security Policy (%s)", req.ID), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("importing Security Policy (%s)", req.ID), err))
 		return
 	}
 
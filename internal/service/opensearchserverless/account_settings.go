@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Account Settings")
+func newResourceAccountSettings(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAccountSettings{}, nil
+}
+
+const (
+	ResNameAccountSettings = "Account Settings"
+
+	// Service defaults, restored when the resource is destroyed.
+	defaultMaxIndexingCapacityInOCU = 10
+	defaultMaxSearchCapacityInOCU   = 10
+)
+
+type resourceAccountSettings struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAccountSettings) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_opensearchserverless_account_settings"
+}
+
+func (r *resourceAccountSettings) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_limits": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityLimitsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_indexing_capacity_in_ocu": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.AtLeast(2),
+								int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("max_search_capacity_in_ocu")),
+							},
+						},
+						"max_search_capacity_in_ocu": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.AtLeast(2),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAccountSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var plan resourceAccountSettingsData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &opensearchserverless.UpdateAccountSettingsInput{}
+
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.UpdateAccountSettings(ctx, in)
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameAccountSettings, r.Meta().AccountID, err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringValueToFramework(ctx, r.Meta().AccountID)
+
+	if out != nil && out.AccountSettingsDetail != nil {
+		resp.Diagnostics.Append(flex.Flatten(ctx, out.AccountSettingsDetail, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAccountSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAccountSettings(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameAccountSettings, state.ID.String(), err),
+			err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAccountSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var plan, state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CapacityLimits.Equal(state.CapacityLimits) {
+		in := &opensearchserverless.UpdateAccountSettingsInput{}
+
+		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		out, err := conn.UpdateAccountSettings(ctx, in)
+		if err != nil {
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionUpdating, ResNameAccountSettings, plan.ID.ValueString(), err),
+				err,
+			))
+			return
+		}
+
+		if out != nil && out.AccountSettingsDetail != nil {
+			resp.Diagnostics.Append(flex.Flatten(ctx, out.AccountSettingsDetail, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAccountSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &opensearchserverless.UpdateAccountSettingsInput{
+		CapacityLimits: &awstypes.CapacityLimits{
+			MaxIndexingCapacityInOCU: aws.Int32(defaultMaxIndexingCapacityInOCU),
+			MaxSearchCapacityInOCU:   aws.Int32(defaultMaxSearchCapacityInOCU),
+		},
+	}
+
+	_, err := conn.UpdateAccountSettings(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameAccountSettings, state.ID.String(), err),
+			err,
+		))
+		return
+	}
+}
+
+func (r *resourceAccountSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+type resourceAccountSettingsData struct {
+	CapacityLimits fwtypes.ListNestedObjectValueOf[capacityLimitsModel] `tfsdk:"capacity_limits"`
+	ID             types.String                                         `tfsdk:"id"`
+}
+
+type capacityLimitsModel struct {
+	MaxIndexingCapacityInOCU types.Int64 `tfsdk:"max_indexing_capacity_in_ocu"`
+	MaxSearchCapacityInOCU   types.Int64 `tfsdk:"max_search_capacity_in_ocu"`
+}
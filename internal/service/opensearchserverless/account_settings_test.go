@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Account settings are a per-Region singleton, so these tests must not run in parallel.
+func TestAccOpenSearchServerlessAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_opensearchserverless_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(4, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_indexing_capacity_in_ocu", "4"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_search_capacity_in_ocu", "6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingsConfig_basic(8, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_indexing_capacity_in_ocu", "8"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_search_capacity_in_ocu", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearchserverless_account_settings" {
+				continue
+			}
+
+			out, err := tfopensearchserverless.FindAccountSettings(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if v := aws.ToInt32(out.CapacityLimits.MaxIndexingCapacityInOCU); v != 10 {
+				return fmt.Errorf("OpenSearch Serverless Account Settings max indexing capacity not reset, got %d", v)
+			}
+
+			if v := aws.ToInt32(out.CapacityLimits.MaxSearchCapacityInOCU); v != 10 {
+				return fmt.Errorf("OpenSearch Serverless Account Settings max search capacity not reset, got %d", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountSettingsExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
+
+		_, err := tfopensearchserverless.FindAccountSettings(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccAccountSettingsConfig_basic(maxIndexing, maxSearch int) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_account_settings" "test" {
+  capacity_limits {
+    max_indexing_capacity_in_ocu = %[1]d
+    max_search_capacity_in_ocu   = %[2]d
+  }
+}
+`, maxIndexing, maxSearch)
+}
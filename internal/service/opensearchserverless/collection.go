@@ -169,10 +169,10 @@ func (r *resourceCollection) Create(ctx context.Context, req resource.CreateRequ
 
 	out, err := conn.CreateCollection(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameCollection, plan.Name.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -183,10 +183,10 @@ func (r *resourceCollection) Create(ctx context.Context, req resource.CreateRequ
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	collection, err := waitCollectionCreated(ctx, conn, aws.ToString(out.CreateCollectionDetail.Id), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionWaitingForCreation, ResNameCollection, plan.Name.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -247,10 +247,10 @@ func (r *resourceCollection) Update(ctx context.Context, req resource.UpdateRequ
 		out, err := conn.UpdateCollection(ctx, input)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionUpdating, ResNameCollection, state.ID.ValueString(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -283,20 +283,20 @@ func (r *resourceCollection) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameCollection, state.Name.ValueString(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitCollectionDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionWaitingForCreation, ResNameCollection, state.Name.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
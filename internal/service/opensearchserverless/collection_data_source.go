@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -106,10 +107,10 @@ func (d *dataSourceCollection) Read(ctx context.Context, req datasource.ReadRequ
 	if !data.ID.IsNull() && !data.ID.IsUnknown() {
 		output, err := findCollectionByID(ctx, conn, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, data.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -119,10 +120,10 @@ func (d *dataSourceCollection) Read(ctx context.Context, req datasource.ReadRequ
 	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		output, err := findCollectionByName(ctx, conn, data.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, data.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -138,10 +139,10 @@ func (d *dataSourceCollection) Read(ctx context.Context, req datasource.ReadRequ
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 	tags, err := listTags(ctx, conn, aws.ToString(out.Arn))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -6,6 +6,7 @@ package opensearchserverless
 // Exports for use in tests only.
 var (
 	ResourceAccessPolicy    = newResourceAccessPolicy
+	ResourceAccountSettings = newResourceAccountSettings
 	ResourceCollection      = newResourceCollection
 	ResourceLifecyclePolicy = newResourceLifecyclePolicy
 	ResourceSecurityConfig  = newResourceSecurityConfig
@@ -13,6 +14,7 @@ var (
 	ResourceVPCEndpoint     = newResourceVPCEndpoint
 
 	FindAccessPolicyByNameAndType    = findAccessPolicyByNameAndType
+	FindAccountSettings              = findAccountSettings
 	FindCollectionByID               = findCollectionByID
 	FindLifecyclePolicyByNameAndType = findLifecyclePolicyByNameAndType
 	FindSecurityConfigByID           = findSecurityConfigByID
@@ -198,3 +198,25 @@ func findLifecyclePolicyByNameAndType(ctx context.Context, conn *opensearchserve
 
 	return &out.LifecyclePolicyDetails[0], nil
 }
+
+func findAccountSettings(ctx context.Context, conn *opensearchserverless.Client) (*types.AccountSettingsDetail, error) {
+	in := &opensearchserverless.GetAccountSettingsInput{}
+
+	out, err := conn.GetAccountSettings(ctx, in)
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AccountSettingsDetail == nil || out.AccountSettingsDetail.CapacityLimits == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AccountSettingsDetail, nil
+}
@@ -112,10 +112,10 @@ func (r *resourceLifecyclePolicy) Create(ctx context.Context, req resource.Creat
 
 	out, err := conn.CreateLifecyclePolicy(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameLifecyclePolicy, plan.Name.ValueString(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.LifecyclePolicyDetail == nil {
@@ -152,10 +152,10 @@ func (r *resourceLifecyclePolicy) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameLifecyclePolicy, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -190,10 +190,10 @@ func (r *resourceLifecyclePolicy) Update(ctx context.Context, req resource.Updat
 
 		out, err := conn.UpdateLifecyclePolicy(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionUpdating, ResNameLifecyclePolicy, plan.ID.ValueString(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil || out.LifecyclePolicyDetail == nil {
@@ -235,10 +235,10 @@ func (r *resourceLifecyclePolicy) Delete(ctx context.Context, req resource.Delet
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameLifecyclePolicy, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -247,7 +247,7 @@ func (r *resourceLifecyclePolicy) ImportState(ctx context.Context, req resource.
 	parts := strings.Split(req.ID, idSeparator)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		err := fmt.Errorf("unexpected format for ID (%[1]s), expected lifecycle-policy-name%[2]slifecycle-policy-type", req.ID, idSeparator)
-		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameLifecyclePolicy, req.ID), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("importing %s (%s)", ResNameLifecyclePolicy, req.ID), err))
 		return
 	}
 
@@ -142,18 +142,18 @@ func (r *resourceSecurityConfig) Create(ctx context.Context, req resource.Create
 
 	out, err := conn.CreateSecurityConfig(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameSecurityConfig, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	if out == nil || out.SecurityConfigDetail == nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameSecurityConfig, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -217,7 +217,7 @@ func (r *resourceSecurityConfig) Update(ctx context.Context, req resource.Update
 	out, err := conn.UpdateSecurityConfig(ctx, input)
 
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("updating Security Policy (%s)", plan.Name.ValueString()), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Security Policy (%s)", plan.Name.ValueString()), err))
 		return
 	}
 	plan.refreshFromOutput(ctx, out.SecurityConfigDetail)
@@ -243,10 +243,10 @@ func (r *resourceSecurityConfig) Delete(ctx context.Context, req resource.Delete
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameSecurityConfig, state.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -254,7 +254,7 @@ func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.I
 	parts := strings.Split(req.ID, idSeparator)
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
 		err := fmt.Errorf("unexpected format for ID (%[1]s), expected saml/account-id/name", req.ID)
-		resp.Diagnostics.AddError(fmt.Sprintf("importing Security Policy (%s)", req.ID), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("importing Security Policy (%s)", req.ID), err))
 		return
 	}
 
@@ -121,10 +121,10 @@ func (r *resourceSecurityPolicy) Create(ctx context.Context, req resource.Create
 
 	out, err := conn.CreateSecurityPolicy(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameSecurityPolicy, plan.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -183,7 +183,7 @@ func (r *resourceSecurityPolicy) Update(ctx context.Context, req resource.Update
 		out, err := conn.UpdateSecurityPolicy(ctx, input)
 
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("updating Security Policy (%s)", plan.Name.ValueString()), err.Error())
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Security Policy (%s)", plan.Name.ValueString()), err))
 			return
 		}
 		resp.Diagnostics.Append(state.refreshFromOutput(ctx, out.SecurityPolicyDetail)...)
@@ -211,10 +211,10 @@ func (r *resourceSecurityPolicy) Delete(ctx context.Context, req resource.Delete
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameSecurityPolicy, state.Name.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -222,7 +222,7 @@ func (r *resourceSecurityPolicy) ImportState(ctx context.Context, req resource.I
 	parts := strings.Split(req.ID, idSeparator)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		err := fmt.Errorf("unexpected format for ID (%[1]s), expected security-policy-name%[2]ssecurity-policy-type", req.ID, idSeparator)
-		resp.Diagnostics.AddError(fmt.Sprintf("importing Security Policy (%s)", req.ID), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("importing Security Policy (%s)", req.ID), err))
 		return
 	}
 
@@ -255,7 +255,7 @@ func (rd *resourceSecurityPolicyData) refreshFromOutput(ctx context.Context, out
 
 	policyBytes, err := out.Policy.MarshalSmithyDocument()
 	if err != nil {
-		diags.AddError(fmt.Sprintf("refreshing state for Security Policy (%s)", rd.Name), err.Error())
+		diags.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("refreshing state for Security Policy (%s)", rd.Name), err))
 		return diags
 	}
 
@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceAccessPolicy,
 			Name:    "Access Policy",
 		},
+		{
+			Factory: newDataSourceAccountSettings,
+			Name:    "Account Settings",
+		},
 		{
 			Factory: newDataSourceCollection,
 			Name:    "Collection",
@@ -41,6 +45,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceAccessPolicy,
 		},
+		{
+			Factory: newResourceAccountSettings,
+			Name:    "Account Settings",
+		},
 		{
 			Factory: newResourceCollection,
 			Name:    "Collection",
@@ -42,15 +42,21 @@ func resourceAccount() *schema.Resource {
 			StateContext: resourceAccountImportState,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"close_on_deletion": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Deprecated:    "close_on_deletion is deprecated. Use on_destroy instead.",
+				ConflictsWith: []string{"on_destroy"},
 			},
 			"create_govcloud": {
 				Type:     schema.TypeBool,
@@ -90,6 +96,12 @@ func resourceAccount() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 50),
 			},
+			"on_destroy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[accountOnDestroy](),
+				ConflictsWith:    []string{"close_on_deletion"},
+			},
 			"parent_id": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -267,19 +279,33 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
 
-	close := d.Get("close_on_deletion").(bool)
+	onDestroy := accountOnDestroyRemove
+	if v, ok := d.GetOk("on_destroy"); ok {
+		onDestroy = accountOnDestroy(v.(string))
+	} else if d.Get("close_on_deletion").(bool) {
+		onDestroy = accountOnDestroyClose
+	}
+
 	var err error
 
-	if close {
+	switch onDestroy {
+	case accountOnDestroyClose:
+		if meta.(*conns.AWSClient).OrganizationsPreventAccountClosure(ctx) {
+			return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): account closure is prevented by the provider's organizations_prevent_account_closure configuration", d.Id())
+		}
+
 		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
 		_, err = conn.CloseAccount(ctx, &organizations.CloseAccountInput{
 			AccountId: aws.String(d.Id()),
 		})
-	} else {
+	case accountOnDestroyRemove:
 		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
 		_, err = conn.RemoveAccountFromOrganization(ctx, &organizations.RemoveAccountFromOrganizationInput{
 			AccountId: aws.String(d.Id()),
 		})
+	case accountOnDestroyRetain:
+		log.Printf("[DEBUG] Retaining AWS Organizations Account: %s", d.Id())
+		return diags
 	}
 
 	if errs.IsA[*awstypes.AccountNotFoundException](err) {
@@ -290,8 +316,8 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting AWS Organizations Account (%s): %s", d.Id(), err)
 	}
 
-	if close {
-		if _, err := waitAccountDeleted(ctx, conn, d.Id()); err != nil {
+	if onDestroy == accountOnDestroyClose {
+		if _, err := waitAccountDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -469,13 +495,13 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Client, id str
 	}
 }
 
-func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Account, error) {
+func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id string, timeout time.Duration) (*awstypes.Account, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(awstypes.AccountStatusPendingClosure, awstypes.AccountStatusActive),
 		Target:       []string{},
 		Refresh:      statusAccountStatus(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
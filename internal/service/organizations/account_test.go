@@ -29,6 +29,7 @@ func testAccAccountImportStep(n string) resource.TestStep {
 			"close_on_deletion",
 			"create_govcloud",
 			"govcloud_id",
+			"on_destroy",
 		},
 	}
 }
@@ -112,6 +113,45 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 	})
 }
 
+func testAccAccount_OnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v awstypes.Account
+	resourceName := "aws_organizations_account.test"
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsEnabled(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_onDestroy(name, email, "retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "on_destroy", "retain"),
+				),
+			},
+			{
+				Config: testAccAccountConfig_onDestroy(name, email, "close"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "on_destroy", "close"),
+				),
+			},
+			testAccAccountImportStep(resourceName),
+		},
+	})
+}
+
 func testAccAccount_ParentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
@@ -301,6 +341,16 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_onDestroy(name, email, onDestroy string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name       = %[1]q
+  email      = %[2]q
+  on_destroy = %[3]q
+}
+`, name, email, onDestroy)
+}
+
 func testAccAccountConfig_parentId1(name, email string) string {
 	return fmt.Sprintf(`
 data "aws_organizations_organization" "test" {}
@@ -5,8 +5,36 @@ package organizations
 
 import (
 	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 const (
 	organizationFinalizationTimeout = 4 * time.Minute
 )
+
+// Not yet defined in the AWS SDK for Go v2.
+const (
+	policyTypeResourceControlPolicy awstypes.PolicyType = "RESOURCE_CONTROL_POLICY"
+)
+
+func policyTypeValues() []string {
+	return append(enum.Values[awstypes.PolicyType](), string(policyTypeResourceControlPolicy))
+}
+
+type accountOnDestroy string
+
+const (
+	accountOnDestroyClose  accountOnDestroy = "close"
+	accountOnDestroyRemove accountOnDestroy = "remove"
+	accountOnDestroyRetain accountOnDestroy = "retain"
+)
+
+func (accountOnDestroy) Values() []accountOnDestroy {
+	return []accountOnDestroy{
+		accountOnDestroyClose,
+		accountOnDestroyRemove,
+		accountOnDestroyRetain,
+	}
+}
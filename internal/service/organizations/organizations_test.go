@@ -40,6 +40,7 @@ func TestAccOrganizations_serial(t *testing.T) {
 		"Account": {
 			acctest.CtBasic:   testAccAccount_basic,
 			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
+			"OnDestroy":       testAccAccount_OnDestroy,
 			"ParentId":        testAccAccount_ParentID,
 			"Tags":            testAccAccount_Tags,
 			"GovCloud":        testAccAccount_govCloud,
@@ -63,6 +64,7 @@ func TestAccOrganizations_serial(t *testing.T) {
 			acctest.CtDisappears:     testAccPolicy_disappears,
 			"Type_AI_OPT_OUT":        testAccPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccPolicy_type_Backup,
+			"Type_RCP":               testAccPolicy_type_RCP,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
 			"ImportAwsManagedPolicy": testAccPolicy_importManagedPolicy,
@@ -5,18 +5,20 @@ package organizations
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -65,15 +67,18 @@ func resourcePolicy() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrType: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          awstypes.PolicyTypeServiceControlPolicy,
-				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      awstypes.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice(policyTypeValues(), false),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			policyContentCustomizeDiff,
+		),
 	}
 }
 
@@ -235,3 +240,90 @@ func findPolicyByID(ctx context.Context, conn *organizations.Client, id string)
 
 	return output.Policy, nil
 }
+
+const (
+	// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html#min-max-values.
+	resourceControlPolicyMaxLength = 5120
+	serviceControlPolicyMaxLength  = 5120
+)
+
+func policyContentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrContent) || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	return validatePolicyContent(awstypes.PolicyType(d.Get(names.AttrType).(string)), d.Get(names.AttrContent).(string))
+}
+
+// validatePolicyContent performs plan-time validation of Service Control Policy and Resource Control Policy documents.
+func validatePolicyContent(policyType awstypes.PolicyType, content string) error {
+	switch policyType {
+	case awstypes.PolicyTypeServiceControlPolicy:
+		if n := utf8.RuneCountInString(content); n > serviceControlPolicyMaxLength {
+			return fmt.Errorf("%s content is %d characters, maximum is %d", policyType, n, serviceControlPolicyMaxLength)
+		}
+	case policyTypeResourceControlPolicy:
+		if n := utf8.RuneCountInString(content); n > resourceControlPolicyMaxLength {
+			return fmt.Errorf("%s content is %d characters, maximum is %d", policyType, n, resourceControlPolicyMaxLength)
+		}
+
+		var document policyDocument
+		if err := json.Unmarshal([]byte(content), &document); err != nil {
+			return fmt.Errorf("parsing %s content: %w", policyType, err)
+		}
+
+		for _, statement := range document.Statement {
+			if len(statement.NotAction) > 0 {
+				return fmt.Errorf("%s statements do not support NotAction", policyType)
+			}
+		}
+	}
+
+	return nil
+}
+
+type policyDocument struct {
+	Statement policyStatements `json:"Statement"`
+}
+
+type policyStatement struct {
+	NotAction policyStringOrSlice `json:"NotAction"`
+}
+
+// policyStatements accepts either a single statement object or an array of statements.
+type policyStatements []policyStatement
+
+func (s *policyStatements) UnmarshalJSON(b []byte) error {
+	var statement policyStatement
+	if err := json.Unmarshal(b, &statement); err == nil {
+		*s = policyStatements{statement}
+		return nil
+	}
+
+	var statements []policyStatement
+	if err := json.Unmarshal(b, &statements); err != nil {
+		return err
+	}
+	*s = statements
+
+	return nil
+}
+
+// policyStringOrSlice accepts either a single string or an array of strings.
+type policyStringOrSlice []string
+
+func (s *policyStringOrSlice) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err == nil {
+		*s = policyStringOrSlice{v}
+		return nil
+	}
+
+	var vs []string
+	if err := json.Unmarshal(b, &vs); err != nil {
+		return err
+	}
+	*s = vs
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -362,6 +363,41 @@ func testAccPolicy_type_Backup(t *testing.T) {
 	})
 }
 
+func testAccPolicy_type_RCP(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy.test"
+	resourceControlPolicyContent := `{"Version": "2012-10-17", "Statement": { "Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*", "Condition": {"BoolIfExists": {"aws:SecureTransport": "false"}}}}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_typeRCP(rName, `{"Version": "2012-10-17", "Statement": { "Effect": "Deny", "Principal": "*", "Action": "ec2:*", "Resource": "*"}}`),
+				ExpectError: regexache.MustCompile(`uses unsupported service \(ec2\)`),
+			},
+			{
+				Config: testAccPolicyConfig_typeRCP(rName, resourceControlPolicyContent),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					acctest.MatchResourceAttrGlobalARN(resourceName, names.AttrARN, "organizations", regexache.MustCompile("policy/o-.+/resource_control_policy/p-.+$")),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "RESOURCE_CONTROL_POLICY"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy},
+			},
+		},
+	})
+}
+
 func testAccPolicy_type_SCP(t *testing.T) {
 	ctx := acctest.Context(t)
 	var policy awstypes.Policy
@@ -455,6 +491,65 @@ func testAccPolicy_importManagedPolicy(t *testing.T) {
 	})
 }
 
+func TestValidatePolicyContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policyType  awstypes.PolicyType
+		content     string
+		expectError bool
+	}{
+		"SCP": {
+			policyType: awstypes.PolicyTypeServiceControlPolicy,
+			content:    `{"Version": "2012-10-17", "Statement": { "Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+		},
+		"SCP too large": {
+			policyType:  awstypes.PolicyTypeServiceControlPolicy,
+			content:     fmt.Sprintf(`{"Version": "2012-10-17", "Statement": { "Effect": "Allow", "Action": "*", "Resource": "*", "Sid": %q}}`, strings.Repeat("a", 5120)),
+			expectError: true,
+		},
+		"tag policy not validated": {
+			policyType: awstypes.PolicyTypeTagPolicy,
+			content:    strings.Repeat(" ", 6000),
+		},
+		"RCP": {
+			policyType: "RESOURCE_CONTROL_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": [{ "Effect": "Deny", "Principal": "*", "Action": ["s3:*", "sts:AssumeRole"], "Resource": "*"}]}`,
+		},
+		"RCP wildcard action": {
+			policyType: "RESOURCE_CONTROL_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": { "Effect": "Allow", "Principal": "*", "Action": "*", "Resource": "*"}}`,
+		},
+		"RCP too large": {
+			policyType:  "RESOURCE_CONTROL_POLICY",
+			content:     fmt.Sprintf(`{"Version": "2012-10-17", "Statement": { "Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*", "Sid": %q}}`, strings.Repeat("a", 5120)),
+			expectError: true,
+		},
+		"RCP NotAction": {
+			policyType:  "RESOURCE_CONTROL_POLICY",
+			content:     `{"Version": "2012-10-17", "Statement": { "Effect": "Deny", "Principal": "*", "NotAction": "s3:GetObject", "Resource": "*"}}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidatePolicyContent(testCase.policyType, testCase.content)
+
+			if err != nil && !testCase.expectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectError {
+				t.Errorf("expected error, but received none")
+			}
+		})
+	}
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx)
@@ -742,6 +837,22 @@ resource "aws_organizations_policy" "test" {
 `, strconv.Quote(content), rName)
 }
 
+func testAccPolicyConfig_typeRCP(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["RESOURCE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_policy" "test" {
+  content = %[1]s
+  name    = %[2]q
+  type    = "RESOURCE_CONTROL_POLICY"
+
+  depends_on = [aws_organizations_organization.test]
+}
+`, strconv.Quote(content), rName)
+}
+
 const testAccPolicyConfig_managedSetup = `
 resource "aws_organizations_organization" "test" {
   enabled_policy_types = ["SERVICE_CONTROL_POLICY"]
@@ -230,7 +230,7 @@ func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRe
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating OpenSearch Ingestion Pipeline (%s)", name), err))
 
 		return
 	}
@@ -240,7 +240,7 @@ func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRe
 	pipeline, err := waitPipelineCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) create", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) create", name), err))
 
 		return
 	}
@@ -260,7 +260,7 @@ func (r *pipelineResource) Read(ctx context.Context, request resource.ReadReques
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -278,7 +278,7 @@ func (r *pipelineResource) Read(ctx context.Context, request resource.ReadReques
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading OpenSearch Ingestion Pipeline (%s)", name), err))
 
 		return
 	}
@@ -320,13 +320,13 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 		_, err := conn.UpdatePipeline(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating OpenSearch Ingestion Pipeline (%s)", name), err))
 
 			return
 		}
 
 		if _, err := waitPipelineUpdated(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) update", name), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) update", name), err))
 
 			return
 		}
@@ -356,13 +356,13 @@ func (r *pipelineResource) Delete(ctx context.Context, request resource.DeleteRe
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting OpenSearch Ingestion Pipeline (%s)", name), err))
 
 		return
 	}
 
 	if _, err := waitPipelineDeleted(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) delete", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) delete", name), err))
 
 		return
 	}
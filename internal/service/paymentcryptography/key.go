@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -254,10 +255,10 @@ func (r *resourceKey) Create(ctx context.Context, request resource.CreateRequest
 
 	out, err := conn.CreateKey(ctx, in)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionCreating, ResNameKey, "FIXME", err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.Key == nil {
@@ -274,10 +275,10 @@ func (r *resourceKey) Create(ctx context.Context, request resource.CreateRequest
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	created, err := waitKeyCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionWaitingForCreation, ResNameKey, plan.KeyArn.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -304,10 +305,10 @@ func (r *resourceKey) Read(ctx context.Context, request resource.ReadRequest, re
 		return
 	}
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionSetting, ResNameKey, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -339,10 +340,10 @@ func (r *resourceKey) Update(ctx context.Context, request resource.UpdateRequest
 			}
 			_, err := conn.StartKeyUsage(ctx, in)
 			if err != nil {
-				response.Diagnostics.AddError(
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 					create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionUpdating, ResNameKey, new.KeyArn.String(), err),
-					err.Error(),
-				)
+					err,
+				))
 				return
 			}
 		} else {
@@ -351,19 +352,19 @@ func (r *resourceKey) Update(ctx context.Context, request resource.UpdateRequest
 			}
 			_, err := conn.StopKeyUsage(ctx, in)
 			if err != nil {
-				response.Diagnostics.AddError(
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 					create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionUpdating, ResNameKey, new.KeyArn.String(), err),
-					err.Error(),
-				)
+					err,
+				))
 				return
 			}
 		}
 		out, err := findKeyByID(ctx, conn, new.ID.ValueString())
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionSetting, ResNameKey, new.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		response.Diagnostics.Append(flex.Flatten(ctx, out, &new)...)
@@ -397,20 +398,20 @@ func (r *resourceKey) Delete(ctx context.Context, request resource.DeleteRequest
 		if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "not in CREATE_COMPLETE state.") {
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionDeleting, ResNameKey, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitKeyDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionWaitingForDeletion, ResNameKey, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -84,7 +84,7 @@ func (r *keyAliasResource) Create(ctx context.Context, request resource.CreateRe
 	output, err := conn.CreateAlias(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating PaymentCryptography Alias", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating PaymentCryptography Alias", err))
 
 		return
 	}
@@ -109,7 +109,7 @@ func (r *keyAliasResource) Read(ctx context.Context, request resource.ReadReques
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -126,7 +126,7 @@ func (r *keyAliasResource) Read(ctx context.Context, request resource.ReadReques
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading PaymentCryptography Alias (%s)", data.ID.String()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading PaymentCryptography Alias (%s)", data.ID.String()), err))
 
 		return
 	}
@@ -164,7 +164,7 @@ func (r *keyAliasResource) Update(ctx context.Context, request resource.UpdateRe
 		_, err := conn.UpdateAlias(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading PaymentCryptography key Alias (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading PaymentCryptography key Alias (%s)", new.ID.String()), err))
 			return
 		}
 	}
@@ -190,7 +190,7 @@ func (r *keyAliasResource) Delete(ctx context.Context, request resource.DeleteRe
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting PaymentCryptography key Alias (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting PaymentCryptography key Alias (%s)", data.ID.ValueString()), err))
 
 		return
 	}
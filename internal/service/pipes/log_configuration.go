@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -36,6 +37,14 @@ func logConfigurationSchema() *schema.Schema {
 						},
 					},
 				},
+				"include_execution_data": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: enum.Validate[types.IncludeExecutionDataOption](),
+					},
+				},
 				"firehose_log_destination": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -99,6 +108,10 @@ func expandPipeLogConfigurationParameters(tfMap map[string]interface{}) *types.P
 		apiObject.FirehoseLogDestination = expandFirehoseLogDestinationParameters(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["include_execution_data"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.IncludeExecutionData = flex.ExpandStringyValueSet[types.IncludeExecutionDataOption](v)
+	}
+
 	if v, ok := tfMap["s3_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.S3LogDestination = expandS3LogDestinationParameters(v[0].(map[string]interface{}))
 	}
@@ -179,6 +192,10 @@ func flattenPipeLogConfiguration(apiObject *types.PipeLogConfiguration) map[stri
 		tfMap["firehose_log_destination"] = []interface{}{flattenFirehoseLogDestination(v)}
 	}
 
+	if v := apiObject.IncludeExecutionData; v != nil {
+		tfMap["include_execution_data"] = flex.FlattenStringyValueSet(v)
+	}
+
 	if v := apiObject.S3LogDestination; v != nil {
 		tfMap["s3_log_destination"] = []interface{}{flattenS3LogDestination(v)}
 	}
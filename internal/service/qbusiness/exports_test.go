@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+// Exports for use in tests only.
+var (
+	ResourceApplication   = resourceApplication
+	ResourceDataSource    = resourceDataSource
+	ResourceIndex         = resourceIndex
+	ResourceRetriever     = resourceRetriever
+	ResourceWebExperience = resourceWebExperience
+
+	DataSourceParseResourceID     = dataSourceParseResourceID
+	FindApplicationByID           = findApplicationByID
+	FindDataSourceByThreePartKey  = findDataSourceByThreePartKey
+	FindIndexByTwoPartKey         = findIndexByTwoPartKey
+	FindRetrieverByTwoPartKey     = findRetrieverByTwoPartKey
+	FindWebExperienceByTwoPartKey = findWebExperienceByTwoPartKey
+	IndexParseResourceID          = indexParseResourceID
+	RetrieverParseResourceID      = retrieverParseResourceID
+	WebExperienceParseResourceID  = webExperienceParseResourceID
+)
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_qbusiness_retriever", name="Retriever")
+// @Tags(identifierAttribute="arn")
+func resourceRetriever() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRetrieverCreate,
+		ReadWithoutTimeout:   resourceRetrieverRead,
+		UpdateWithoutTimeout: resourceRetrieverUpdate,
+		DeleteWithoutTimeout: resourceRetrieverDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kendra_index_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.kendra_index_configuration", "configuration.0.native_index_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"native_index_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.kendra_index_configuration", "configuration.0.native_index_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"retriever_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RetrieverType](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRetrieverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessClient(ctx)
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get(names.AttrDisplayName).(string)
+	input := &qbusiness.CreateRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		ClientToken:   aws.String(id.UniqueId()),
+		Configuration: expandRetrieverConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		DisplayName:   aws.String(name),
+		Tags:          getTagsIn(ctx),
+		Type:          awstypes.RetrieverType(d.Get(names.AttrType).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrRoleARN); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRetriever(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Q Business Retriever (%s): %s", name, err)
+	}
+
+	d.SetId(retrieverCreateResourceID(applicationID, aws.ToString(output.RetrieverId)))
+
+	if _, err := waitRetrieverActive(ctx, conn, applicationID, aws.ToString(output.RetrieverId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Retriever (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRetrieverRead(ctx, d, meta)...)
+}
+
+func resourceRetrieverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessClient(ctx)
+
+	applicationID, retrieverID, err := retrieverParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q Business Retriever (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set(names.AttrARN, output.RetrieverArn)
+	if err := d.Set(names.AttrConfiguration, flattenRetrieverConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set(names.AttrDisplayName, output.DisplayName)
+	d.Set("retriever_id", output.RetrieverId)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrType, output.Type)
+
+	return diags
+}
+
+func resourceRetrieverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessClient(ctx)
+
+	applicationID, retrieverID, err := retrieverParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &qbusiness.UpdateRetrieverInput{
+			ApplicationId: aws.String(applicationID),
+			RetrieverId:   aws.String(retrieverID),
+		}
+
+		if d.HasChange(names.AttrConfiguration) {
+			input.Configuration = expandRetrieverConfiguration(d.Get(names.AttrConfiguration).([]interface{}))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		if d.HasChange(names.AttrRoleARN) {
+			input.RoleArn = aws.String(d.Get(names.AttrRoleARN).(string))
+		}
+
+		_, err := conn.UpdateRetriever(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Q Business Retriever (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitRetrieverActive(ctx, conn, applicationID, retrieverID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Q Business Retriever (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRetrieverRead(ctx, d, meta)...)
+}
+
+func resourceRetrieverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessClient(ctx)
+
+	applicationID, retrieverID, err := retrieverParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Q Business Retriever: %s", d.Id())
+	_, err = conn.DeleteRetriever(ctx, &qbusiness.DeleteRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const retrieverResourceIDPartCount = 2
+
+func retrieverCreateResourceID(applicationID, retrieverID string) string {
+	parts := []string{applicationID, retrieverID}
+
+	return errs.Must(flex.FlattenResourceId(parts, retrieverResourceIDPartCount, false))
+}
+
+func retrieverParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, retrieverResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findRetrieverByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) (*qbusiness.GetRetrieverOutput, error) {
+	input := &qbusiness.GetRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	output, err := conn.GetRetriever(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRetriever(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRetrieverActive(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RetrieverStatusCreating),
+		Target:  enum.Slice(awstypes.RetrieverStatusActive),
+		Refresh: statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandRetrieverConfiguration(tfList []interface{}) awstypes.RetrieverConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["kendra_index_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &awstypes.RetrieverConfigurationMemberKendraIndexConfiguration{
+			Value: awstypes.KendraIndexConfiguration{
+				IndexId: aws.String(v[0].(map[string]interface{})["index_id"].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["native_index_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &awstypes.RetrieverConfigurationMemberNativeIndexConfiguration{
+			Value: awstypes.NativeIndexConfiguration{
+				IndexId: aws.String(v[0].(map[string]interface{})["index_id"].(string)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenRetrieverConfiguration(apiObject awstypes.RetrieverConfiguration) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.RetrieverConfigurationMemberKendraIndexConfiguration:
+		tfMap["kendra_index_configuration"] = []interface{}{map[string]interface{}{
+			"index_id": aws.ToString(v.Value.IndexId),
+		}}
+	case *awstypes.RetrieverConfigurationMemberNativeIndexConfiguration:
+		tfMap["native_index_configuration"] = []interface{}{map[string]interface{}{
+			"index_id": aws.ToString(v.Value.IndexId),
+		}}
+	case nil:
+		return nil
+	default:
+		log.Printf("[WARN] unsupported Q Business Retriever configuration type: %T", v)
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
@@ -77,7 +77,8 @@ func ResourceAnalysis() *schema.Resource {
 					Required: true,
 					ForceNew: true,
 				},
-				"definition": quicksightschema.AnalysisDefinitionSchema(),
+				"definition":      quicksightschema.AnalysisDefinitionSchema(),
+				"definition_json": quicksightschema.AnalysisDefinitionJSONSchema(),
 				names.AttrLastUpdatedTime: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -172,6 +173,15 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("definition_json"); ok {
+		definition, err := quicksightschema.ExpandAnalysisDefinitionJSON(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Parameters = quicksightschema.ExpandParameters(d.Get(names.AttrParameters).([]interface{}))
 	}
@@ -237,7 +247,17 @@ func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "describing QuickSight Analysis (%s) Definition: %s", d.Id(), err)
 	}
 
-	if err := d.Set("definition", quicksightschema.FlattenAnalysisDefinition(descResp.Definition)); err != nil {
+	// Only one representation of the definition is kept in state. When the JSON form is in use the
+	// typed block is left empty, which keeps plans fast for large definitions.
+	if _, ok := d.GetOk("definition_json"); ok {
+		v, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting definition_json: %s", err)
+		}
+
+		d.Set("definition", nil)
+		d.Set("definition_json", v)
+	} else if err := d.Set("definition", quicksightschema.FlattenAnalysisDefinition(descResp.Definition)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
 	}
 
@@ -276,6 +296,13 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		_, createdFromEntity := d.GetOk("source_entity")
 		if createdFromEntity {
 			in.SourceEntity = quicksightschema.ExpandAnalysisSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if v, ok := d.GetOk("definition_json"); ok {
+			definition, err := quicksightschema.ExpandAnalysisDefinitionJSON(v.(string))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
 		}
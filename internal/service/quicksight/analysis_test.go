@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -161,6 +162,49 @@ func TestAccQuickSightAnalysis_update(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightAnalysis_definitionJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysis quicksight.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx, false),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalysisConfig_definitionJSONInvalid(rId, rName),
+				ExpectError: regexache.MustCompile(`missing required field`),
+			},
+			{
+				Config: testAccAnalysisConfig_definitionJSON(rId, rName, "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "analysis_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, quicksight.ResourceStatusCreationSuccessful),
+				),
+			},
+			{
+				Config: testAccAnalysisConfig_definitionJSON(rId, rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, quicksight.ResourceStatusUpdateSuccessful),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightAnalysis_parametersConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -435,6 +479,56 @@ resource "aws_quicksight_analysis" "test" {
 `, rId, rName))
 }
 
+func testAccAnalysisConfig_definitionJSON(rId, rName, title string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  definition_json = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      DataSetArn = aws_quicksight_data_set.test.arn
+      Identifier = "1"
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Title   = %[3]q
+      Visuals = [{
+        CustomContentVisual = {
+          DataSetIdentifier = "1"
+          VisualId          = "Test1"
+          Title = {
+            FormatText = {
+              PlainText = %[3]q
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rId, rName, title))
+}
+
+func testAccAnalysisConfig_definitionJSONInvalid(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  definition_json = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      Identifier = "1"
+    }]
+  })
+}
+`, rId, rName))
+}
+
 func testAccAnalysisConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
 	return acctest.ConfigCompose(
 		testAccAnalysisConfigBase(rId, rName),
@@ -71,6 +71,7 @@ func ResourceDashboard() *schema.Resource {
 				},
 				"dashboard_publish_options": quicksightschema.DashboardPublishOptionsSchema(),
 				"definition":                quicksightschema.DashboardDefinitionSchema(),
+				"definition_json":           quicksightschema.DashboardDefinitionJSONSchema(),
 				names.AttrLastUpdatedTime: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -174,6 +175,15 @@ func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("definition_json"); ok {
+		definition, err := quicksightschema.ExpandDashboardDefinitionJSON(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.GetOk("dashboard_publish_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DashboardPublishOptions = quicksightschema.ExpandDashboardPublishOptions(d.Get("dashboard_publish_options").([]interface{}))
 	}
@@ -240,7 +250,17 @@ func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "describing QuickSight Dashboard (%s) Definition: %s", d.Id(), err)
 	}
 
-	if err := d.Set("definition", quicksightschema.FlattenDashboardDefinition(descResp.Definition)); err != nil {
+	// Only one representation of the definition is kept in state. When the JSON form is in use the
+	// typed block is left empty, which keeps plans fast for large definitions.
+	if _, ok := d.GetOk("definition_json"); ok {
+		v, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting definition_json: %s", err)
+		}
+
+		d.Set("definition", nil)
+		d.Set("definition_json", v)
+	} else if err := d.Set("definition", quicksightschema.FlattenDashboardDefinition(descResp.Definition)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
 	}
 
@@ -284,6 +304,13 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		_, createdFromEntity := d.GetOk("source_entity")
 		if createdFromEntity {
 			in.SourceEntity = quicksightschema.ExpandDashboardSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if v, ok := d.GetOk("definition_json"); ok {
+			definition, err := quicksightschema.ExpandDashboardDefinitionJSON(v.(string))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
 		}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -165,6 +166,49 @@ func TestAccQuickSightDashboard_updateVersionNumber(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDashboard_definitionJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardConfig_definitionJSONInvalid(rId, rName),
+				ExpectError: regexache.MustCompile(`missing required field`),
+			},
+			{
+				Config: testAccDashboardConfig_definitionJSON(rId, rName, "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, quicksight.ResourceStatusCreationSuccessful),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_definitionJSON(rId, rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, quicksight.ResourceStatusUpdateSuccessful),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightDashboard_dashboardSpecificConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -379,6 +423,58 @@ resource "aws_quicksight_dashboard" "test" {
 `, rId, rName))
 }
 
+func testAccDashboardConfig_definitionJSON(rId, rName, title string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+
+  definition_json = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      DataSetArn = aws_quicksight_data_set.test.arn
+      Identifier = "1"
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Title   = %[3]q
+      Visuals = [{
+        CustomContentVisual = {
+          DataSetIdentifier = "1"
+          VisualId          = "Test1"
+          Title = {
+            FormatText = {
+              PlainText = %[3]q
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rId, rName, title))
+}
+
+func testAccDashboardConfig_definitionJSONInvalid(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "test"
+
+  definition_json = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      Identifier = "1"
+    }]
+  })
+}
+`, rId, rName))
+}
+
 func testAccDashboardConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
 	return acctest.ConfigCompose(
 		testAccDashboardConfigBase(rId, rName),
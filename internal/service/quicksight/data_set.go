@@ -272,6 +272,15 @@ func ResourceDataSet() *schema.Resource {
 								Optional:     true,
 								ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
 							},
+							"tag_rule_configurations": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 50,
+								Elem: &schema.Schema{
+									Type: schema.TypeList,
+									Elem: &schema.Schema{Type: schema.TypeString},
+								},
+							},
 							"tag_rules": {
 								Type:     schema.TypeList,
 								Required: true,
@@ -1858,6 +1867,9 @@ func expandDataSetRowLevelPermissionTagConfigurations(tfList []interface{}) *qui
 	if v, ok := tfMap["tag_rules"].([]interface{}); ok {
 		rowLevelPermissionTagConfiguration.TagRules = expandDataSetTagRules(v)
 	}
+	if v, ok := tfMap["tag_rule_configurations"].([]interface{}); ok && len(v) > 0 {
+		rowLevelPermissionTagConfiguration.TagRuleConfigurations = expandDataSetTagRuleConfigurations(v)
+	}
 	if v, ok := tfMap[names.AttrStatus].(string); ok {
 		rowLevelPermissionTagConfiguration.Status = aws.String(v)
 	}
@@ -1959,6 +1971,21 @@ func expandDataSetTagRules(tfList []interface{}) []*quicksight.RowLevelPermissio
 	return tagRules
 }
 
+func expandDataSetTagRuleConfigurations(tfList []interface{}) [][]*string {
+	var apiObjects [][]*string
+
+	for _, v := range tfList {
+		tagKeys, ok := v.([]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, flex.ExpandStringList(tagKeys))
+	}
+
+	return apiObjects
+}
+
 func expandDataSetTagRule(tfMap map[string]interface{}) *quicksight.RowLevelPermissionTagRule {
 	if tfMap == nil {
 		return nil
@@ -2607,6 +2634,9 @@ func flattenRowLevelPermissionTagConfiguration(apiObject *quicksight.RowLevelPer
 	if apiObject.Status != nil {
 		tfMap[names.AttrStatus] = aws.StringValue(apiObject.Status)
 	}
+	if apiObject.TagRuleConfigurations != nil {
+		tfMap["tag_rule_configurations"] = flattenTagRuleConfigurations(apiObject.TagRuleConfigurations)
+	}
 	if apiObject.TagRules != nil {
 		tfMap["tag_rules"] = flattenTagRules(apiObject.TagRules)
 	}
@@ -2672,6 +2702,16 @@ func flattenLookbackWindow(apiObject *quicksight.LookbackWindow) interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenTagRuleConfigurations(apiObjects [][]*string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flex.FlattenStringList(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenTagRules(apiObject []*quicksight.RowLevelPermissionTagRule) []interface{} {
 	if len(apiObject) == 0 {
 		return nil
@@ -197,6 +197,14 @@ func DataSourceDataSet() *schema.Resource {
 								Type:     schema.TypeString,
 								Computed: true,
 							},
+							"tag_rule_configurations": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeList,
+									Elem: &schema.Schema{Type: schema.TypeString},
+								},
+							},
 							"tag_rules": {
 								Type:     schema.TypeList,
 								Computed: true,
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -149,10 +150,10 @@ func (r *resourceIAMPolicyAssignment) Create(ctx context.Context, req resource.C
 
 	out, err := conn.CreateIAMPolicyAssignmentWithContext(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameIAMPolicyAssignment, plan.AssignmentName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -169,10 +170,10 @@ func (r *resourceIAMPolicyAssignment) Create(ctx context.Context, req resource.C
 		return FindIAMPolicyAssignmentByID(ctx, conn, plan.ID.ValueString())
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameIAMPolicyAssignment, plan.AssignmentName.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -194,10 +195,10 @@ func (r *resourceIAMPolicyAssignment) Read(ctx context.Context, req resource.Rea
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameIAMPolicyAssignment, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -214,10 +215,10 @@ func (r *resourceIAMPolicyAssignment) Read(ctx context.Context, req resource.Rea
 	// individual values in state
 	_, namespace, _, err := ParseIAMPolicyAssignmentID(state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameIAMPolicyAssignment, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	state.Namespace = flex.StringValueToFramework(ctx, namespace)
@@ -259,10 +260,10 @@ func (r *resourceIAMPolicyAssignment) Update(ctx context.Context, req resource.U
 
 		out, err := conn.UpdateIAMPolicyAssignmentWithContext(ctx, &in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.QuickSight, create.ErrActionUpdating, ResNameIAMPolicyAssignment, plan.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -296,10 +297,10 @@ func (r *resourceIAMPolicyAssignment) Delete(ctx context.Context, req resource.D
 		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, ResNameIAMPolicyAssignment, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 
 	// wait for IAM to propagate before returning
@@ -307,10 +308,10 @@ func (r *resourceIAMPolicyAssignment) Delete(ctx context.Context, req resource.D
 		return FindIAMPolicyAssignmentByID(ctx, conn, state.ID.ValueString())
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, ResNameIAMPolicyAssignment, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -127,10 +128,10 @@ func (r *resourceNamespace) Create(ctx context.Context, req resource.CreateReque
 
 	out, err := conn.CreateNamespaceWithContext(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameNamespace, plan.Namespace.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -144,10 +145,10 @@ func (r *resourceNamespace) Create(ctx context.Context, req resource.CreateReque
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	waitOut, err := waitNamespaceCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, ResNameNamespace, plan.Namespace.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	plan.ARN = flex.StringToFramework(ctx, waitOut.Arn)
@@ -173,10 +174,10 @@ func (r *resourceNamespace) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameNamespace, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -189,10 +190,10 @@ func (r *resourceNamespace) Read(ctx context.Context, req resource.ReadRequest,
 	// individual values in state
 	awsAccountID, namespace, err := ParseNamespaceID(state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameNamespace, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
@@ -231,19 +232,19 @@ func (r *resourceNamespace) Delete(ctx context.Context, req resource.DeleteReque
 		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, ResNameNamespace, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitNamespaceDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForDeletion, ResNameNamespace, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -249,10 +250,10 @@ func (r *resourceRefreshSchedule) Create(ctx context.Context, req resource.Creat
 
 	out, err := conn.CreateRefreshScheduleWithContext(ctx, &in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameRefreshSchedule, plan.ScheduleID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -265,10 +266,10 @@ func (r *resourceRefreshSchedule) Create(ctx context.Context, req resource.Creat
 
 	_, outFind, err := FindRefreshScheduleByID(ctx, conn, plan.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, ResNameRefreshSchedule, plan.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -291,10 +292,10 @@ func (r *resourceRefreshSchedule) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, ResNameRefreshSchedule, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	resp.Diagnostics.Append(state.refreshFromRead(ctx, arn, outFind)...)
@@ -344,10 +345,10 @@ func (r *resourceRefreshSchedule) Update(ctx context.Context, req resource.Updat
 		}
 		out, err := conn.UpdateRefreshScheduleWithContext(ctx, &in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.QuickSight, create.ErrActionUpdating, ResNameRefreshSchedule, plan.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -360,10 +361,10 @@ func (r *resourceRefreshSchedule) Update(ctx context.Context, req resource.Updat
 
 		_, outFind, err := FindRefreshScheduleByID(ctx, conn, plan.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, ResNameRefreshSchedule, plan.ID.String(), nil),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -383,10 +384,10 @@ func (r *resourceRefreshSchedule) Delete(ctx context.Context, req resource.Delet
 
 	_, dataSetID, scheduleID, err := ParseRefreshScheduleID(state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameRefreshSchedule, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	_, err = conn.DeleteRefreshScheduleWithContext(ctx, &quicksight.DeleteRefreshScheduleInput{
@@ -398,10 +399,10 @@ func (r *resourceRefreshSchedule) Delete(ctx context.Context, req resource.Delet
 		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, ResNameRefreshSchedule, state.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 	}
 }
 
@@ -494,10 +495,10 @@ func (rd *resourceRefreshScheduleData) refreshFromRead(ctx context.Context, arn
 	// Support import
 	awsAccountID, dataSetID, scheduleID, err := ParseRefreshScheduleID(rd.ID.ValueString())
 	if err != nil {
-		diags.AddError(
+		diags.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, ResNameRefreshSchedule, rd.ID.String(), nil),
-			err.Error(),
-		)
+			err,
+		))
 		return diags
 	}
 	rd.AWSAccountID = types.StringValue(awsAccountID)
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Refresh Schedule")
+func newDataSourceRefreshSchedule(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceRefreshSchedule{}, nil
+}
+
+const (
+	DSNameRefreshSchedule = "Refresh Schedule Data Source"
+)
+
+type dataSourceRefreshSchedule struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceRefreshSchedule) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_quicksight_refresh_schedule"
+}
+
+func (d *dataSourceRefreshSchedule) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAWSAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"data_set_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrSchedule: schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: scheduleAttrTypes},
+				Computed:    true,
+			},
+			"schedule_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceRefreshSchedule) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data resourceRefreshScheduleData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AWSAccountID.IsNull() || data.AWSAccountID.IsUnknown() {
+		data.AWSAccountID = types.StringValue(d.Meta().AccountID)
+	}
+	data.ID = types.StringValue(createRefreshScheduleID(data.AWSAccountID.ValueString(), data.DataSetID.ValueString(), data.ScheduleID.ValueString()))
+
+	conn := d.Meta().QuickSightConn(ctx)
+
+	arn, out, err := FindRefreshScheduleByID(ctx, conn, data.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, DSNameRefreshSchedule, data.ID.String(), err),
+			err,
+		))
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, arn)
+
+	schedule, diags := flattenSchedule(ctx, out)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Schedule = schedule
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightRefreshScheduleDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_quicksight_refresh_schedule.test"
+	resourceName := "aws_quicksight_refresh_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRefreshScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRefreshScheduleDataSourceConfig_basic(rId, rName, sId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAWSAccountID, resourceName, names.AttrAWSAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_set_id", resourceName, "data_set_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "schedule.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule.0.refresh_type", resourceName, "schedule.0.refresh_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule.0.schedule_frequency.0.interval", resourceName, "schedule.0.schedule_frequency.0.interval"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule.0.schedule_frequency.0.time_of_the_day", resourceName, "schedule.0.schedule_frequency.0.time_of_the_day"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule.0.schedule_frequency.0.timezone", resourceName, "schedule.0.schedule_frequency.0.timezone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule_id", resourceName, "schedule_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRefreshScheduleDataSourceConfig_basic(rId, rName, sId string) string {
+	return acctest.ConfigCompose(
+		testAccRefreshScheduleConfigBasic(rId, rName, sId),
+		`
+data "aws_quicksight_refresh_schedule" "test" {
+  data_set_id = aws_quicksight_refresh_schedule.test.data_set_id
+  schedule_id = aws_quicksight_refresh_schedule.test.schedule_id
+}
+`)
+}
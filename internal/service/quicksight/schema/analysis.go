@@ -20,6 +20,7 @@ func AnalysisDefinitionSchema() *schema.Schema {
 		Computed: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
@@ -118,6 +119,7 @@ func AnalysisSourceEntitySchema() *schema.Schema {
 		Optional: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
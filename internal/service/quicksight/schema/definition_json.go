@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// definitionJSONSchema returns the schema for a raw JSON alternative to a typed "definition" block.
+// The JSON uses the same member names as the QuickSight API and is decoded into the SDK type at plan time
+// so that malformed or incomplete definitions are rejected before any API call is made.
+func definitionJSONSchema[T any, PT interface {
+	*T
+	Validate() error
+}]() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ExactlyOneOf: []string{
+			"definition",
+			"definition_json",
+			"source_entity",
+		},
+		ValidateFunc: validation.All(
+			validation.StringIsJSON,
+			func(v interface{}, k string) (ws []string, es []error) {
+				var apiObject PT = new(T)
+
+				if err := expandDefinitionJSON(v.(string), apiObject); err != nil {
+					es = append(es, fmt.Errorf("%q: %w", k, err))
+					return
+				}
+
+				if err := apiObject.Validate(); err != nil {
+					es = append(es, fmt.Errorf("%q: %w", k, err))
+				}
+
+				return
+			},
+		),
+		DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+		DiffSuppressOnRefresh: true,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
+
+func DashboardDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema[quicksight.DashboardVersionDefinition]()
+}
+
+func AnalysisDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema[quicksight.AnalysisDefinition]()
+}
+
+func ExpandDashboardDefinitionJSON(s string) (*quicksight.DashboardVersionDefinition, error) {
+	apiObject := &quicksight.DashboardVersionDefinition{}
+
+	if err := expandDefinitionJSON(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func ExpandAnalysisDefinitionJSON(s string) (*quicksight.AnalysisDefinition, error) {
+	apiObject := &quicksight.AnalysisDefinition{}
+
+	if err := expandDefinitionJSON(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+// FlattenDefinitionJSON serializes a definition returned by the API back into its JSON wire format.
+// Members that the service did not return are omitted, so the result is stable across reads.
+func FlattenDefinitionJSON(apiObject interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+func expandDefinitionJSON(s string, apiObject interface{}) error {
+	return jsonutil.UnmarshalJSON(apiObject, strings.NewReader(s))
+}
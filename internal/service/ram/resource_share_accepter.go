@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ram"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -69,14 +71,25 @@ func resourceResourceShareAccepter() *schema.Resource {
 				Computed: true,
 			},
 			"sender_account_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
 			},
 			"share_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"share_arn", "share_arn_pattern"},
+			},
+			"share_arn_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 			"share_id": {
 				Type:     schema.TypeString,
@@ -94,8 +107,34 @@ func resourceResourceShareAccepterCreate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RAMClient(ctx)
 
+	inputG := &ram.GetResourceShareInvitationsInput{}
+	filters := []tfslices.Predicate[*awstypes.ResourceShareInvitation]{
+		func(v *awstypes.ResourceShareInvitation) bool {
+			return v.Status == awstypes.ResourceShareInvitationStatusPending
+		},
+	}
+
 	shareARN := d.Get("share_arn").(string)
-	maybeInvitation, err := findMaybeResourceShareInvitationByResourceShareARNAndStatus(ctx, conn, shareARN, string(awstypes.ResourceShareInvitationStatusPending))
+	if shareARN != "" {
+		inputG.ResourceShareArns = []string{shareARN}
+	}
+
+	if v, ok := d.GetOk("share_arn_pattern"); ok {
+		shareARN = v.(string)
+		re := regexache.MustCompile(shareARN)
+		filters = append(filters, func(v *awstypes.ResourceShareInvitation) bool {
+			return re.MatchString(aws.ToString(v.ResourceShareArn))
+		})
+	}
+
+	if v, ok := d.GetOk("sender_account_id"); ok {
+		senderAccountID := v.(string)
+		filters = append(filters, func(v *awstypes.ResourceShareInvitation) bool {
+			return aws.ToString(v.SenderAccountId) == senderAccountID
+		})
+	}
+
+	maybeInvitation, err := findMaybeResourceShareInvitationRetry(ctx, conn, inputG, tfslices.PredicateAnd(filters...))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading pending RAM Resource Share (%s) invitation: %s", shareARN, err)
@@ -104,8 +143,10 @@ func resourceResourceShareAccepterCreate(ctx context.Context, d *schema.Resource
 	var invitationExists bool
 	var invitationARN string
 	if maybeInvitation.IsSome() {
+		invitation := maybeInvitation.MustUnwrap()
 		invitationExists = true
-		invitationARN = aws.ToString(maybeInvitation.MustUnwrap().ResourceShareInvitationArn)
+		invitationARN = aws.ToString(invitation.ResourceShareInvitationArn)
+		shareARN = aws.ToString(invitation.ResourceShareArn)
 	}
 
 	if !invitationExists || invitationARN == "" {
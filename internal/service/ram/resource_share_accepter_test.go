@@ -129,6 +129,41 @@ func TestAccRAMResourceShareAccepter_resourceAssociation(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShareAccepter_shareARNPattern(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ram_resource_share_accepter.test"
+	principalAssociationResourceName := "aws_ram_principal_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckResourceShareAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareAccepterConfig_shareARNPattern(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareAccepterExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "share_arn", principalAssociationResourceName, "resource_share_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "sender_account_id", "data.aws_caller_identity.sender", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceShareStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "share_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"share_arn_pattern"},
+			},
+		},
+	})
+}
+
 func testAccCheckResourceShareAccepterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMClient(ctx)
@@ -198,6 +233,41 @@ data "aws_caller_identity" "receiver" {}
 `, rName))
 }
 
+func testAccResourceShareAccepterConfig_shareARNPattern(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn_pattern = "^${aws_ram_resource_share.test.arn}$"
+  sender_account_id = data.aws_caller_identity.sender.account_id
+
+  depends_on = [aws_ram_principal_association.test]
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.receiver.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_caller_identity" "receiver" {}
+
+data "aws_caller_identity" "sender" {
+  provider = "awsalternate"
+}
+`, rName))
+}
+
 func testAccResourceShareAccepterConfig_association(rName string) string {
 	return acctest.ConfigCompose(testAccResourceShareAccepterConfig_basic(rName), fmt.Sprintf(`
 resource "aws_ram_resource_association" "test" {
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ram_resource_share_invitations", name="Resource Share Invitations")
+func dataSourceResourceShareInvitations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourceShareInvitationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"invitations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invitation_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invitation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"receiver_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sender_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"share_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ResourceShareInvitationStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceResourceShareInvitationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	input := &ram.GetResourceShareInvitationsInput{}
+
+	if v, ok := d.GetOk("share_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceShareArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	filter := tfslices.PredicateTrue[*awstypes.ResourceShareInvitation]()
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		status := awstypes.ResourceShareInvitationStatus(v.(string))
+		filter = func(v *awstypes.ResourceShareInvitation) bool {
+			return v.Status == status
+		}
+	}
+
+	invitations, err := findResourceShareInvitations(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share Invitations: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("invitations", flattenResourceShareInvitations(invitations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invitations: %s", err)
+	}
+
+	return diags
+}
+
+func flattenResourceShareInvitations(apiObjects []awstypes.ResourceShareInvitation) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"invitation_arn":      aws.ToString(apiObject.ResourceShareInvitationArn),
+			"receiver_account_id": aws.ToString(apiObject.ReceiverAccountId),
+			"sender_account_id":   aws.ToString(apiObject.SenderAccountId),
+			"share_arn":           aws.ToString(apiObject.ResourceShareArn),
+			"share_name":          aws.ToString(apiObject.ResourceShareName),
+			names.AttrStatus:      string(apiObject.Status),
+		}
+
+		if v := apiObject.InvitationTimestamp; v != nil {
+			tfMap["invitation_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
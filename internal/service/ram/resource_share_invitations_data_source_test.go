@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMResourceShareInvitationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ram_resource_share_invitations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareInvitationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.invitation_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.invitation_timestamp"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "invitations.0.receiver_account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invitations.0.sender_account_id", "data.aws_caller_identity.sender", names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invitations.0.share_arn", "aws_ram_resource_share.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.0.share_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.0.status", string(awstypes.ResourceShareInvitationStatusPending)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceShareInvitationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_ram_resource_share_invitations" "test" {
+  share_arns = [aws_ram_principal_association.test.resource_share_arn]
+  status     = "PENDING"
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.receiver.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_caller_identity" "receiver" {}
+
+data "aws_caller_identity" "sender" {
+  provider = "awsalternate"
+}
+`, rName))
+}
@@ -31,6 +31,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Resource Share",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceResourceShareInvitations,
+			TypeName: "aws_ram_resource_share_invitations",
+			Name:     "Resource Share Invitations",
+		},
 	}
 }
 
@@ -311,6 +311,10 @@ func resourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"multi_az_secondary_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -417,7 +421,14 @@ func resourceCluster() *schema.Resource {
 
 				if diff.Id() != "" {
 					if o, n := diff.GetChange(names.AttrAvailabilityZone); !azRelocationEnabled && o.(string) != n.(string) {
-						return errors.New("cannot change `availability_zone` if `availability_zone_relocation_enabled` is not true")
+						// A Multi-AZ cluster's primary compute can be failed over to the secondary Availability Zone.
+						if multiAZ && !diff.HasChange("multi_az") {
+							if secondaryAZ := diff.Get("multi_az_secondary_availability_zone").(string); n.(string) != secondaryAZ {
+								return fmt.Errorf("`availability_zone` of a Multi-AZ cluster can only be changed to the secondary Availability Zone (%s)", secondaryAZ)
+							}
+						} else {
+							return errors.New("cannot change `availability_zone` if `availability_zone_relocation_enabled` is not true")
+						}
 					}
 				}
 
@@ -708,6 +719,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	} else {
 		d.Set("multi_az", v)
 	}
+	if v := rsc.MultiAZSecondary; v != nil {
+		d.Set("multi_az_secondary_availability_zone", v.AvailabilityZone)
+	} else {
+		d.Set("multi_az_secondary_availability_zone", nil)
+	}
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set(names.AttrPreferredMaintenanceWindow, rsc.PreferredMaintenanceWindow)
@@ -902,7 +918,21 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	// Availability Zone cannot be changed at the same time as other settings
-	if d.HasChange(names.AttrAvailabilityZone) {
+	if d.HasChange(names.AttrAvailabilityZone) && d.Get("multi_az").(bool) && !d.HasChange("multi_az") {
+		input := &redshift.FailoverPrimaryComputeInput{
+			ClusterIdentifier: aws.String(d.Id()),
+		}
+
+		_, err := conn.FailoverPrimaryComputeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "failing over Redshift Cluster (%s) primary compute: %s", d.Id(), err)
+		}
+
+		if _, err := waitClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", d.Id(), err)
+		}
+	} else if d.HasChange(names.AttrAvailabilityZone) {
 		input := &redshift.ModifyClusterInput{
 			AvailabilityZone:  aws.String(d.Get(names.AttrAvailabilityZone).(string)),
 			ClusterIdentifier: aws.String(d.Id()),
@@ -965,6 +995,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "modifying Redshift Cluster (%s) multi-AZ: %s", d.Id(), err)
 		}
 
+		// Converting between single-AZ and Multi-AZ is performed as a resize.
+		if _, err = waitClusterResized(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) resize: %s", d.Id(), err)
+		}
+
 		if _, err = waitClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", d.Id(), err)
 		}
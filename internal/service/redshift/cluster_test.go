@@ -12,6 +12,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -922,6 +923,96 @@ func TestAccRedshiftCluster_multiAZ(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftCluster_multiAZConversion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v redshift.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_multiAZ(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary_availability_zone", ""),
+				),
+			},
+			{
+				Config: testAccClusterConfig_multiAZ(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_az_secondary_availability_zone"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_multiAZ(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary_availability_zone", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftCluster_multiAZFailover(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 redshift.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var primaryAZ, secondaryAZ string
+	// The secondary Availability Zone is chosen by Redshift, so the failover configuration's
+	// variable is only populated once the Multi-AZ cluster exists.
+	configVariables := config.Variables{
+		names.AttrAvailabilityZone: config.StringVariable(""),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_multiAZ(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_az_secondary_availability_zone"),
+				),
+			},
+			{
+				PreConfig: func() {
+					primaryAZ = aws.StringValue(v1.AvailabilityZone)
+					secondaryAZ = aws.StringValue(v1.MultiAZSecondary.AvailabilityZone)
+					configVariables[names.AttrAvailabilityZone] = config.StringVariable(secondaryAZ)
+				},
+				Config:          testAccClusterConfig_multiAZFailover(rName),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtTrue),
+					func(s *terraform.State) error {
+						return resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, names.AttrAvailabilityZone, secondaryAZ),
+							resource.TestCheckResourceAttr(resourceName, "multi_az_secondary_availability_zone", primaryAZ),
+						)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn(ctx)
@@ -1885,3 +1976,55 @@ resource "aws_redshift_cluster" "test" {
 }
 `, rName, enabled))
 }
+
+func testAccClusterConfig_multiAZFailover(rName string) string {
+	return acctest.ConfigCompose(
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "ra3.xlplus"
+  number_of_nodes                     = 2
+  cluster_type                        = "multi-node"
+  automated_snapshot_retention_period = 1
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+  encrypted                           = true
+  kms_key_id                          = aws_kms_key.test.arn
+
+  publicly_accessible                  = false
+  availability_zone_relocation_enabled = false
+  multi_az                             = true
+  availability_zone                    = var.availability_zone
+}
+
+variable "availability_zone" {
+  type     = string
+  nullable = false
+}
+`, rName))
+}
@@ -23,6 +23,7 @@ const (
 	clusterStatusAvailable = "available"
 	clusterStatusModifying = "modifying"
 	clusterStatusRebooting = "rebooting"
+	clusterStatusResizing  = "resizing"
 )
 
 const (
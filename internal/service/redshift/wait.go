@@ -78,6 +78,26 @@ func waitClusterUpdated(ctx context.Context, conn *redshift.Redshift, id string,
 	return nil, err
 }
 
+func waitClusterResized(ctx context.Context, conn *redshift.Redshift, id string, timeout time.Duration) (*redshift.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{clusterStatusModifying, clusterStatusResizing},
+		Target:     []string{clusterStatusAvailable},
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshift.Cluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ClusterStatus)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Redshift, id string) (*redshift.Cluster, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: clusterAvailabilityZoneRelocationStatus_PendingValues(),
@@ -100,7 +100,7 @@ func (r *customDomainAssociationResource) Create(ctx context.Context, request re
 	output, err := conn.CreateCustomDomainAssociation(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Redshift Serverless Custom Domain Association", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Redshift Serverless Custom Domain Association", err))
 
 		return
 	}
@@ -120,7 +120,7 @@ func (r *customDomainAssociationResource) Read(ctx context.Context, request reso
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -136,7 +136,7 @@ func (r *customDomainAssociationResource) Read(ctx context.Context, request reso
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Redshift Serverless Custom Domain Association (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -172,7 +172,7 @@ func (r *customDomainAssociationResource) Update(ctx context.Context, request re
 	output, err := conn.UpdateCustomDomainAssociation(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Serverless Custom Domain Association (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Redshift Serverless Custom Domain Association (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -202,7 +202,7 @@ func (r *customDomainAssociationResource) Delete(ctx context.Context, request re
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Serverless Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Redshift Serverless Custom Domain Association (%s)", data.ID.ValueString()), err))
 
 		return
 	}
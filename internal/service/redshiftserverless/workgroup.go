@@ -94,6 +94,13 @@ func resourceWorkgroup() *schema.Resource {
 					},
 				},
 			},
+			"cross_account_vpcs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			names.AttrEndpoint: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -285,6 +292,7 @@ func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	if err := d.Set("config_parameter", flattenConfigParameters(out.ConfigParameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting config_parameter: %s", err)
 	}
+	d.Set("cross_account_vpcs", aws.StringValueSlice(out.CrossAccountVpcs))
 	if err := d.Set(names.AttrEndpoint, []interface{}{flattenEndpoint(out.Endpoint)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting endpoint: %s", err)
 	}
@@ -91,7 +91,7 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 	output, err := conn.CreateIndex(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Resource Explorer Index", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Resource Explorer Index", err))
 
 		return
 	}
@@ -101,7 +101,7 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 
 	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
 	if _, err := waitIndexCreated(ctx, conn, createTimeout); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Resource Explorer Index (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -115,13 +115,13 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 		_, err := conn.UpdateIndexType(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", data.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Resource Explorer Index (%s)", data.ID.ValueString()), err))
 
 			return
 		}
 
 		if _, err := waitIndexUpdated(ctx, conn, createTimeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", data.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", data.ID.ValueString()), err))
 
 			return
 		}
@@ -154,7 +154,7 @@ func (r *resourceIndex) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer Index (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Resource Explorer Index (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -196,14 +196,14 @@ func (r *resourceIndex) Update(ctx context.Context, request resource.UpdateReque
 		_, err := conn.UpdateIndexType(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Resource Explorer Index (%s)", new.ID.ValueString()), err))
 
 			return
 		}
 
 		updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
 		if _, err := waitIndexUpdated(ctx, conn, updateTimeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -231,14 +231,14 @@ func (r *resourceIndex) Delete(ctx context.Context, request resource.DeleteReque
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer Index (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Resource Explorer Index (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, data.Timeouts)
 	if _, err := waitIndexDeleted(ctx, conn, deleteTimeout); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) delete", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Resource Explorer Index (%s) delete", data.ID.ValueString()), err))
 
 		return
 	}
@@ -24,6 +24,7 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 			"defaultView":        testAccView_defaultView,
 			acctest.CtDisappears: testAccView_disappears,
 			"filter":             testAccView_filter,
+			"scope":              testAccView_scope,
 			"tags":               testAccView_tags,
 		},
 		"SearchDataSource": {
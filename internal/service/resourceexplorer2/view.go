@@ -75,6 +75,15 @@ func (r *resourceView) Schema(ctx context.Context, request resource.SchemaReques
 					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z-]+$`), `can include letters, digits, and the dash (-) character`),
 				},
 			},
+			names.AttrScope: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -133,7 +142,7 @@ func (r *resourceView) Create(ctx context.Context, request resource.CreateReques
 	output, err := conn.CreateView(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Resource Explorer View", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Resource Explorer View", err))
 
 		return
 	}
@@ -148,13 +157,14 @@ func (r *resourceView) Create(ctx context.Context, request resource.CreateReques
 		_, err := conn.AssociateDefaultView(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", arn), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("setting Resource Explorer View (%s) as the default", arn), err))
 
 			return
 		}
 	}
 
 	// Set values for unknowns.
+	data.Scope = fwtypes.ARNValue(aws.ToString(output.View.Scope))
 	data.ViewARN = types.StringValue(arn)
 	data.setID()
 
@@ -171,7 +181,7 @@ func (r *resourceView) Read(ctx context.Context, request resource.ReadRequest, r
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -188,7 +198,7 @@ func (r *resourceView) Read(ctx context.Context, request resource.ReadRequest, r
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer View (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Resource Explorer View (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -196,7 +206,7 @@ func (r *resourceView) Read(ctx context.Context, request resource.ReadRequest, r
 	defaultViewARN, err := findDefaultViewARN(ctx, conn)
 
 	if err != nil {
-		response.Diagnostics.AddError("reading Resource Explorer Default View", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading Resource Explorer Default View", err))
 
 		return
 	}
@@ -252,7 +262,7 @@ func (r *resourceView) Update(ctx context.Context, request resource.UpdateReques
 		_, err := conn.UpdateView(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer View (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Resource Explorer View (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -267,7 +277,7 @@ func (r *resourceView) Update(ctx context.Context, request resource.UpdateReques
 			_, err := conn.AssociateDefaultView(ctx, input)
 
 			if err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", new.ID.ValueString()), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("setting Resource Explorer View (%s) as the default", new.ID.ValueString()), err))
 
 				return
 			}
@@ -277,7 +287,7 @@ func (r *resourceView) Update(ctx context.Context, request resource.UpdateReques
 			_, err := conn.DisassociateDefaultView(ctx, input)
 
 			if err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("unsetting Resource Explorer View (%s) as the default", new.ID.ValueString()), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("unsetting Resource Explorer View (%s) as the default", new.ID.ValueString()), err))
 
 				return
 			}
@@ -306,7 +316,7 @@ func (r *resourceView) Delete(ctx context.Context, request resource.DeleteReques
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer View (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Resource Explorer View (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -326,6 +336,7 @@ type viewResourceModel struct {
 	Filters            fwtypes.ListNestedObjectValueOf[searchFilterModel]     `tfsdk:"filters"`
 	ID                 types.String                                           `tfsdk:"id"`
 	IncludedProperties fwtypes.ListNestedObjectValueOf[includedPropertyModel] `tfsdk:"included_property"`
+	Scope              fwtypes.ARN                                            `tfsdk:"scope"`
 	ViewARN            types.String                                           `tfsdk:"arn"`
 	ViewName           types.String                                           `tfsdk:"name"`
 	Tags               types.Map                                              `tfsdk:"tags"`
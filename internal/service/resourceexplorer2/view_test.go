@@ -44,6 +44,7 @@ func testAccView_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "filters.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "included_property.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					acctest.CheckResourceAttrGlobalARN(resourceName, names.AttrScope, "iam", "root"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 				),
 			},
@@ -56,6 +57,37 @@ func testAccView_basic(t *testing.T) {
 	})
 }
 
+func testAccView_scope(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resourceexplorer2.GetViewOutput
+	resourceName := "aws_resourceexplorer2_view.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewConfig_scope(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckViewExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrGlobalARN(resourceName, names.AttrScope, "iam", "root"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccView_defaultView(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v resourceexplorer2.GetViewOutput
@@ -297,6 +329,29 @@ resource "aws_resourceexplorer2_view" "test" {
 `, rName)
 }
 
+func testAccViewConfig_scope(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_resourceexplorer2_index" "test" {
+  type = "LOCAL"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourceexplorer2_view" "test" {
+  name  = %[1]q
+  scope = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+
+  depends_on = [aws_resourceexplorer2_index.test]
+}
+`, rName)
+}
+
 func testAccViewConfig_defaultView(rName string, defaultView bool) string {
 	return fmt.Sprintf(`
 resource "aws_resourceexplorer2_index" "test" {
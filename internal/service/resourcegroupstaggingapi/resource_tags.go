@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_resource_tags", name="Resource Tags")
+func resourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceTagsCreate,
+		ReadWithoutTimeout:   resourceResourceTagsRead,
+		UpdateWithoutTimeout: resourceResourceTagsUpdate,
+		DeleteWithoutTimeout: resourceResourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceResourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arn := d.Get(names.AttrResourceARN).(string)
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	if err := tagResource(ctx, conn, arn, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Resource Tags (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	const (
+		propagationTimeout = 2 * time.Minute
+	)
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findResourceTagsByARN(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Tagging API Resource Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	tags := outputRaw.(tftags.KeyValueTags)
+
+	// Only the keys managed by this resource are tracked.
+	d.Set(names.AttrResourceARN, d.Id())
+	d.Set(names.AttrTags, tags.Only(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))).Map())
+
+	return diags
+}
+
+func resourceResourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	o, n := d.GetChange(names.AttrTags)
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		if err := untagResource(ctx, conn, d.Id(), removedTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		if err := tagResource(ctx, conn, d.Id(), updatedTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	if len(tags) == 0 {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tagging API Resource Tags: %s", d.Id())
+	if err := untagResource(ctx, conn, d.Id(), tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceResourceTagsImport adopts all (non-ignored) tags on the resource, as there are no managed keys yet.
+func resourceResourceTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	tags, err := findResourceTagsByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findResourceTagsByARN(ctx context.Context, conn *resourcegroupstaggingapi.Client, arn string) (tftags.KeyValueTags, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: []string{arn},
+	}
+
+	output, err := conn.GetResources(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.ResourceTagMappingList {
+		if aws.ToString(v.ResourceARN) == arn {
+			return KeyValueTags(ctx, v.Tags), nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func tagResource(ctx context.Context, conn *resourcegroupstaggingapi.Client, arn string, tags tftags.KeyValueTags) error {
+	input := &resourcegroupstaggingapi.TagResourcesInput{
+		ResourceARNList: []string{arn},
+		Tags:            tags.IgnoreAWS().Map(),
+	}
+
+	output, err := conn.TagResources(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	return failedResourcesError(output.FailedResourcesMap)
+}
+
+func untagResource(ctx context.Context, conn *resourcegroupstaggingapi.Client, arn string, tags tftags.KeyValueTags) error {
+	input := &resourcegroupstaggingapi.UntagResourcesInput{
+		ResourceARNList: []string{arn},
+		TagKeys:         tags.IgnoreAWS().Keys(),
+	}
+
+	output, err := conn.UntagResources(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	return failedResourcesError(output.FailedResourcesMap)
+}
+
+func failedResourcesError(apiObject map[string]awstypes.FailureInfo) error {
+	var errs []error
+
+	for arn, v := range apiObject {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
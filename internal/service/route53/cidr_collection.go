@@ -92,7 +92,7 @@ func (r *cidrCollectionResource) Create(ctx context.Context, request resource.Cr
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 CIDR Collection (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Route 53 CIDR Collection (%s)", name), err))
 
 		return
 	}
@@ -124,7 +124,7 @@ func (r *cidrCollectionResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -158,7 +158,7 @@ func (r *cidrCollectionResource) Delete(ctx context.Context, request resource.De
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 CIDR Collection (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Route 53 CIDR Collection (%s)", data.ID.ValueString()), err))
 
 		return
 	}
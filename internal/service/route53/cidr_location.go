@@ -89,7 +89,7 @@ func (r *cidrLocationResource) Create(ctx context.Context, request resource.Crea
 	collection, err := findCIDRCollectionByID(ctx, conn, collectionID)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", collectionID), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", collectionID), err))
 
 		return
 	}
@@ -108,7 +108,7 @@ func (r *cidrLocationResource) Create(ctx context.Context, request resource.Crea
 	_, err = conn.ChangeCidrCollection(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 CIDR Location (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Route 53 CIDR Location (%s)", name), err))
 
 		return
 	}
@@ -126,7 +126,7 @@ func (r *cidrLocationResource) Read(ctx context.Context, request resource.ReadRe
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -143,7 +143,7 @@ func (r *cidrLocationResource) Read(ctx context.Context, request resource.ReadRe
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Location (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 CIDR Location (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -177,7 +177,7 @@ func (r *cidrLocationResource) Update(ctx context.Context, request resource.Upda
 	collection, err := findCIDRCollectionByID(ctx, conn, new.CIDRCollectionID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", new.CIDRCollectionID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", new.CIDRCollectionID.ValueString()), err))
 
 		return
 	}
@@ -202,7 +202,7 @@ func (r *cidrLocationResource) Update(ctx context.Context, request resource.Upda
 		_, err = conn.ChangeCidrCollection(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("adding CIDR blocks to Route 53 CIDR Location (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("adding CIDR blocks to Route 53 CIDR Location (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -224,7 +224,7 @@ func (r *cidrLocationResource) Update(ctx context.Context, request resource.Upda
 		_, err = conn.ChangeCidrCollection(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("removing CIDR blocks from Route 53 CIDR Location (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("removing CIDR blocks from Route 53 CIDR Location (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -245,7 +245,7 @@ func (r *cidrLocationResource) Delete(ctx context.Context, request resource.Dele
 	collection, err := findCIDRCollectionByID(ctx, conn, data.CIDRCollectionID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", data.CIDRCollectionID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", data.CIDRCollectionID.ValueString()), err))
 
 		return
 	}
@@ -271,7 +271,7 @@ func (r *cidrLocationResource) Delete(ctx context.Context, request resource.Dele
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 CIDR Location (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Route 53 CIDR Location (%s)", data.ID.ValueString()), err))
 
 		return
 	}
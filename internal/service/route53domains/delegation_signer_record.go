@@ -122,13 +122,13 @@ func (r *delegationSignerRecordResource) Create(ctx context.Context, request res
 	output, err := conn.AssociateDelegationSignerToDomain(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Route 53 Domains Delegation Signer Record", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Route 53 Domains Delegation Signer Record", err))
 
 		return
 	}
 
 	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError("waiting for Route 53 Domains Delegation Signer Record create", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("waiting for Route 53 Domains Delegation Signer Record create", err))
 
 		return
 	}
@@ -142,7 +142,7 @@ func (r *delegationSignerRecordResource) Create(ctx context.Context, request res
 	dnssecKey, err := findDNSSECKeyByThreePartKey(ctx, conn, data.DomainName.ValueString(), int(signingAttributes.Flags.ValueInt64()), signingAttributes.PublicKey.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s) DNSSEC key", data.DomainName.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 Domains Domain (%s) DNSSEC key", data.DomainName.ValueString()), err))
 
 		return
 	}
@@ -162,7 +162,7 @@ func (r *delegationSignerRecordResource) Read(ctx context.Context, request resou
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -179,7 +179,7 @@ func (r *delegationSignerRecordResource) Read(ctx context.Context, request resou
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s) DNSSEC key", data.DomainName.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Route 53 Domains Domain (%s) DNSSEC key", data.DomainName.ValueString()), err))
 
 		return
 	}
@@ -211,14 +211,14 @@ func (r *delegationSignerRecordResource) Delete(ctx context.Context, request res
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 Domains Delegation Signer Record (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Route 53 Domains Delegation Signer Record (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
 		if !errs.Contains(err, "The DNSSEC you specified is not found on domain") {
-			response.Diagnostics.AddError("waiting for Route 53 Domains Delegation Signer Record delete", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("waiting for Route 53 Domains Delegation Signer Record delete", err))
 
 			return
 		}
@@ -162,7 +162,7 @@ func (r *directoryBucketResource) Create(ctx context.Context, request resource.C
 	_, err := conn.CreateBucket(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Bucket (%s)", data.Bucket.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating S3 Directory Bucket (%s)", data.Bucket.ValueString()), err))
 
 		return
 	}
@@ -184,7 +184,7 @@ func (r *directoryBucketResource) Read(ctx context.Context, request resource.Rea
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -201,7 +201,7 @@ func (r *directoryBucketResource) Read(ctx context.Context, request resource.Rea
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading S3 Directory Bucket (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -261,7 +261,7 @@ func (r *directoryBucketResource) Delete(ctx context.Context, request resource.D
 			_, err = emptyDirectoryBucket(ctx, conn, data.ID.ValueString())
 
 			if err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("emptying S3 Directory Bucket (%s)", data.ID.ValueString()), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("emptying S3 Directory Bucket (%s)", data.ID.ValueString()), err))
 
 				return
 			}
@@ -277,7 +277,7 @@ func (r *directoryBucketResource) Delete(ctx context.Context, request resource.D
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Bucket (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting S3 Directory Bucket (%s)", data.ID.ValueString()), err))
 
 		return
 	}
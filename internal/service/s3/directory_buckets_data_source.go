@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -67,7 +68,7 @@ func (d *directoryBucketsDataSource) Read(ctx context.Context, request datasourc
 		page, err := pages.NextPage(ctx)
 
 		if err != nil {
-			response.Diagnostics.AddError("listing S3 Directory Buckets", err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing S3 Directory Buckets", err))
 
 			return
 		}
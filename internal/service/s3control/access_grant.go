@@ -184,7 +184,7 @@ func (r *accessGrantResource) Create(ctx context.Context, request resource.Creat
 	}, errCodeInvalidRequest, "Invalid Grantee in the request")
 
 	if err != nil {
-		response.Diagnostics.AddError("creating S3 Access Grant", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating S3 Access Grant", err))
 
 		return
 	}
@@ -209,7 +209,7 @@ func (r *accessGrantResource) Read(ctx context.Context, request resource.ReadReq
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -226,7 +226,7 @@ func (r *accessGrantResource) Read(ctx context.Context, request resource.ReadReq
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grant (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading S3 Access Grant (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -244,7 +244,7 @@ func (r *accessGrantResource) Read(ctx context.Context, request resource.ReadReq
 	tags, err := listTags(ctx, conn, data.AccessGrantARN.ValueString(), data.AccountID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Access Grant (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("listing tags for S3 Access Grant (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -273,7 +273,7 @@ func (r *accessGrantResource) Update(ctx context.Context, request resource.Updat
 
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
 		if err := updateTags(ctx, conn, new.AccessGrantARN.ValueString(), new.AccountID.ValueString(), oldTagsAll, newTagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating tags for S3 Access Grant (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating tags for S3 Access Grant (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -303,7 +303,7 @@ func (r *accessGrantResource) Delete(ctx context.Context, request resource.Delet
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Access Grant (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting S3 Access Grant (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -110,7 +110,7 @@ func (r *accessGrantsInstanceResource) Create(ctx context.Context, request resou
 	output, err := conn.CreateAccessGrantsInstance(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Access Grants Instance (%s)", data.AccountID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating S3 Access Grants Instance (%s)", data.AccountID.ValueString()), err))
 
 		return
 	}
@@ -134,7 +134,7 @@ func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resourc
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -151,7 +151,7 @@ func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resourc
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading S3 Access Grants Instance (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -164,7 +164,7 @@ func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resourc
 	tags, err := listTags(ctx, conn, data.AccessGrantsInstanceARN.ValueString(), data.AccountID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Access Grants Instance (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("listing tags for S3 Access Grants Instance (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -194,7 +194,7 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 	if oldARN, newARN := old.IdentityCenterARN, new.IdentityCenterARN; !newARN.Equal(oldARN) {
 		if !oldARN.IsNull() {
 			if err := disassociateAccessGrantsInstanceIdentityCenterInstance(ctx, conn, old.ID.ValueString()); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("dissociating S3 Access Grants Instance (%s) IAM Identity Center instance", old.ID.ValueString()), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("dissociating S3 Access Grants Instance (%s) IAM Identity Center instance", old.ID.ValueString()), err))
 
 				return
 			}
@@ -202,7 +202,7 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 
 		if !newARN.IsNull() {
 			if err := associateAccessGrantsInstanceIdentityCenterInstance(ctx, conn, new.ID.ValueString(), newARN.ValueString()); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("associating S3 Access Grants Instance (%s) IAM Identity Center instance (%s)", new.ID.ValueString(), newARN.ValueString()), err.Error())
+				response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("associating S3 Access Grants Instance (%s) IAM Identity Center instance (%s)", new.ID.ValueString(), newARN.ValueString()), err))
 
 				return
 			}
@@ -211,7 +211,7 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
 		if err := updateTags(ctx, conn, new.AccessGrantsInstanceARN.ValueString(), new.AccountID.ValueString(), oldTagsAll, newTagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating tags for S3 Access Grants Instance (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating tags for S3 Access Grants Instance (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -233,7 +233,7 @@ func (r *accessGrantsInstanceResource) Delete(ctx context.Context, request resou
 
 	if !data.IdentityCenterARN.IsNull() {
 		if err := disassociateAccessGrantsInstanceIdentityCenterInstance(ctx, conn, data.ID.ValueString()); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("dissociating S3 Access Grants Instance (%s) IAM Identity Center instance", data.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("dissociating S3 Access Grants Instance (%s) IAM Identity Center instance", data.ID.ValueString()), err))
 
 			return
 		}
@@ -248,7 +248,7 @@ func (r *accessGrantsInstanceResource) Delete(ctx context.Context, request resou
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Access Grants Instance (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting S3 Access Grants Instance (%s)", data.ID.ValueString()), err))
 
 		return
 	}
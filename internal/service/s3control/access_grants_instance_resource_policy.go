@@ -87,7 +87,7 @@ func (r *accessGrantsInstanceResourcePolicyResource) Create(ctx context.Context,
 	_, err := conn.PutAccessGrantsInstanceResourcePolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Access Grants Instance Resource Policy (%s)", data.AccountID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating S3 Access Grants Instance Resource Policy (%s)", data.AccountID.ValueString()), err))
 
 		return
 	}
@@ -108,7 +108,7 @@ func (r *accessGrantsInstanceResourcePolicyResource) Read(ctx context.Context, r
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -125,7 +125,7 @@ func (r *accessGrantsInstanceResourcePolicyResource) Read(ctx context.Context, r
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance Resource Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading S3 Access Grants Instance Resource Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -164,7 +164,7 @@ func (r *accessGrantsInstanceResourcePolicyResource) Update(ctx context.Context,
 	_, err := conn.PutAccessGrantsInstanceResourcePolicy(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Access Grants Instance Resource Policy (%s)", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating S3 Access Grants Instance Resource Policy (%s)", new.ID.ValueString()), err))
 
 		return
 	}
@@ -192,7 +192,7 @@ func (r *accessGrantsInstanceResourcePolicyResource) Delete(ctx context.Context,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Access Grants Instance Resource Policy (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting S3 Access Grants Instance Resource Policy (%s)", data.ID.ValueString()), err))
 
 		return
 	}
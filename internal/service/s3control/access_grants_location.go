@@ -116,7 +116,7 @@ func (r *accessGrantsLocationResource) Create(ctx context.Context, request resou
 	}, errCodeInvalidIAMRole)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Access Grants Location (%s)", data.LocationScope.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating S3 Access Grants Location (%s)", data.LocationScope.ValueString()), err))
 
 		return
 	}
@@ -140,7 +140,7 @@ func (r *accessGrantsLocationResource) Read(ctx context.Context, request resourc
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -157,7 +157,7 @@ func (r *accessGrantsLocationResource) Read(ctx context.Context, request resourc
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Location (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading S3 Access Grants Location (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -171,7 +171,7 @@ func (r *accessGrantsLocationResource) Read(ctx context.Context, request resourc
 	tags, err := listTags(ctx, conn, data.AccessGrantsLocationARN.ValueString(), data.AccountID.ValueString())
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Access Grants Location (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("listing tags for S3 Access Grants Location (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -210,7 +210,7 @@ func (r *accessGrantsLocationResource) Update(ctx context.Context, request resou
 		}, errCodeInvalidIAMRole)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Access Grants Location (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating S3 Access Grants Location (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -218,7 +218,7 @@ func (r *accessGrantsLocationResource) Update(ctx context.Context, request resou
 
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
 		if err := updateTags(ctx, conn, new.AccessGrantsLocationARN.ValueString(), new.AccountID.ValueString(), oldTagsAll, newTagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating tags for S3 Access Grants Location (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating tags for S3 Access Grants Location (%s)", new.ID.ValueString()), err))
 
 			return
 		}
@@ -253,7 +253,7 @@ func (r *accessGrantsLocationResource) Delete(ctx context.Context, request resou
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Access Grants Location (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting S3 Access Grants Location (%s)", data.ID.ValueString()), err))
 
 		return
 	}
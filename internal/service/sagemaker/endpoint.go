@@ -263,11 +263,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(name)
 
-	describeInput := &sagemaker.DescribeEndpointInput{
-		EndpointName: aws.String(name),
-	}
-
-	if err := conn.WaitUntilEndpointInServiceWithContext(ctx, describeInput); err != nil {
+	if _, err := WaitEndpointInService(ctx, conn, name); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Endpoint (%s) to be in service: %s", name, err)
 	}
 
@@ -320,13 +316,14 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker Endpoint (%s): %s", d.Id(), err)
 		}
 
-		describeInput := &sagemaker.DescribeEndpointInput{
-			EndpointName: aws.String(d.Id()),
-		}
+		output, err := WaitEndpointUpdated(ctx, conn, d.Id())
 
-		err := conn.WaitUntilEndpointInServiceWithContext(ctx, describeInput)
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Endpoint (%s) to be in service: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Endpoint (%s) update: %s", d.Id(), err)
+		}
+
+		if endpointConfigName := d.Get("endpoint_config_name").(string); aws.StringValue(output.EndpointConfigName) != endpointConfigName {
+			diags = sdkdiag.AppendErrorf(diags, "updating SageMaker Endpoint (%s): deployment of endpoint configuration (%s) was rolled back to (%s): %s", d.Id(), endpointConfigName, aws.StringValue(output.EndpointConfigName), aws.StringValue(output.FailureReason))
 		}
 	}
 
@@ -140,6 +140,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceImageVersion,
 			TypeName: "aws_sagemaker_image_version",
 		},
+		{
+			Factory:  ResourceInferenceComponent,
+			TypeName: "aws_sagemaker_inference_component",
+			Name:     "Inference Component",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceModel,
 			TypeName: "aws_sagemaker_model",
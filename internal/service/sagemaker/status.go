@@ -40,6 +40,40 @@ func StatusNotebookInstance(ctx context.Context, conn *sagemaker.SageMaker, note
 	}
 }
 
+// StatusEndpoint fetches the Endpoint and its Status
+func StatusEndpoint(ctx context.Context, conn *sagemaker.SageMaker, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.EndpointStatus), nil
+	}
+}
+
+// StatusInferenceComponent fetches the InferenceComponent and its Status
+func StatusInferenceComponent(ctx context.Context, conn *sagemaker.SageMaker, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInferenceComponentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.InferenceComponentStatus), nil
+	}
+}
+
 // StatusModelPackageGroup fetches the ModelPackageGroup and its Status
 func StatusModelPackageGroup(ctx context.Context, conn *sagemaker.SageMaker, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -42,6 +42,10 @@ const (
 	SpaceInServiceTimeout              = 10 * time.Minute
 	MonitoringScheduleScheduledTimeout = 2 * time.Minute
 	MonitoringScheduleStoppedTimeout   = 2 * time.Minute
+	EndpointInServiceTimeout           = 60 * time.Minute
+	EndpointUpdatedTimeout             = 120 * time.Minute
+	InferenceComponentInServiceTimeout = 60 * time.Minute
+	InferenceComponentDeletedTimeout   = 30 * time.Minute
 )
 
 // WaitNotebookInstanceInService waits for a NotebookInstance to return InService
@@ -691,3 +695,97 @@ func WaitMonitoringScheduleNotFound(ctx context.Context, conn *sagemaker.SageMak
 
 	return nil, err
 }
+
+// WaitEndpointInService waits for a newly created Endpoint to return InService
+func WaitEndpointInService(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{sagemaker.EndpointStatusCreating},
+		Target:  []string{sagemaker.EndpointStatusInService},
+		Refresh: StatusEndpoint(ctx, conn, name),
+		Timeout: EndpointInServiceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeEndpointOutput); ok {
+		if status := aws.StringValue(output.EndpointStatus); status == sagemaker.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitEndpointUpdated waits for an Endpoint deployment to complete.
+// A deployment that is automatically rolled back also returns the Endpoint to InService,
+// so callers must compare the returned EndpointConfigName with the requested one.
+func WaitEndpointUpdated(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			sagemaker.EndpointStatusUpdating,
+			sagemaker.EndpointStatusSystemUpdating,
+			sagemaker.EndpointStatusRollingBack,
+		},
+		Target:  []string{sagemaker.EndpointStatusInService},
+		Refresh: StatusEndpoint(ctx, conn, name),
+		Timeout: EndpointUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeEndpointOutput); ok {
+		if status := aws.StringValue(output.EndpointStatus); status == sagemaker.EndpointStatusFailed || status == sagemaker.EndpointStatusUpdateRollbackFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitInferenceComponentInService waits for an InferenceComponent to return InService
+func WaitInferenceComponentInService(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{sagemaker.InferenceComponentStatusCreating, sagemaker.InferenceComponentStatusUpdating},
+		Target:  []string{sagemaker.InferenceComponentStatusInService},
+		Refresh: StatusInferenceComponent(ctx, conn, name),
+		Timeout: InferenceComponentInServiceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if status, reason := aws.StringValue(output.InferenceComponentStatus), aws.StringValue(output.FailureReason); status == sagemaker.InferenceComponentStatusFailed && reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitInferenceComponentDeleted waits for an InferenceComponent to be deleted
+func WaitInferenceComponentDeleted(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{sagemaker.InferenceComponentStatusDeleting},
+		Target:  []string{},
+		Refresh: StatusInferenceComponent(ctx, conn, name),
+		Timeout: InferenceComponentDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if status, reason := aws.StringValue(output.InferenceComponentStatus), aws.StringValue(output.FailureReason); status == sagemaker.InferenceComponentStatusFailed && reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -5,6 +5,8 @@ package scheduler
 
 // Exports for use in tests only.
 var (
-	FindScheduleByTwoPartKey = findScheduleByTwoPartKey
-	ResourceSchedule         = resourceSchedule
+	FindScheduleByTwoPartKey        = findScheduleByTwoPartKey
+	PolicyAllowsServiceToAssumeRole = policyAllowsServiceToAssumeRole
+	ResourceSchedule                = resourceSchedule
+	RoleNameFromARN                 = roleNameFromARN
 )
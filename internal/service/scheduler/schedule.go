@@ -44,6 +44,8 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -154,9 +156,12 @@ func resourceSchedule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrARN: {
-										Type:             schema.TypeString,
-										Required:         true,
-										ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+											verify.ValidARN,
+											validation.StringMatch(regexache.MustCompile(`^arn:[\w-]+:sqs:`), "must be the ARN of an SQS queue"),
+										)),
 									},
 								},
 							},
@@ -423,6 +428,11 @@ func resourceSchedule() *schema.Resource {
 					},
 				},
 			},
+			"validate_target_role": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -431,6 +441,58 @@ const (
 	ResNameSchedule = "Schedule"
 )
 
+func resourceScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.Get("flexible_time_window").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		mode, maximumWindow := types.FlexibleTimeWindowMode(tfMap[names.AttrMode].(string)), tfMap["maximum_window_in_minutes"].(int)
+
+		switch {
+		case mode == types.FlexibleTimeWindowModeFlexible && maximumWindow == 0 && d.NewValueKnown("flexible_time_window.0.maximum_window_in_minutes"):
+			return fmt.Errorf("flexible_time_window.0.maximum_window_in_minutes is required when mode is %s", mode)
+		case mode == types.FlexibleTimeWindowModeOff && maximumWindow != 0:
+			return fmt.Errorf("flexible_time_window.0.maximum_window_in_minutes must not be set when mode is %s", mode)
+		}
+	}
+
+	if startDate, endDate := d.Get("start_date").(string), d.Get("end_date").(string); startDate != "" && endDate != "" {
+		start, _ := time.Parse(time.RFC3339, startDate)
+		end, _ := time.Parse(time.RFC3339, endDate)
+
+		if !end.After(start) {
+			return fmt.Errorf("end_date (%s) must be after start_date (%s)", endDate, startDate)
+		}
+	}
+
+	if !d.Get("validate_target_role").(bool) {
+		return nil
+	}
+
+	// Only validate when the role or target might have changed.
+	if d.Id() != "" && !d.HasChanges(names.AttrTarget, "validate_target_role") {
+		return nil
+	}
+
+	for _, key := range []string{"target.0.arn", "target.0.role_arn", "target.0.dead_letter_config.0.arn", "target.0.ecs_parameters.0.task_definition_arn"} {
+		if !d.NewValueKnown(key) {
+			log.Printf("[DEBUG] Skipping validation of EventBridge Scheduler Schedule execution role: %s is not yet known", key)
+			return nil
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+	servicePrincipal := fmt.Sprintf("scheduler.%s", meta.(*conns.AWSClient).DNSSuffix(ctx))
+	roleARN := d.Get("target.0.role_arn").(string)
+	targetARN := d.Get("target.0.arn").(string)
+	taskDefinitionARN := d.Get("target.0.ecs_parameters.0.task_definition_arn").(string)
+	deadLetterARN := d.Get("target.0.dead_letter_config.0.arn").(string)
+
+	if err := validateTargetRole(ctx, conn, servicePrincipal, roleARN, targetARN, taskDefinitionARN, deadLetterARN); err != nil {
+		return fmt.Errorf("validating target.0.role_arn: %w", err)
+	}
+
+	return nil
+}
+
 func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
@@ -452,6 +452,36 @@ func TestAccSchedulerSchedule_flexibleTimeWindow(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_flexibleTimeWindowValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowMode(name, "FLEXIBLE", "null"),
+				ExpectError: regexache.MustCompile(`maximum_window_in_minutes is required when mode is FLEXIBLE`),
+			},
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowMode(name, "OFF", "10"),
+				ExpectError: regexache.MustCompile(`maximum_window_in_minutes must not be set when mode is OFF`),
+			},
+			{
+				Config:      testAccScheduleConfig_startAndEndDate(name, "2100-01-01T01:02:03Z", "2099-01-01T01:00:00Z"),
+				ExpectError: regexache.MustCompile(`end_date \(2099-01-01T01:00:00Z\) must be after start_date`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_groupName(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1431,6 +1461,81 @@ func TestAccSchedulerSchedule_targetRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_validateTargetRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			// The role and its policies must exist before the schedule is planned for them to be validated.
+			{
+				Config: testAccScheduleConfig_validateTargetRoleBase(name, false),
+			},
+			{
+				Config:      testAccScheduleConfig_validateTargetRole(name, false),
+				ExpectError: regexache.MustCompile(`is not allowed to perform sqs:SendMessage`),
+			},
+			{
+				Config: testAccScheduleConfig_validateTargetRoleBase(name, true),
+			},
+			{
+				Config: testAccScheduleConfig_validateTargetRole(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "validate_target_role", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"validate_target_role",
+				},
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_validateTargetRoleTrustPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_validateTargetRoleUntrustedBase(name),
+			},
+			{
+				Config:      testAccScheduleConfig_validateTargetRoleUntrusted(name),
+				ExpectError: regexache.MustCompile(`trust policy does not allow scheduler\.`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_targetSageMakerPipelineParameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1774,6 +1879,58 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
+func testAccScheduleConfig_flexibleTimeWindowMode(name, mode, window string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    maximum_window_in_minutes = %[3]s
+    mode                      = %[2]q
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, mode, window),
+	)
+}
+
+func testAccScheduleConfig_startAndEndDate(name, startDate, endDate string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  start_date = %[2]q
+  end_date   = %[3]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, startDate, endDate),
+	)
+}
+
 func testAccScheduleConfig_groupName(name string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
@@ -2496,6 +2653,121 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
+func testAccScheduleConfig_validateTargetRoleBase(name string, allowSendMessage bool) string {
+	// SQS queue policies can grant access, so only an explicit deny fails the validation.
+	effect := "Deny"
+
+	if allowSendMessage {
+		effect = "Allow"
+	}
+
+	policy := fmt.Sprintf(`
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = %[1]q
+      Action   = "sqs:SendMessage"
+      Resource = [aws_sqs_queue.test.arn, aws_sqs_queue.dlq.arn]
+    }]
+  })
+}
+`, effect)
+
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+`, name),
+		policy,
+	)
+}
+
+func testAccScheduleConfig_validateTargetRole(name string, allowSendMessage bool) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_validateTargetRoleBase(name, allowSendMessage),
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+
+    dead_letter_config {
+      arn = aws_sqs_queue.dlq.arn
+    }
+  }
+
+  validate_target_role = true
+}
+`, name),
+	)
+}
+
+func testAccScheduleConfig_validateTargetRoleUntrustedBase(name string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "main" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "lambda.${data.aws_partition.main.dns_suffix}"
+      }
+    }]
+  })
+}
+`, name)
+}
+
+func testAccScheduleConfig_validateTargetRoleUntrusted(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_validateTargetRoleUntrustedBase(name),
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  validate_target_role = true
+}
+`, name),
+	)
+}
+
 func testAccScheduleConfig_targetSageMakerPipelineParameters1(name, name1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// targetRoleActions returns the IAM actions that the execution role must be allowed to perform
+// to invoke the specified templated target, keyed by the resource the actions apply to.
+// Universal targets (arn:aws:scheduler:::aws-sdk:service:apiAction) are not supported, as
+// SDK service names don't reliably map to IAM service prefixes.
+func targetRoleActions(targetARN string, ecsTaskDefinitionARN string) (map[string][]string, bool) {
+	v, err := arn.Parse(targetARN)
+
+	if err != nil {
+		return nil, false
+	}
+
+	switch v.Service {
+	case "ecs":
+		if ecsTaskDefinitionARN == "" {
+			return nil, false
+		}
+		return map[string][]string{ecsTaskDefinitionARN: {"ecs:RunTask"}}, true
+	case "events":
+		return map[string][]string{targetARN: {"events:PutEvents"}}, true
+	case "kinesis":
+		return map[string][]string{targetARN: {"kinesis:PutRecord"}}, true
+	case "lambda":
+		return map[string][]string{targetARN: {"lambda:InvokeFunction"}}, true
+	case "sagemaker":
+		return map[string][]string{targetARN: {"sagemaker:StartPipelineExecution"}}, true
+	case "sns":
+		return map[string][]string{targetARN: {"sns:Publish"}}, true
+	case "sqs":
+		return map[string][]string{targetARN: {"sqs:SendMessage"}}, true
+	case "states":
+		return map[string][]string{targetARN: {"states:StartExecution"}}, true
+	}
+
+	return nil, false
+}
+
+// validateTargetRole verifies that the specified execution role can be assumed by EventBridge Scheduler
+// and is allowed to invoke the target and, if configured, send undeliverable events to the dead-letter queue.
+func validateTargetRole(ctx context.Context, conn *iam.Client, servicePrincipal, roleARN, targetARN, ecsTaskDefinitionARN, deadLetterARN string) error {
+	roleName, err := roleNameFromARN(roleARN)
+
+	if err != nil {
+		return err
+	}
+
+	role, err := tfiam.FindRoleByName(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("execution role (%s) not found", roleARN)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading execution role (%s): %w", roleARN, err)
+	}
+
+	trustPolicy, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		return fmt.Errorf("decoding execution role (%s) trust policy: %w", roleARN, err)
+	}
+
+	trusted, err := policyAllowsServiceToAssumeRole(trustPolicy, servicePrincipal)
+
+	if err != nil {
+		return fmt.Errorf("parsing execution role (%s) trust policy: %w", roleARN, err)
+	}
+
+	if !trusted {
+		return fmt.Errorf("execution role (%s) trust policy does not allow %s to assume the role", roleARN, servicePrincipal)
+	}
+
+	actions, ok := targetRoleActions(targetARN, ecsTaskDefinitionARN)
+
+	if !ok {
+		log.Printf("[DEBUG] Skipping permissions validation of EventBridge Scheduler execution role (%s) for target (%s)", roleARN, targetARN)
+		actions = make(map[string][]string)
+	}
+
+	if deadLetterARN != "" {
+		actions[deadLetterARN] = append(actions[deadLetterARN], "sqs:SendMessage")
+	}
+
+	for resourceARN, actionNames := range actions {
+		explicitlyDenied, implicitlyDenied, err := findDeniedActions(ctx, conn, roleARN, resourceARN, actionNames)
+
+		// The caller may not be allowed to simulate policies. Don't block the plan.
+		if err != nil {
+			log.Printf("[WARN] Unable to validate permissions of EventBridge Scheduler execution role (%s): %s", roleARN, err)
+			return nil
+		}
+
+		// The simulation doesn't evaluate resource-based policies, which may grant the role access.
+		if len(implicitlyDenied) > 0 && resourceHasResourcePolicy(resourceARN) {
+			log.Printf("[WARN] EventBridge Scheduler execution role (%s) policies don't allow %s on %s; access must be granted by the resource's policy", roleARN, strings.Join(implicitlyDenied, ", "), resourceARN)
+			implicitlyDenied = nil
+		}
+
+		if denied := slices.Concat(explicitlyDenied, implicitlyDenied); len(denied) > 0 {
+			return fmt.Errorf("execution role (%s) is not allowed to perform %s on %s", roleARN, strings.Join(denied, ", "), resourceARN)
+		}
+	}
+
+	return nil
+}
+
+// resourceHasResourcePolicy returns whether the specified resource can have a resource-based policy
+// that grants an execution role access to it.
+func resourceHasResourcePolicy(resourceARN string) bool {
+	v, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return false
+	}
+
+	switch v.Service {
+	case "sns", "sqs":
+		return true
+	}
+
+	return false
+}
+
+// findDeniedActions simulates the execution role's identity-based policies and returns the
+// actions that are explicitly denied and those that are implicitly denied (not allowed).
+func findDeniedActions(ctx context.Context, conn *iam.Client, roleARN, resourceARN string, actionNames []string) ([]string, []string, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     actionNames,
+		PolicySourceArn: aws.String(roleARN),
+		ResourceArns:    []string{resourceARN},
+	}
+	var explicitlyDenied, implicitlyDenied []string
+
+	pages := iam.NewSimulatePrincipalPolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, v := range page.EvaluationResults {
+			switch v.EvalDecision {
+			case iamtypes.PolicyEvaluationDecisionTypeAllowed:
+			case iamtypes.PolicyEvaluationDecisionTypeImplicitDeny:
+				implicitlyDenied = append(implicitlyDenied, aws.ToString(v.EvalActionName))
+			default:
+				explicitlyDenied = append(explicitlyDenied, aws.ToString(v.EvalActionName))
+			}
+		}
+	}
+
+	return explicitlyDenied, implicitlyDenied, nil
+}
+
+func policyAllowsServiceToAssumeRole(policy, servicePrincipal string) (bool, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	// Statement can be either a single statement or a list of statements.
+	var statements []*tfiam.IAMPolicyStatement
+
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement tfiam.IAMPolicyStatement
+
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return false, err
+		}
+
+		statements = append(statements, &statement)
+	}
+
+	for _, statement := range statements {
+		if statement.Effect != "Allow" {
+			continue
+		}
+
+		if !slices.ContainsFunc(policyStatementValues(statement.Actions), func(v string) bool {
+			return v == "*" || strings.EqualFold(v, "sts:*") || strings.EqualFold(v, "sts:AssumeRole")
+		}) {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			if principal.Type == "*" || (principal.Type == "Service" && slices.Contains(policyStatementValues(principal.Identifiers), servicePrincipal)) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func policyStatementValues(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
+
+func roleNameFromARN(v string) (string, error) {
+	roleARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	resource, ok := strings.CutPrefix(roleARN.Resource, "role/")
+
+	if !ok {
+		return "", fmt.Errorf("%q is not an IAM role ARN", v)
+	}
+
+	// Strip any path.
+	return resource[strings.LastIndex(resource, "/")+1:], nil
+}
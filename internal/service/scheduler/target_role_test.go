@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"testing"
+
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+)
+
+func TestPolicyAllowsServiceToAssumeRole(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Policy   string
+		Expected bool
+		Fails    bool
+	}{
+		"single statement": {
+			Policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"scheduler.amazonaws.com"}}}`,
+			Expected: true,
+		},
+		"statement list": {
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"Service":["lambda.amazonaws.com","scheduler.amazonaws.com"]}}]}`,
+			Expected: true,
+		},
+		"wildcard principal": {
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":"*"}]}`,
+			Expected: true,
+		},
+		"other service": {
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"lambda.amazonaws.com"}}]}`,
+			Expected: false,
+		},
+		"deny": {
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sts:AssumeRole","Principal":{"Service":"scheduler.amazonaws.com"}}]}`,
+			Expected: false,
+		},
+		"other action": {
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:TagSession","Principal":{"Service":"scheduler.amazonaws.com"}}]}`,
+			Expected: false,
+		},
+		"invalid JSON": {
+			Policy: `{`,
+			Fails:  true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfscheduler.PolicyAllowsServiceToAssumeRole(tc.Policy, "scheduler.amazonaws.com")
+
+			if tc.Fails {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			if got != tc.Expected {
+				t.Errorf("expected %t, got: %t", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestRoleNameFromARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ARN   string
+		Name  string
+		Fails bool
+	}{
+		{
+			ARN:  "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			Name: "test",
+		},
+		{
+			ARN:  "arn:aws:iam::123456789012:role/service-role/test", //lintignore:AWSAT005
+			Name: "test",
+		},
+		{
+			ARN:   "arn:aws:iam::123456789012:user/test", //lintignore:AWSAT005
+			Fails: true,
+		},
+		{
+			ARN:   "test",
+			Fails: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.ARN, func(t *testing.T) {
+			t.Parallel()
+
+			name, err := tfscheduler.RoleNameFromARN(tc.ARN)
+
+			if tc.Fails {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got: %s", err)
+			}
+
+			if name != tc.Name {
+				t.Errorf("expected name %s, got: %s", tc.Name, name)
+			}
+		})
+	}
+}
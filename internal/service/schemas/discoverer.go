@@ -41,6 +41,11 @@ func resourceDiscoverer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cross_account": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -66,8 +71,9 @@ func resourceDiscovererCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	sourceARN := d.Get("source_arn").(string)
 	input := &schemas.CreateDiscovererInput{
-		SourceArn: aws.String(sourceARN),
-		Tags:      getTagsIn(ctx),
+		CrossAccount: aws.Bool(d.Get("cross_account").(bool)),
+		SourceArn:    aws.String(sourceARN),
+		Tags:         getTagsIn(ctx),
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -102,6 +108,7 @@ func resourceDiscovererRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set(names.AttrARN, output.DiscovererArn)
+	d.Set("cross_account", output.CrossAccount)
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("source_arn", output.SourceArn)
 
@@ -112,8 +119,9 @@ func resourceDiscovererUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchemasClient(ctx)
 
-	if d.HasChange(names.AttrDescription) {
+	if d.HasChanges("cross_account", names.AttrDescription) {
 		input := &schemas.UpdateDiscovererInput{
+			CrossAccount: aws.Bool(d.Get("cross_account").(bool)),
 			DiscovererId: aws.String(d.Id()),
 			Description:  aws.String(d.Get(names.AttrDescription).(string)),
 		}
@@ -36,6 +36,7 @@ func TestAccSchemasDiscoverer_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDiscovererExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "schemas", fmt.Sprintf("discoverer/events-event-bus-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "cross_account", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 				),
@@ -115,6 +116,41 @@ func TestAccSchemasDiscoverer_description(t *testing.T) {
 	})
 }
 
+func TestAccSchemasDiscoverer_crossAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v schemas.DescribeDiscovererOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_schemas_discoverer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.SchemasEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchemasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDiscovererDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscovererConfig_crossAccount(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiscovererExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cross_account", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDiscovererConfig_crossAccount(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiscovererExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cross_account", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSchemasDiscoverer_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v schemas.DescribeDiscovererOutput
@@ -234,6 +270,20 @@ resource "aws_schemas_discoverer" "test" {
 `, rName, description)
 }
 
+func testAccDiscovererConfig_crossAccount(rName string, crossAccount bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_schemas_discoverer" "test" {
+  source_arn = aws_cloudwatch_event_bus.test.arn
+
+  cross_account = %[2]t
+}
+`, rName, crossAccount)
+}
+
 func testAccDiscovererConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
@@ -374,7 +374,7 @@ func (r *automationRuleResource) Create(ctx context.Context, request resource.Cr
 	output, err := conn.CreateAutomationRule(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Security Hub Automation Rule (%s)", aws.ToString(input.RuleName)), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Security Hub Automation Rule (%s)", aws.ToString(input.RuleName)), err))
 
 		return
 	}
@@ -387,7 +387,7 @@ func (r *automationRuleResource) Create(ctx context.Context, request resource.Cr
 	automationRule, err := findAutomationRuleByARN(ctx, conn, ruleARN)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Automation Rule (%s)", ruleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Hub Automation Rule (%s)", ruleARN), err))
 
 		return
 	}
@@ -405,7 +405,7 @@ func (r *automationRuleResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -423,7 +423,7 @@ func (r *automationRuleResource) Read(ctx context.Context, request resource.Read
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Automation Rule (%s)", ruleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Hub Automation Rule (%s)", ruleARN), err))
 
 		return
 	}
@@ -469,7 +469,7 @@ func (r *automationRuleResource) Update(ctx context.Context, request resource.Up
 		_, err := conn.BatchUpdateAutomationRules(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Security Hub Automation Rule (%s)", aws.ToString(item.RuleArn)), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Security Hub Automation Rule (%s)", aws.ToString(item.RuleArn)), err))
 
 			return
 		}
@@ -497,7 +497,7 @@ func (r *automationRuleResource) Delete(ctx context.Context, request resource.De
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Automation Rule (%s)", ruleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Security Hub Automation Rule (%s)", ruleARN), err))
 
 		return
 	}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Automation Rules")
+func newAutomationRulesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &automationRulesDataSource{}, nil
+}
+
+type automationRulesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *automationRulesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_automation_rules"
+}
+
+func (d *automationRulesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"automation_rules_metadata": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesMetadataModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrARN:         types.StringType,
+						"created_at":          timetypes.RFC3339Type{},
+						"created_by":          types.StringType,
+						names.AttrDescription: types.StringType,
+						"is_terminal":         types.BoolType,
+						"rule_name":           types.StringType,
+						"rule_order":          types.Int64Type,
+						"rule_status":         fwtypes.StringEnumType[awstypes.RuleStatus](),
+						"updated_at":          timetypes.RFC3339Type{},
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *automationRulesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data automationRulesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SecurityHubClient(ctx)
+
+	output, err := findAutomationRulesMetadata(ctx, conn, &securityhub.ListAutomationRulesInput{})
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing Security Hub Automation Rules", err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.AutomationRulesMetadata)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAutomationRulesMetadata(ctx context.Context, conn *securityhub.Client, input *securityhub.ListAutomationRulesInput) ([]awstypes.AutomationRulesMetadata, error) {
+	var output []awstypes.AutomationRulesMetadata
+
+	for {
+		page, err := conn.ListAutomationRules(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationRulesMetadata...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+type automationRulesDataSourceModel struct {
+	AutomationRulesMetadata fwtypes.ListNestedObjectValueOf[automationRulesMetadataModel] `tfsdk:"automation_rules_metadata"`
+	ID                      types.String                                                  `tfsdk:"id"`
+}
+
+type automationRulesMetadataModel struct {
+	CreatedAt   timetypes.RFC3339                       `tfsdk:"created_at"`
+	CreatedBy   types.String                            `tfsdk:"created_by"`
+	Description types.String                            `tfsdk:"description"`
+	IsTerminal  types.Bool                              `tfsdk:"is_terminal"`
+	RuleARN     types.String                            `tfsdk:"arn"`
+	RuleName    types.String                            `tfsdk:"rule_name"`
+	RuleOrder   types.Int64                             `tfsdk:"rule_order"`
+	RuleStatus  fwtypes.StringEnum[awstypes.RuleStatus] `tfsdk:"rule_status"`
+	UpdatedAt   timetypes.RFC3339                       `tfsdk:"updated_at"`
+}
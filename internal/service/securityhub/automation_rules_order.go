@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of rules accepted by BatchGetAutomationRules and BatchUpdateAutomationRules.
+	automationRulesBatchSize = 100
+)
+
+// @FrameworkResource(name="Automation Rules Order")
+func newAutomationRulesOrderResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &automationRulesOrderResource{}, nil
+}
+
+type automationRulesOrderResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *automationRulesOrderResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_automation_rules_order"
+}
+
+func (r *automationRulesOrderResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"rule_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *automationRulesOrderResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data automationRulesOrderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	if err := updateAutomationRulesOrder(ctx, conn, fwflex.ExpandFrameworkStringValueList(ctx, data.RuleARNs)); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Security Hub Automation Rules Order", err))
+
+		return
+	}
+
+	data.ID = types.StringValue(r.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *automationRulesOrderResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data automationRulesOrderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	// On import there are no rules in state; order every existing rule.
+	var ruleARNs []string
+	if data.RuleARNs.IsNull() {
+		rules, err := findAutomationRulesMetadata(ctx, conn, &securityhub.ListAutomationRulesInput{})
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Hub Automation Rules Order (%s)", data.ID.ValueString()), err))
+
+			return
+		}
+
+		ruleARNs = tfslices.ApplyToAll(rules, func(v awstypes.AutomationRulesMetadata) string {
+			return aws.ToString(v.RuleArn)
+		})
+	} else {
+		ruleARNs = fwflex.ExpandFrameworkStringValueList(ctx, data.RuleARNs)
+	}
+
+	rules, err := findAutomationRulesByARNs(ctx, conn, ruleARNs)
+
+	if err == nil && len(rules) == 0 {
+		err = tfresource.NewEmptyResultError(ruleARNs)
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Hub Automation Rules Order (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	slices.SortStableFunc(rules, func(a, b awstypes.AutomationRulesConfig) int {
+		return int(aws.ToInt32(a.RuleOrder) - aws.ToInt32(b.RuleOrder))
+	})
+
+	data.RuleARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(rules, func(v awstypes.AutomationRulesConfig) string {
+		return aws.ToString(v.RuleArn)
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *automationRulesOrderResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new automationRulesOrderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	if !new.RuleARNs.Equal(old.RuleARNs) {
+		if err := updateAutomationRulesOrder(ctx, conn, fwflex.ExpandFrameworkStringValueList(ctx, new.RuleARNs)); err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Security Hub Automation Rules Order (%s)", new.ID.ValueString()), err))
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete leaves the rules and their current ordering in place.
+func (r *automationRulesOrderResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+func findAutomationRulesByARNs(ctx context.Context, conn *securityhub.Client, arns []string) ([]awstypes.AutomationRulesConfig, error) {
+	var output []awstypes.AutomationRulesConfig
+
+	for _, chunk := range tfslices.Chunks(arns, automationRulesBatchSize) {
+		input := &securityhub.BatchGetAutomationRulesInput{
+			AutomationRulesArns: chunk,
+		}
+
+		rules, err := findAutomationRules(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, rules...)
+	}
+
+	return output, nil
+}
+
+// updateAutomationRulesOrder sets each rule's order to its 1-based position in arns.
+// Rules are updated in a single request unless there are more than the API allows per batch.
+func updateAutomationRulesOrder(ctx context.Context, conn *securityhub.Client, arns []string) error {
+	var errs []error
+
+	for i, chunk := range tfslices.Chunks(arns, automationRulesBatchSize) {
+		input := &securityhub.BatchUpdateAutomationRulesInput{}
+
+		for j, arn := range chunk {
+			input.UpdateAutomationRulesRequestItems = append(input.UpdateAutomationRulesRequestItems, awstypes.UpdateAutomationRulesRequestItem{
+				RuleArn:   aws.String(arn),
+				RuleOrder: aws.Int32(int32(i*automationRulesBatchSize + j + 1)),
+			})
+		}
+
+		output, err := conn.BatchUpdateAutomationRules(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.UnprocessedAutomationRules {
+			errs = append(errs, fmt.Errorf("automation rule (%s): %s", aws.ToString(v.RuleArn), aws.ToString(v.ErrorMessage)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type automationRulesOrderResourceModel struct {
+	ID       types.String                      `tfsdk:"id"`
+	RuleARNs fwtypes.ListValueOf[types.String] `tfsdk:"rule_arns"`
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomationRulesOrder_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securityhub_automation_rules_order.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesOrderConfig_basic(rName, "aws_securityhub_automation_rule.test[0].arn, aws_securityhub_automation_rule.test[1].arn, aws_securityhub_automation_rule.test[2].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_arns.#", acctest.Ct3),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_arns.0", "aws_securityhub_automation_rule.test.0", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_arns.1", "aws_securityhub_automation_rule.test.1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_arns.2", "aws_securityhub_automation_rule.test.2", names.AttrARN),
+					testAccCheckAutomationRuleOrder(ctx, "aws_securityhub_automation_rule.test.0", 1),
+					testAccCheckAutomationRuleOrder(ctx, "aws_securityhub_automation_rule.test.1", 2),
+					testAccCheckAutomationRuleOrder(ctx, "aws_securityhub_automation_rule.test.2", 3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRulesOrderConfig_basic(rName, "aws_securityhub_automation_rule.test[2].arn, aws_securityhub_automation_rule.test[0].arn, aws_securityhub_automation_rule.test[1].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_arns.#", acctest.Ct3),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_arns.0", "aws_securityhub_automation_rule.test.2", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_arns.1", "aws_securityhub_automation_rule.test.0", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_arns.2", "aws_securityhub_automation_rule.test.1", names.AttrARN),
+					testAccCheckAutomationRuleOrder(ctx, "aws_securityhub_automation_rule.test.2", 1),
+					testAccCheckAutomationRuleOrder(ctx, "aws_securityhub_automation_rule.test.0", 2),
+					testAccCheckAutomationRuleOrder(ctx, "aws_securityhub_automation_rule.test.1", 3),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleOrder(ctx context.Context, n string, want int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		output, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToInt32(output.RuleOrder); got != want {
+			return fmt.Errorf("Security Hub Automation Rule (%s) rule_order = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccAutomationRulesOrderConfig_basic(rName, ruleARNs string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  count = 3
+
+  description = "test description"
+  rule_name   = "%[1]s-${count.index}"
+  rule_order  = count.index + 10
+
+  actions {
+    finding_fields_update {
+      severity {
+        label   = "LOW"
+        product = "0.0"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    resource_id {
+      comparison = "CONTAINS"
+      value      = %[1]q
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [rule_order]
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_automation_rules_order" "test" {
+  rule_arns = [%[2]s]
+}
+`, rName, ruleARNs)
+}
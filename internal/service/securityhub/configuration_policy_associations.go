@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum number of targets accepted by BatchGetConfigurationPolicyAssociations.
+	configurationPolicyAssociationsBatchSize = 50
+)
+
+// @SDKResource("aws_securityhub_configuration_policy_associations", name="Configuration Policy Associations")
+func resourceConfigurationPolicyAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyAssociationsCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyAssociationsRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyAssociationsUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"association_status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The universally unique identifier (UUID) of the configuration policy.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"target_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The identifiers of the target accounts, organizational units, or the root to associate with the specified configuration.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(
+						regexache.MustCompile(`^(r-[a-z0-9]{4,32})$|^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32})$|^([0-9]{12})$`),
+						"Target ID must be a valid root, organizational unit or account id.",
+					),
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	policyID := d.Get("policy_id").(string)
+	targetIDs := flex.ExpandStringValueSet(d.Get("target_ids").(*schema.Set))
+
+	if err := startConfigurationPolicyAssociations(ctx, conn, policyID, targetIDs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy (%s) Associations: %s", policyID, err)
+	}
+
+	d.SetId(policyID)
+
+	if _, err := waitConfigurationPolicyAssociationsSucceeded(ctx, conn, targetIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy (%s) Associations success: %s", policyID, err)
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	var associations []types.ConfigurationPolicyAssociationSummary
+	var err error
+
+	// On import there are no targets in state; read every target the policy is applied to.
+	if v := d.Get("target_ids").(*schema.Set); v.Len() > 0 {
+		associations, err = findConfigurationPolicyAssociationsByTargetIDs(ctx, conn, flex.ExpandStringValueSet(v))
+	} else {
+		associations, err = findConfigurationPolicyAssociationSummaries(ctx, conn, &securityhub.ListConfigurationPolicyAssociationsInput{
+			Filters: &types.AssociationFilters{
+				AssociationType:       types.AssociationTypeApplied,
+				ConfigurationPolicyId: aws.String(d.Id()),
+			},
+		})
+	}
+
+	if err == nil {
+		associations = tfslices.Filter(associations, func(v types.ConfigurationPolicyAssociationSummary) bool {
+			return aws.ToString(v.ConfigurationPolicyId) == d.Id() && v.AssociationType == types.AssociationTypeApplied
+		})
+
+		if len(associations) == 0 {
+			err = tfresource.NewEmptyResultError(d.Id())
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Associations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Associations (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("associations", flattenConfigurationPolicyAssociationSummaries(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting associations: %s", err)
+	}
+	d.Set("policy_id", d.Id())
+	d.Set("target_ids", tfslices.ApplyToAll(associations, func(v types.ConfigurationPolicyAssociationSummary) string {
+		return aws.ToString(v.TargetId)
+	}))
+
+	return diags
+}
+
+func resourceConfigurationPolicyAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	if d.HasChange("target_ids") {
+		o, n := d.GetChange("target_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := startConfigurationPolicyDisassociations(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy (%s) Disassociations: %s", d.Id(), err)
+		}
+
+		if err := startConfigurationPolicyAssociations(ctx, conn, d.Id(), add); err != nil {
+			return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy (%s) Associations: %s", d.Id(), err)
+		}
+
+		if len(add) > 0 {
+			if _, err := waitConfigurationPolicyAssociationsSucceeded(ctx, conn, add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy (%s) Associations success: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy Associations: %s", d.Id())
+	if err := startConfigurationPolicyDisassociations(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("target_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy (%s) Disassociations: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func startConfigurationPolicyAssociations(ctx context.Context, conn *securityhub.Client, policyID string, targetIDs []string) error {
+	var errs []error
+
+	for _, targetID := range targetIDs {
+		input := &securityhub.StartConfigurationPolicyAssociationInput{
+			ConfigurationPolicyIdentifier: aws.String(policyID),
+			Target:                        expandTarget(targetID),
+		}
+
+		if _, err := conn.StartConfigurationPolicyAssociation(ctx, input); err != nil {
+			errs = append(errs, fmt.Errorf("target (%s): %w", targetID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func startConfigurationPolicyDisassociations(ctx context.Context, conn *securityhub.Client, policyID string, targetIDs []string) error {
+	var errs []error
+
+	for _, targetID := range targetIDs {
+		input := &securityhub.StartConfigurationPolicyDisassociationInput{
+			ConfigurationPolicyIdentifier: aws.String(policyID),
+			Target:                        expandTarget(targetID),
+		}
+
+		_, err := conn.StartConfigurationPolicyDisassociation(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("target (%s): %w", targetID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func findConfigurationPolicyAssociationsByTargetIDs(ctx context.Context, conn *securityhub.Client, targetIDs []string) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	var output []types.ConfigurationPolicyAssociationSummary
+
+	for _, chunk := range tfslices.Chunks(targetIDs, configurationPolicyAssociationsBatchSize) {
+		input := &securityhub.BatchGetConfigurationPolicyAssociationsInput{
+			ConfigurationPolicyAssociationIdentifiers: tfslices.ApplyToAll(chunk, func(v string) types.ConfigurationPolicyAssociation {
+				return types.ConfigurationPolicyAssociation{
+					Target: expandTarget(v),
+				}
+			}),
+		}
+
+		page, err := conn.BatchGetConfigurationPolicyAssociations(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeAccessDeniedException, "Must be a Security Hub delegated administrator with Central Configuration enabled") || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Targets that are not found are returned as unprocessed.
+		for _, v := range page.UnprocessedConfigurationPolicyAssociations {
+			if aws.ToString(v.ErrorCode) != errCodeResourceNotFoundException {
+				return nil, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorReason))
+			}
+		}
+
+		output = append(output, page.ConfigurationPolicyAssociations...)
+	}
+
+	return output, nil
+}
+
+func findConfigurationPolicyAssociationSummaries(ctx context.Context, conn *securityhub.Client, input *securityhub.ListConfigurationPolicyAssociationsInput) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	var output []types.ConfigurationPolicyAssociationSummary
+
+	pages := securityhub.NewListConfigurationPolicyAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrMessageContains(err, errCodeAccessDeniedException, "Must be a Security Hub delegated administrator with Central Configuration enabled") || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfigurationPolicyAssociationSummaries...)
+	}
+
+	return output, nil
+}
+
+// statusConfigurationPolicyAssociations returns the least advanced status across all targets.
+func statusConfigurationPolicyAssociations(ctx context.Context, conn *securityhub.Client, targetIDs []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConfigurationPolicyAssociationsByTargetIDs(ctx, conn, targetIDs)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := types.ConfigurationPolicyAssociationStatusSuccess
+		for _, v := range output {
+			switch v.AssociationStatus {
+			case types.ConfigurationPolicyAssociationStatusFailed:
+				return output, string(v.AssociationStatus), nil
+			case types.ConfigurationPolicyAssociationStatusPending:
+				status = v.AssociationStatus
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitConfigurationPolicyAssociationsSucceeded(ctx context.Context, conn *securityhub.Client, targetIDs []string, timeout time.Duration) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ConfigurationPolicyAssociationStatusPending),
+		Target:  enum.Slice(types.ConfigurationPolicyAssociationStatusSuccess),
+		Refresh: statusConfigurationPolicyAssociations(ctx, conn, targetIDs),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if tfresource.TimedOut(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Associations still in PENDING state. It can take up to 24 hours for the status to change from PENDING to SUCCESS or FAILURE")
+		// As with a single association, don't error if still in PENDING state.
+		return findConfigurationPolicyAssociationsByTargetIDs(ctx, conn, targetIDs)
+	}
+
+	if output, ok := outputRaw.([]types.ConfigurationPolicyAssociationSummary); ok {
+		var errs []error
+		for _, v := range output {
+			if v.AssociationStatus == types.ConfigurationPolicyAssociationStatusFailed {
+				errs = append(errs, fmt.Errorf("target (%s): %s", aws.ToString(v.TargetId), aws.ToString(v.AssociationStatusMessage)))
+			}
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenConfigurationPolicyAssociationSummaries(apiObjects []types.ConfigurationPolicyAssociationSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"association_status":         string(apiObject.AssociationStatus),
+			"association_status_message": aws.ToString(apiObject.AssociationStatusMessage),
+			"target_id":                  aws.ToString(apiObject.TargetId),
+			"target_type":                string(apiObject.TargetType),
+		})
+	}
+
+	return tfList
+}
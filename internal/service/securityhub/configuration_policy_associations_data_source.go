@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Configuration Policy Associations")
+func newConfigurationPolicyAssociationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &configurationPolicyAssociationsDataSource{}, nil
+}
+
+type configurationPolicyAssociationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *configurationPolicyAssociationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_configuration_policy_associations"
+}
+
+func (d *configurationPolicyAssociationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"association_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigurationPolicyAssociationStatus](),
+				Optional:   true,
+			},
+			"association_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AssociationType](),
+				Optional:   true,
+			},
+			"associations": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configurationPolicyAssociationSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"association_status":         fwtypes.StringEnumType[awstypes.ConfigurationPolicyAssociationStatus](),
+						"association_status_message": types.StringType,
+						"association_type":           fwtypes.StringEnumType[awstypes.AssociationType](),
+						"policy_id":                  types.StringType,
+						"target_id":                  types.StringType,
+						"target_type":                fwtypes.StringEnumType[awstypes.TargetType](),
+						"updated_at":                 timetypes.RFC3339Type{},
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *configurationPolicyAssociationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data configurationPolicyAssociationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SecurityHubClient(ctx)
+
+	input := &securityhub.ListConfigurationPolicyAssociationsInput{
+		Filters: &awstypes.AssociationFilters{
+			AssociationStatus:     data.AssociationStatus.ValueEnum(),
+			AssociationType:       data.AssociationType.ValueEnum(),
+			ConfigurationPolicyId: fwflex.StringFromFramework(ctx, data.PolicyID),
+		},
+	}
+
+	output, err := findConfigurationPolicyAssociationSummaries(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("listing Security Hub Configuration Policy Associations", err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Associations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type configurationPolicyAssociationsDataSourceModel struct {
+	AssociationStatus fwtypes.StringEnum[awstypes.ConfigurationPolicyAssociationStatus]           `tfsdk:"association_status"`
+	AssociationType   fwtypes.StringEnum[awstypes.AssociationType]                                `tfsdk:"association_type"`
+	Associations      fwtypes.ListNestedObjectValueOf[configurationPolicyAssociationSummaryModel] `tfsdk:"associations"`
+	ID                types.String                                                                `tfsdk:"id"`
+	PolicyID          types.String                                                                `tfsdk:"policy_id"`
+}
+
+type configurationPolicyAssociationSummaryModel struct {
+	AssociationStatus        fwtypes.StringEnum[awstypes.ConfigurationPolicyAssociationStatus] `tfsdk:"association_status"`
+	AssociationStatusMessage types.String                                                      `tfsdk:"association_status_message"`
+	AssociationType          fwtypes.StringEnum[awstypes.AssociationType]                      `tfsdk:"association_type"`
+	ConfigurationPolicyID    types.String                                                      `tfsdk:"policy_id"`
+	TargetID                 types.String                                                      `tfsdk:"target_id"`
+	TargetType               fwtypes.StringEnum[awstypes.TargetType]                           `tfsdk:"target_type"`
+	UpdatedAt                timetypes.RFC3339                                                 `tfsdk:"updated_at"`
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPolicyAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providers := make(map[string]*schema.Provider)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy_associations.test"
+	dataSourceName := "data.aws_securityhub_configuration_policy_associations.test"
+	accountTarget := "data.aws_caller_identity.member.account_id"
+	ouTarget := "aws_organizations_organizational_unit.test.id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Run a simple configuration to initialize the alternate providers
+				Config: testAccOrganizationConfigurationConfig_centralConfigurationInit,
+			},
+			{
+				PreConfig: func() {
+					// Can only run check here because the provider is not available until the previous step.
+					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
+				},
+				Config: testAccConfigurationPolicyAssociationsConfig_basic(rName, ouTarget, accountTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "aws_securityhub_configuration_policy.test_1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_ids.*", "aws_organizations_organizational_unit.test", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_ids.*", "data.aws_caller_identity.member", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "associations.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"associations"},
+			},
+			{
+				Config: testAccConfigurationPolicyAssociationsConfig_basic(rName, ouTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_ids.*", "aws_organizations_organizational_unit.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "associations.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccConfigurationPolicyAssociationsConfig_dataSource(rName, ouTarget),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "associations.*.target_id", "aws_organizations_organizational_unit.test", names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "associations.*", map[string]string{
+						"association_type": string(types.AssociationTypeApplied),
+						"target_type":      string(types.TargetTypeOrganizationalUnit),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicyAssociations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	providers := make(map[string]*schema.Provider)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy_associations.test"
+	ouTarget := "aws_organizations_organizational_unit.test.id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Run a simple configuration to initialize the alternate providers
+				Config: testAccOrganizationConfigurationConfig_centralConfigurationInit,
+			},
+			{
+				PreConfig: func() {
+					// Can only run check here because the provider is not available until the previous step.
+					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
+				},
+				Config: testAccConfigurationPolicyAssociationsConfig_basic(rName, ouTarget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceConfigurationPolicyAssociations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyAssociationsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		output, err := tfsecurityhub.FindConfigurationPolicyAssociationsByTargetIDs(ctx, conn, testAccConfigurationPolicyAssociationsTargetIDs(rs))
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if aws.ToString(v.ConfigurationPolicyId) != rs.Primary.ID || v.AssociationType != types.AssociationTypeApplied {
+				return fmt.Errorf("Security Hub Configuration Policy (%s) not applied to target %s", rs.Primary.ID, aws.ToString(v.TargetId))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationPolicyAssociationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_configuration_policy_associations" {
+				continue
+			}
+
+			output, err := tfsecurityhub.FindConfigurationPolicyAssociationsByTargetIDs(ctx, conn, testAccConfigurationPolicyAssociationsTargetIDs(rs))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output {
+				if aws.ToString(v.ConfigurationPolicyId) == rs.Primary.ID && v.AssociationType == types.AssociationTypeApplied {
+					return fmt.Errorf("Security Hub Configuration Policy (%s) Association %s still exists", rs.Primary.ID, aws.ToString(v.TargetId))
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigurationPolicyAssociationsTargetIDs(rs *terraform.ResourceState) []string {
+	var targetIDs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "target_ids.") && k != "target_ids.#" {
+			targetIDs = append(targetIDs, v)
+		}
+	}
+
+	return targetIDs
+}
+
+func testAccConfigurationPolicyAssociationsConfig_basic(rName string, targetIDs ...string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccMemberAccountDelegatedAdminConfig_base,
+		testAccOrganizationalUnitConfig_base(rName),
+		testAccCentralConfigurationEnabledConfig_base,
+		testAccConfigurationPoliciesConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy_associations" "test" {
+  policy_id  = aws_securityhub_configuration_policy.test_1.id
+  target_ids = [%[1]s]
+}
+`, strings.Join(targetIDs, ", ")))
+}
+
+func testAccConfigurationPolicyAssociationsConfig_dataSource(rName string, targetIDs ...string) string {
+	return acctest.ConfigCompose(testAccConfigurationPolicyAssociationsConfig_basic(rName, targetIDs...), `
+data "aws_securityhub_configuration_policy_associations" "test" {
+  policy_id = aws_securityhub_configuration_policy_associations.test.policy_id
+}
+`)
+}
@@ -31,6 +31,12 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"mapFilters":         testAccAutomationRule_mapFilters,
 			"tags":               testAccAutomationRule_tags,
 		},
+		"AutomationRules": {
+			"dataSource": testAccAutomationRulesDataSource_basic,
+		},
+		"AutomationRulesOrder": {
+			acctest.CtBasic: testAccAutomationRulesOrder_basic,
+		},
 		"ActionTarget": {
 			acctest.CtBasic:      testAccActionTarget_basic,
 			acctest.CtDisappears: testAccActionTarget_disappears,
@@ -47,6 +53,10 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccConfigurationPolicyAssociation_basic,
 			acctest.CtDisappears: testAccConfigurationPolicyAssociation_disappears,
 		},
+		"ConfigurationPolicyAssociations": {
+			acctest.CtBasic:      testAccConfigurationPolicyAssociations_basic,
+			acctest.CtDisappears: testAccConfigurationPolicyAssociations_disappears,
+		},
 		"FindingAggregator": {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
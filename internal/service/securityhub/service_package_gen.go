@@ -16,7 +16,16 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newAutomationRulesDataSource,
+			Name:    "Automation Rules",
+		},
+		{
+			Factory: newConfigurationPolicyAssociationsDataSource,
+			Name:    "Configuration Policy Associations",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -28,6 +37,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newAutomationRulesOrderResource,
+			Name:    "Automation Rules Order",
+		},
 	}
 }
 
@@ -57,6 +70,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_securityhub_configuration_policy_association",
 			Name:     "Configuration Policy Association",
 		},
+		{
+			Factory:  resourceConfigurationPolicyAssociations,
+			TypeName: "aws_securityhub_configuration_policy_associations",
+			Name:     "Configuration Policy Associations",
+		},
 		{
 			Factory:  resourceFindingAggregator,
 			TypeName: "aws_securityhub_finding_aggregator",
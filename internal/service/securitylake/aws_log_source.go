@@ -122,7 +122,7 @@ func (r *awsLogSourceResource) Create(ctx context.Context, request resource.Crea
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Security Lake AWS Log Source", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Security Lake AWS Log Source", err))
 
 		return
 	}
@@ -133,7 +133,7 @@ func (r *awsLogSourceResource) Create(ctx context.Context, request resource.Crea
 	logSource, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -170,7 +170,7 @@ func (r *awsLogSourceResource) Read(ctx context.Context, request resource.ReadRe
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -207,7 +207,7 @@ func (r *awsLogSourceResource) Delete(ctx context.Context, request resource.Dele
 		logSource, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err))
 
 			return
 		}
@@ -224,7 +224,7 @@ func (r *awsLogSourceResource) Delete(ctx context.Context, request resource.Dele
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -193,7 +193,7 @@ func (r *customLogSourceResource) Create(ctx context.Context, request resource.C
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Security Lake Custom Log Source", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Security Lake Custom Log Source", err))
 
 		return
 	}
@@ -221,7 +221,7 @@ func (r *customLogSourceResource) Read(ctx context.Context, request resource.Rea
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -239,7 +239,7 @@ func (r *customLogSourceResource) Read(ctx context.Context, request resource.Rea
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", sourceName), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", sourceName), err))
 
 		return
 	}
@@ -281,7 +281,7 @@ func (r *customLogSourceResource) Delete(ctx context.Context, request resource.D
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err))
 
 		return
 	}
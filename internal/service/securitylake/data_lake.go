@@ -198,7 +198,7 @@ func (r *dataLakeResource) Create(ctx context.Context, request resource.CreateRe
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError("creating Security Lake Data Lake", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Security Lake Data Lake", err))
 
 		return
 	}
@@ -211,7 +211,7 @@ func (r *dataLakeResource) Create(ctx context.Context, request resource.CreateRe
 	dataLake, err = waitDataLakeCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", data.ID.ValueString()), err))
 
 		return
 	}
@@ -237,7 +237,7 @@ func (r *dataLakeResource) Read(ctx context.Context, request resource.ReadReques
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -254,7 +254,7 @@ func (r *dataLakeResource) Read(ctx context.Context, request resource.ReadReques
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -304,13 +304,13 @@ func (r *dataLakeResource) Update(ctx context.Context, request resource.UpdateRe
 		})
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err))
 
 			return
 		}
 
 		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err))
 
 			return
 		}
@@ -345,13 +345,13 @@ func (r *dataLakeResource) Delete(ctx context.Context, request resource.DeleteRe
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err))
 
 		return
 	}
 
 	if _, err = waitDataLakeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err))
 
 		return
 	}
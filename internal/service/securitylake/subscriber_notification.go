@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -144,7 +145,7 @@ func (r *subscriberNotificationResource) Create(ctx context.Context, request res
 
 	output, err := conn.CreateSubscriberNotification(ctx, input)
 	if err != nil {
-		response.Diagnostics.AddError("creating Security Lake Subscriber Notification", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Security Lake Subscriber Notification", err))
 
 		return
 	}
@@ -165,7 +166,7 @@ func (r *subscriberNotificationResource) Read(ctx context.Context, request resou
 	}
 
 	if err := data.initFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 		return
 	}
 
@@ -178,7 +179,7 @@ func (r *subscriberNotificationResource) Read(ctx context.Context, request resou
 		return
 	}
 	if err != nil {
-		response.Diagnostics.AddError("creating Security Lake Subscriber Notification", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Security Lake Subscriber Notification", err))
 		return
 	}
 
@@ -232,10 +233,10 @@ func (r *subscriberNotificationResource) Update(ctx context.Context, request res
 
 		output, err := conn.UpdateSubscriberNotification(ctx, in)
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionUpdating, ResNameSubscriberNotification, new.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 
@@ -266,10 +267,10 @@ func (r *subscriberNotificationResource) Delete(ctx context.Context, req resourc
 		if errors.As(err, &nfe) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameSubscriberNotification, data.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
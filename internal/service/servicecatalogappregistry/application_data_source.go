@@ -6,10 +6,14 @@ package servicecatalogappregistry
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -35,15 +39,38 @@ func (d *dataSourceApplication) Metadata(_ context.Context, req datasource.Metad
 func (d *dataSourceApplication) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrARN: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName(names.AttrID),
+						path.MatchRelative().AtParent().AtName(names.AttrName),
+					),
+				},
+			},
 			names.AttrDescription: schema.StringAttribute{
 				Computed: true,
 			},
 			names.AttrID: schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName(names.AttrARN),
+						path.MatchRelative().AtParent().AtName(names.AttrName),
+					),
+				},
 			},
 			names.AttrName: schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName(names.AttrARN),
+						path.MatchRelative().AtParent().AtName(names.AttrID),
+					),
+				},
 			},
 			"application_tag": schema.MapAttribute{
 				ElementType: types.StringType,
@@ -61,12 +88,20 @@ func (d *dataSourceApplication) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	out, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+	// GetApplication accepts the application's name, ID or ARN.
+	id := data.ID.ValueString()
+	if !data.Name.IsNull() {
+		id = data.Name.ValueString()
+	} else if !data.ARN.IsNull() {
+		id = data.ARN.ValueString()
+	}
+
+	out, err := findApplicationByID(ctx, conn, id)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionSetting, ResNameApplication, data.ID.String(), err),
-			err.Error(),
-		)
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionSetting, ResNameApplication, id, err),
+			err,
+		))
 		return
 	}
 
@@ -41,6 +41,61 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogAppRegistryApplicationDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_servicecatalogappregistry_application.test"
+	applicationResourceName := "aws_servicecatalogappregistry_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceCatalogAppRegistryEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDataSourceConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, applicationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, applicationResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_tag.awsApplication", applicationResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryApplicationDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_servicecatalogappregistry_application.test"
+	applicationResourceName := "aws_servicecatalogappregistry_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceCatalogAppRegistryEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDataSourceConfig_arn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, applicationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, applicationResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccApplicationDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_servicecatalogappregistry_application" "test" {
@@ -59,3 +114,27 @@ resource "aws_ssm_parameter" "test" {
 }
 `, rName)
 }
+
+func testAccApplicationDataSourceConfig_name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+data "aws_servicecatalogappregistry_application" "test" {
+  name = aws_servicecatalogappregistry_application.test.name
+}
+`, rName)
+}
+
+func testAccApplicationDataSourceConfig_arn(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+data "aws_servicecatalogappregistry_application" "test" {
+  arn = aws_servicecatalogappregistry_application.test.arn
+}
+`, rName)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Attribute Group Associations")
+func newDataSourceAttributeGroupAssociations(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceAttributeGroupAssociations{}, nil
+}
+
+const (
+	DSNameAttributeGroupAssociations = "Attribute Group Associations Data Source"
+)
+
+type dataSourceAttributeGroupAssociations struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceAttributeGroupAssociations) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_servicecatalogappregistry_attribute_group_associations"
+}
+
+func (d *dataSourceAttributeGroupAssociations) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attribute_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName(names.AttrName),
+					),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceAttributeGroupAssociations) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var data dataSourceAttributeGroupAssociationsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListAssociatedAttributeGroups accepts the application's name or ID.
+	id := data.ID.ValueString()
+	if !data.Name.IsNull() {
+		id = data.Name.ValueString()
+	}
+
+	out, err := findAssociatedAttributeGroupsByApplication(ctx, conn, id)
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionReading, DSNameAttributeGroupAssociations, id, err),
+			err,
+		))
+		return
+	}
+
+	data.AttributeGroupIDs = flex.FlattenFrameworkStringValueSet(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findAssociatedAttributeGroupsByApplication(ctx context.Context, conn *servicecatalogappregistry.Client, id string) ([]string, error) {
+	in := &servicecatalogappregistry.ListAssociatedAttributeGroupsInput{
+		Application: aws.String(id),
+	}
+
+	var out []string
+	pages := servicecatalogappregistry.NewListAssociatedAttributeGroupsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.AttributeGroups...)
+	}
+
+	return out, nil
+}
+
+type dataSourceAttributeGroupAssociationsData struct {
+	AttributeGroupIDs types.Set    `tfsdk:"attribute_group_ids"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+}
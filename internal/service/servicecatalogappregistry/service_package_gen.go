@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceApplication,
 			Name:    "Application",
 		},
+		{
+			Factory: newDataSourceAttributeGroupAssociations,
+			Name:    "Attribute Group Associations",
+		},
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -114,10 +115,10 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 	parts := []string{region, quotaCode, serviceCode}
 	id, err := flex.FlattenResourceId(parts, templateIDPartCount, false)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionCreating, ResNameTemplate, id, err),
-			err.Error(),
-		)
+			err,
+		))
 	}
 	plan.ID = fwflex.StringValueToFramework(ctx, id)
 
@@ -130,10 +131,10 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 
 	out, err := conn.PutServiceQuotaIncreaseRequestIntoTemplate(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionCreating, ResNameTemplate, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil || out.ServiceQuotaIncreaseRequestInTemplate == nil {
@@ -168,10 +169,10 @@ func (r *resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionSetting, ResNameTemplate, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -207,10 +208,10 @@ func (r *resourceTemplate) Update(ctx context.Context, req resource.UpdateReques
 
 		out, err := conn.PutServiceQuotaIncreaseRequestIntoTemplate(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionUpdating, ResNameTemplate, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil || out.ServiceQuotaIncreaseRequestInTemplate == nil {
@@ -251,10 +252,10 @@ func (r *resourceTemplate) Delete(ctx context.Context, req resource.DeleteReques
 		if errs.IsA[*awstypes.NoSuchResourceException](err) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionDeleting, ResNameTemplate, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -6,6 +6,7 @@ package sfn
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -15,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -60,21 +63,64 @@ func ResourceAlias() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				MinItems: 1,
+				MaxItems: 2,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"state_machine_version_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
 						},
 						names.AttrWeight: {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
 						},
 					},
 				},
 			},
 		},
+
+		CustomizeDiff: resourceAliasCustomizeDiff,
+	}
+}
+
+// resourceAliasCustomizeDiff validates the alias routing configuration at plan time.
+// Traffic can be shifted gradually (canary-style) between two versions by adjusting weights across applies.
+func resourceAliasCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("routing_configuration") {
+		return nil
 	}
+
+	var total int
+	versionARNs := make(map[string]struct{})
+
+	for i, tfMapRaw := range d.Get("routing_configuration").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v := tfMap["state_machine_version_arn"].(string); v != "" {
+			if _, ok := versionARNs[v]; ok {
+				return fmt.Errorf("routing_configuration.%d.state_machine_version_arn: duplicate state machine version (%s)", i, v)
+			}
+			versionARNs[v] = struct{}{}
+		}
+
+		if !d.NewValueKnown(fmt.Sprintf("routing_configuration.%d.weight", i)) {
+			return nil
+		}
+
+		total += tfMap[names.AttrWeight].(int)
+	}
+
+	if total != 100 {
+		return fmt.Errorf("routing_configuration weights must add up to 100, got %d", total)
+	}
+
+	return nil
 }
 
 const (
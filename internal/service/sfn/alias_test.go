@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -87,6 +88,28 @@ func TestAccSFNAlias_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSFNAlias_routingConfigurationValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineAliasConfig_routingConfiguration(rName, 1, 2, 50, 40),
+				ExpectError: regexache.MustCompile(`weights must add up to 100`),
+			},
+			{
+				Config:      testAccStateMachineAliasConfig_routingConfiguration(rName, 1, 1, 50, 50),
+				ExpectError: regexache.MustCompile(`duplicate state machine version`),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasAttributes(mapping *sfn.DescribeStateMachineAliasOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		name := *mapping.Name
@@ -294,3 +317,31 @@ resource "aws_sfn_alias" "test" {
 }
 `, aliasName))
 }
+
+func testAccStateMachineAliasConfig_routingConfiguration(rName string, version1, version2, weight1, weight2 int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+locals {
+  state_machine_arn = "arn:${data.aws_partition.current.partition}:states:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:stateMachine:%[1]s"
+}
+
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  routing_configuration {
+    state_machine_version_arn = "${local.state_machine_arn}:%[2]d"
+    weight                    = %[4]d
+  }
+
+  routing_configuration {
+    state_machine_version_arn = "${local.state_machine_arn}:%[3]d"
+    weight                    = %[5]d
+  }
+}
+`, rName, version1, version2, weight1, weight2)
+}
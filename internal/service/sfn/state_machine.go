@@ -5,6 +5,7 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,6 +62,11 @@ func ResourceStateMachine() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
 			},
+			"definition_validation_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(definitionValidationSeverity_Values(), false),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -162,8 +169,91 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceStateMachineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+const (
+	// The SDK currently only models the ERROR severity.
+	definitionValidationSeverityError   = sfn.ValidateStateMachineDefinitionSeverityError
+	definitionValidationSeverityWarning = "WARNING"
+)
+
+func definitionValidationSeverity_Values() []string {
+	return []string{
+		definitionValidationSeverityError,
+		definitionValidationSeverityWarning,
+	}
+}
+
+// definitionValidationDiagnosticFails returns whether a diagnostic of the specified severity
+// is at or above the configured minimum severity.
+func definitionValidationDiagnosticFails(diagnosticSeverity, minimumSeverity string) bool {
+	if minimumSeverity == definitionValidationSeverityWarning {
+		return true
+	}
+
+	return diagnosticSeverity == definitionValidationSeverityError
+}
+
+func resourceStateMachineCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	severity := d.Get("definition_validation_severity").(string)
+
+	if severity == "" {
+		return nil
+	}
+
+	// Only validate when the definition might have changed.
+	if d.Id() != "" && !d.HasChanges("definition", "definition_validation_severity") {
+		return nil
+	}
+
+	if !d.NewValueKnown("definition") {
+		log.Printf("[DEBUG] Skipping validation of Step Functions State Machine definition: definition is not yet known")
+		return nil
 	}
+
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	input := &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(d.Get("definition").(string)),
+		Type:       aws.String(d.Get(names.AttrType).(string)),
+	}
+
+	output, err := conn.ValidateStateMachineDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	var errs []error
+
+	for _, v := range output.Diagnostics {
+		if v == nil {
+			continue
+		}
+
+		message := fmt.Sprintf("%s: %s (%s)", aws.StringValue(v.Severity), aws.StringValue(v.Message), aws.StringValue(v.Code))
+		if v := aws.StringValue(v.Location); v != "" {
+			message = fmt.Sprintf("%s at %s", message, v)
+		}
+
+		if !definitionValidationDiagnosticFails(aws.StringValue(v.Severity), severity) {
+			log.Printf("[WARN] Step Functions State Machine definition: %s", message)
+			continue
+		}
+
+		errs = append(errs, errors.New(message))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	return nil
 }
 
 func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -229,6 +319,7 @@ func resourceStateMachineRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set(names.AttrCreationDate, nil)
 	}
 	d.Set("definition", output.Definition)
+	d.Set("definition_validation_severity", d.Get("definition_validation_severity").(string))
 	d.Set(names.AttrDescription, output.Description)
 	if output.LoggingConfiguration != nil {
 		if err := d.Set(names.AttrLoggingConfiguration, []interface{}{flattenLoggingConfiguration(output.LoggingConfiguration)}); err != nil {
@@ -276,7 +367,7 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SFNConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("definition_validation_severity", names.AttrTags, names.AttrTagsAll) {
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
 		input := &sfn.UpdateStateMachineInput{
 			Definition:      aws.String(d.Get("definition").(string)),
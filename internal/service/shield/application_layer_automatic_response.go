@@ -115,7 +115,7 @@ func (r *applicationLayerAutomaticResponseResource) Create(ctx context.Context,
 	_, err := conn.EnableApplicationLayerAutomaticResponse(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("enabling Shield Application Layer Automatic Response (%s)", resourceARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("enabling Shield Application Layer Automatic Response (%s)", resourceARN), err))
 
 		return
 	}
@@ -124,7 +124,7 @@ func (r *applicationLayerAutomaticResponseResource) Create(ctx context.Context,
 	data.setID()
 
 	if _, err := waitApplicationLayerAutomaticResponseEnabled(ctx, conn, resourceARN, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) create", resourceARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) create", resourceARN), err))
 
 		return
 	}
@@ -140,7 +140,7 @@ func (r *applicationLayerAutomaticResponseResource) Read(ctx context.Context, re
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -158,7 +158,7 @@ func (r *applicationLayerAutomaticResponseResource) Read(ctx context.Context, re
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Shield Application Layer Automatic Response (%s)", resourceARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Shield Application Layer Automatic Response (%s)", resourceARN), err))
 
 		return
 	}
@@ -203,13 +203,13 @@ func (r *applicationLayerAutomaticResponseResource) Update(ctx context.Context,
 		_, err := conn.UpdateApplicationLayerAutomaticResponse(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Shield Application Layer Automatic Response (%s)", resourceARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Shield Application Layer Automatic Response (%s)", resourceARN), err))
 
 			return
 		}
 
 		if _, err := waitApplicationLayerAutomaticResponseEnabled(ctx, conn, resourceARN, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) update", resourceARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) update", resourceARN), err))
 
 			return
 		}
@@ -239,13 +239,13 @@ func (r *applicationLayerAutomaticResponseResource) Delete(ctx context.Context,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("disabling Application Layer Automatic Response (%s)", resourceARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("disabling Application Layer Automatic Response (%s)", resourceARN), err))
 
 		return
 	}
 
 	if _, err := waitApplicationLayerAutomaticResponseDeleted(ctx, conn, resourceARN, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) delete", resourceARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield Application Layer Automatic Response (%s) delete", resourceARN), err))
 
 		return
 	}
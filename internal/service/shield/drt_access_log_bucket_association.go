@@ -96,7 +96,7 @@ func (r *drtAccessLogBucketAssociationResource) Create(ctx context.Context, requ
 	_, err := conn.AssociateDRTLogBucket(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Shield DRT Log Bucket Association (%s)", logBucket), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Shield DRT Log Bucket Association (%s)", logBucket), err))
 
 		return
 	}
@@ -109,7 +109,7 @@ func (r *drtAccessLogBucketAssociationResource) Create(ctx context.Context, requ
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield DRT Log Bucket Association (%s) create", logBucket), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield DRT Log Bucket Association (%s) create", logBucket), err))
 
 		return
 	}
@@ -125,7 +125,7 @@ func (r *drtAccessLogBucketAssociationResource) Read(ctx context.Context, reques
 	}
 
 	if err := data.InitFromID(); err != nil {
-		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
 
 		return
 	}
@@ -143,7 +143,7 @@ func (r *drtAccessLogBucketAssociationResource) Read(ctx context.Context, reques
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Shield DRT Log Bucket Association (%s)", logBucket), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Shield DRT Log Bucket Association (%s)", logBucket), err))
 
 		return
 	}
@@ -172,7 +172,7 @@ func (r *drtAccessLogBucketAssociationResource) Delete(ctx context.Context, requ
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Shield DRT Log Bucket Association (%s)", logBucket), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Shield DRT Log Bucket Association (%s)", logBucket), err))
 
 		return
 	}
@@ -182,7 +182,7 @@ func (r *drtAccessLogBucketAssociationResource) Delete(ctx context.Context, requ
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield DRT Log Bucket Association (%s) delete", logBucket), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield DRT Log Bucket Association (%s) delete", logBucket), err))
 
 		return
 	}
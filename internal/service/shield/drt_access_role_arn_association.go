@@ -83,7 +83,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Create(ctx context.Context, reques
 	}, "role does not have a valid DRT managed policy")
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Shield DRT Role ARN Association (%s)", roleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Shield DRT Role ARN Association (%s)", roleARN), err))
 
 		return
 	}
@@ -96,7 +96,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Create(ctx context.Context, reques
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield DRT Role ARN Association (%s) create", roleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield DRT Role ARN Association (%s) create", roleARN), err))
 
 		return
 	}
@@ -123,7 +123,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Read(ctx context.Context, request
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError("reading Shield DRT Access", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading Shield DRT Access", err))
 
 		return
 	}
@@ -156,7 +156,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Update(ctx context.Context, reques
 		}, "role does not have a valid DRT managed policy")
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Shield DRT Role ARN Association (%s)", roleARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating Shield DRT Role ARN Association (%s)", roleARN), err))
 
 			return
 		}
@@ -166,7 +166,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Update(ctx context.Context, reques
 		})
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield DRT Role ARN Association (%s) update", roleARN), err.Error())
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield DRT Role ARN Association (%s) update", roleARN), err))
 
 			return
 		}
@@ -194,7 +194,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Delete(ctx context.Context, reques
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting Shield DRT Role ARN Association (%s)", roleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting Shield DRT Role ARN Association (%s)", roleARN), err))
 
 		return
 	}
@@ -204,7 +204,7 @@ func (r *resourceDRTAccessRoleARNAssociation) Delete(ctx context.Context, reques
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("waiting for Shield DRT Role ARN Association (%s) delete", roleARN), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Shield DRT Role ARN Association (%s) delete", roleARN), err))
 
 		return
 	}
@@ -103,7 +103,7 @@ func (r *proactiveEngagementResource) Create(ctx context.Context, request resour
 
 	// "InvalidOperationException: Proactive engagement details are already associated with the subscription. Please use Enable/DisableProactiveEngagement APIs to update it's status".
 	if err != nil && !errs.IsA[*awstypes.InvalidOperationException](err) {
-		response.Diagnostics.AddError("creating Shield Proactive Engagement", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("creating Shield Proactive Engagement", err))
 
 		return
 	}
@@ -153,7 +153,7 @@ func (r *proactiveEngagementResource) Read(ctx context.Context, request resource
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError("reading Shield Proactive Engagement", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading Shield Proactive Engagement", err))
 
 		return
 	}
@@ -212,7 +212,7 @@ func (r *proactiveEngagementResource) Delete(ctx context.Context, request resour
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError("disabling Shield proactive engagement", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("disabling Shield proactive engagement", err))
 
 		return
 	}
@@ -228,7 +228,7 @@ func (r *proactiveEngagementResource) Delete(ctx context.Context, request resour
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError("updating Shield emergency contact settings", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("updating Shield emergency contact settings", err))
 
 		return
 	}
@@ -241,7 +241,7 @@ func disableProactiveEngagement(ctx context.Context, conn *shield.Client) diag.D
 	_, err := conn.DisableProactiveEngagement(ctx, input)
 
 	if err != nil {
-		diags.AddError("disabling Shield proactive engagement", err.Error())
+		diags.Append(fwdiag.NewErrorDiagnostic("disabling Shield proactive engagement", err))
 
 		return diags
 	}
@@ -256,7 +256,7 @@ func enableProactiveEngagement(ctx context.Context, conn *shield.Client) diag.Di
 	_, err := conn.EnableProactiveEngagement(ctx, input)
 
 	if err != nil {
-		diags.AddError("enabling Shield proactive engagement", err.Error())
+		diags.Append(fwdiag.NewErrorDiagnostic("enabling Shield proactive engagement", err))
 
 		return diags
 	}
@@ -288,7 +288,7 @@ func updateEmergencyContactSettings(ctx context.Context, conn *shield.Client, da
 	_, err := conn.UpdateEmergencyContactSettings(ctx, input)
 
 	if err != nil {
-		diags.AddError("updating Shield emergency contact settings", err.Error())
+		diags.Append(fwdiag.NewErrorDiagnostic("updating Shield emergency contact settings", err))
 
 		return diags
 	}
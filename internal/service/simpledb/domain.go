@@ -78,7 +78,7 @@ func (r *resourceDomain) Create(ctx context.Context, request resource.CreateRequ
 	_, err := conn.CreateDomainWithContext(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating SimpleDB Domain (%s)", name), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating SimpleDB Domain (%s)", name), err))
 
 		return
 	}
@@ -111,7 +111,7 @@ func (r *resourceDomain) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading SimpleDB Domain (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading SimpleDB Domain (%s)", data.ID.ValueString()), err))
 
 		return
 	}
@@ -150,7 +150,7 @@ func (r *resourceDomain) Delete(ctx context.Context, request resource.DeleteRequ
 	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("deleting SimpleDB Domain (%s)", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting SimpleDB Domain (%s)", data.ID.ValueString()), err))
 
 		return
 	}
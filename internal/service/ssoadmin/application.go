@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -187,10 +188,10 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 
 	out, err := conn.CreateApplication(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.Name.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	if out == nil {
@@ -207,10 +208,10 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 	// Read after create to get computed attributes omitted from the create response
 	readOut, err := findApplicationByID(ctx, conn, plan.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	plan.ApplicationAccount = flex.StringToFramework(ctx, readOut.ApplicationAccount)
@@ -237,10 +238,10 @@ func (r *resourceApplication) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplication, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -261,10 +262,10 @@ func (r *resourceApplication) Read(ctx context.Context, req resource.ReadRequest
 	// explicitly rather than with transparent tagging.
 	tags, err := listTags(ctx, conn, state.ApplicationARN.ValueString(), state.InstanceARN.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplication, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 	setTagsOut(ctx, Tags(tags))
@@ -316,10 +317,10 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 
 		out, err := conn.UpdateApplication(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplication, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 		if out == nil {
@@ -335,10 +336,10 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 	// explicitly rather than with transparent tagging.
 	if oldTagsAll, newTagsAll := state.TagsAll, plan.TagsAll; !newTagsAll.Equal(oldTagsAll) {
 		if err := updateTags(ctx, conn, plan.ApplicationARN.ValueString(), plan.InstanceARN.ValueString(), oldTagsAll, newTagsAll); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplication, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
@@ -364,10 +365,10 @@ func (r *resourceApplication) Delete(ctx context.Context, req resource.DeleteReq
 		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplication, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Assignments")
+func newResourceApplicationAssignments(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAssignments{}, nil
+}
+
+const (
+	ResNameApplicationAssignments = "Application Assignments"
+)
+
+type resourceApplicationAssignments struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationAssignments) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_ssoadmin_application_assignments"
+}
+
+func (r *resourceApplicationAssignments) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"principal": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[applicationAssignmentsPrincipalData](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principal_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PrincipalType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationAssignments) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan resourceApplicationAssignmentsData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationARN := plan.ApplicationARN.ValueString()
+	plan.ID = types.StringValue(applicationARN)
+
+	principals, diags := expandApplicationAssignmentsPrincipals(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopt any existing assignments rather than failing on them.
+	existing, err := findApplicationAssignmentsByApplicationARN(ctx, conn, applicationARN)
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationAssignments, applicationARN, err),
+			err,
+		))
+		return
+	}
+
+	for principal := range principals {
+		if _, ok := existing[principal]; ok {
+			continue
+		}
+
+		if err := createApplicationAssignment(ctx, conn, applicationARN, principal); err != nil {
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationAssignments, applicationARN, err),
+				err,
+			))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceApplicationAssignments) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationAssignmentsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All of the application's assignments are listed in pages, rather than described one at a time.
+	out, err := findApplicationAssignmentsByApplicationARN(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationAssignments, state.ID.String(), err),
+			err,
+		))
+		return
+	}
+
+	principals := make([]*applicationAssignmentsPrincipalData, 0, len(out))
+	for principal := range out {
+		principals = append(principals, &applicationAssignmentsPrincipalData{
+			PrincipalID:   types.StringValue(principal.ID),
+			PrincipalType: fwtypes.StringEnumValue(principal.Type),
+		})
+	}
+
+	state.ApplicationARN = state.ID
+	state.Principals = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, principals)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceApplicationAssignments) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan, state resourceApplicationAssignmentsData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Principals.Equal(state.Principals) {
+		applicationARN := plan.ApplicationARN.ValueString()
+
+		newPrincipals, diags := expandApplicationAssignmentsPrincipals(ctx, plan.Principals)
+		resp.Diagnostics.Append(diags...)
+		oldPrincipals, diags := expandApplicationAssignmentsPrincipals(ctx, state.Principals)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for principal := range oldPrincipals {
+			if _, ok := newPrincipals[principal]; ok {
+				continue
+			}
+
+			if err := deleteApplicationAssignment(ctx, conn, applicationARN, principal); err != nil {
+				resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+					create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationAssignments, plan.ID.String(), err),
+					err,
+				))
+				return
+			}
+		}
+
+		for principal := range newPrincipals {
+			if _, ok := oldPrincipals[principal]; ok {
+				continue
+			}
+
+			if err := createApplicationAssignment(ctx, conn, applicationARN, principal); err != nil {
+				resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+					create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationAssignments, plan.ID.String(), err),
+					err,
+				))
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceApplicationAssignments) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationAssignmentsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principals, diags := expandApplicationAssignmentsPrincipals(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for principal := range principals {
+		if err := deleteApplicationAssignment(ctx, conn, state.ApplicationARN.ValueString(), principal); err != nil {
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplicationAssignments, state.ID.String(), err),
+				err,
+			))
+			return
+		}
+	}
+}
+
+func (r *resourceApplicationAssignments) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+// applicationAssignmentsPrincipal identifies a principal assigned to an application.
+type applicationAssignmentsPrincipal struct {
+	ID   string
+	Type awstypes.PrincipalType
+}
+
+func createApplicationAssignment(ctx context.Context, conn *ssoadmin.Client, applicationARN string, principal applicationAssignmentsPrincipal) error {
+	in := &ssoadmin.CreateApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principal.ID),
+		PrincipalType:  principal.Type,
+	}
+
+	if _, err := conn.CreateApplicationAssignment(ctx, in); err != nil {
+		return fmt.Errorf("assigning %s (%s): %w", principal.Type, principal.ID, err)
+	}
+
+	return nil
+}
+
+func deleteApplicationAssignment(ctx context.Context, conn *ssoadmin.Client, applicationARN string, principal applicationAssignmentsPrincipal) error {
+	in := &ssoadmin.DeleteApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principal.ID),
+		PrincipalType:  principal.Type,
+	}
+
+	_, err := conn.DeleteApplicationAssignment(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unassigning %s (%s): %w", principal.Type, principal.ID, err)
+	}
+
+	return nil
+}
+
+func findApplicationAssignmentsByApplicationARN(ctx context.Context, conn *ssoadmin.Client, applicationARN string) (map[applicationAssignmentsPrincipal]struct{}, error) {
+	in := &ssoadmin.ListApplicationAssignmentsInput{
+		ApplicationArn: aws.String(applicationARN),
+	}
+	out := make(map[applicationAssignmentsPrincipal]struct{})
+
+	pages := ssoadmin.NewListApplicationAssignmentsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ApplicationAssignments {
+			out[applicationAssignmentsPrincipal{ID: aws.ToString(v.PrincipalId), Type: v.PrincipalType}] = struct{}{}
+		}
+	}
+
+	return out, nil
+}
+
+func expandApplicationAssignmentsPrincipals(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[applicationAssignmentsPrincipalData]) (map[applicationAssignmentsPrincipal]struct{}, diag.Diagnostics) {
+	tfList, diags := tfSet.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make(map[applicationAssignmentsPrincipal]struct{}, len(tfList))
+	for _, v := range tfList {
+		apiObjects[applicationAssignmentsPrincipal{ID: v.PrincipalID.ValueString(), Type: v.PrincipalType.ValueEnum()}] = struct{}{}
+	}
+
+	return apiObjects, diags
+}
+
+type resourceApplicationAssignmentsData struct {
+	ApplicationARN types.String                                                        `tfsdk:"application_arn"`
+	ID             types.String                                                        `tfsdk:"id"`
+	Principals     fwtypes.SetNestedObjectValueOf[applicationAssignmentsPrincipalData] `tfsdk:"principal"`
+}
+
+type applicationAssignmentsPrincipalData struct {
+	PrincipalID   types.String                               `tfsdk:"principal_id"`
+	PrincipalType fwtypes.StringEnum[awstypes.PrincipalType] `tfsdk:"principal_type"`
+}
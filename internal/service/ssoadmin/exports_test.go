@@ -13,6 +13,7 @@ var (
 
 	FindApplicationByID                        = findApplicationByID
 	FindApplicationAssignmentByID              = findApplicationAssignmentByID
+	FindApplicationAssignmentsByApplicationARN = findApplicationAssignmentsByApplicationARN
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
 	FindApplicationAccessScopeByID             = findApplicationAccessScopeByID
 	FindTrustedTokenIssuerByARN                = findTrustedTokenIssuerByARN
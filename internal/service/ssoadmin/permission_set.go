@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,6 +31,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// permissionSetProvisioningConcurrency is the maximum number of accounts a permission set is provisioned to at once.
+	permissionSetProvisioningConcurrency = 5
+)
+
 // @SDKResource("aws_ssoadmin_permission_set", name="Permission Set")
 // @Tags
 func ResourcePermissionSet() *schema.Resource {
@@ -78,6 +85,11 @@ func ResourcePermissionSet() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), "must match [\\w+=,.@-]"),
 				),
 			},
+			"provision_stale_accounts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"relay_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -92,11 +104,19 @@ func ResourcePermissionSet() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 100),
 				Default:      "PT1H",
 			},
+			"stale_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePermissionSetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -164,6 +184,20 @@ func resourcePermissionSetRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("relay_state", permissionSet.RelayState)
 	d.Set("session_duration", permissionSet.SessionDuration)
 
+	// Stale accounts are only looked up when they are to be provisioned, so that reading a
+	// Permission Set doesn't need sso:ListAccountsForProvisionedPermissionSet.
+	if d.Get("provision_stale_accounts").(bool) {
+		staleAccountIDs, err := findStaleAccountIDsForPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSO Permission Set (%s) stale accounts: %s", d.Id(), err)
+		}
+
+		d.Set("stale_account_ids", staleAccountIDs)
+	} else {
+		d.Set("stale_account_ids", nil)
+	}
+
 	tags, err := listTags(ctx, conn, permissionSetARN, instanceARN)
 
 	if err != nil {
@@ -217,6 +251,10 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+	} else if d.HasChange("stale_account_ids") && d.Get("provision_stale_accounts").(bool) {
+		if err := provisionPermissionSetToStaleAccounts(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	if d.HasChange(names.AttrTagsAll) {
@@ -255,6 +293,15 @@ func resourcePermissionSetDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func resourcePermissionSetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Plan the provisioning of accounts with an outdated copy of the permission set.
+	if d.Id() != "" && d.Get("provision_stale_accounts").(bool) && d.Get("stale_account_ids").(*schema.Set).Len() > 0 {
+		return d.SetNew("stale_account_ids", []string{})
+	}
+
+	return nil
+}
+
 func ParseResourceID(id string) (string, string, error) {
 	idParts := strings.Split(id, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -309,6 +356,110 @@ func provisionPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissi
 	return nil
 }
 
+// provisionPermissionSetToStaleAccounts provisions the permission set to each account that has an outdated copy of it,
+// at most permissionSetProvisioningConcurrency accounts at a time, and reports the failures together.
+func provisionPermissionSetToStaleAccounts(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, timeout time.Duration) error {
+	accountIDs, err := findStaleAccountIDsForPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return fmt.Errorf("reading SSO Permission Set (%s) stale accounts: %w", permissionSetARN, err)
+	}
+
+	var (
+		mu        sync.Mutex
+		failures  []error
+		wg        sync.WaitGroup
+		sem       = make(chan struct{}, permissionSetProvisioningConcurrency)
+		succeeded int
+	)
+
+	for _, accountID := range accountIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(accountID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := provisionPermissionSetToAccount(ctx, conn, permissionSetARN, instanceARN, accountID, timeout)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				failures = append(failures, fmt.Errorf("account %s: %w", accountID, err))
+			} else {
+				succeeded++
+			}
+		}(accountID)
+	}
+
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return fmt.Errorf("provisioning SSO Permission Set (%s) to %d stale accounts (%d succeeded, %d failed): %w", permissionSetARN, len(accountIDs), succeeded, len(failures), errors.Join(failures...))
+	}
+
+	return nil
+}
+
+func provisionPermissionSetToAccount(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN, accountID string, timeout time.Duration) error {
+	input := &ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		TargetId:         aws.String(accountID),
+		TargetType:       awstypes.ProvisionTargetTypeAwsAccount,
+	}
+
+	// Provisioning requests for the same permission set may conflict with each other.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.ProvisionPermissionSet(ctx, input)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitPermissionSetProvisioned(ctx, conn, instanceARN, aws.ToString(outputRaw.(*ssoadmin.ProvisionPermissionSetOutput).PermissionSetProvisioningStatus.RequestId), timeout); err != nil {
+		return fmt.Errorf("waiting for provision: %w", err)
+	}
+
+	return nil
+}
+
+// findStaleAccountIDsForPermissionSet returns the IDs of the accounts to which the latest version of the permission set
+// hasn't been provisioned.
+func findStaleAccountIDsForPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) ([]string, error) {
+	input := &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:        aws.String(instanceARN),
+		PermissionSetArn:   aws.String(permissionSetARN),
+		ProvisioningStatus: awstypes.ProvisioningStatusLatestPermissionSetNotProvisioned,
+	}
+	var output []string
+
+	pages := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountIds...)
+	}
+
+	return output, nil
+}
+
 func findPermissionSetProvisioningStatus(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string) (*awstypes.PermissionSetProvisioningStatus, error) {
 	input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
 		InstanceArn:                     aws.String(instanceARN),
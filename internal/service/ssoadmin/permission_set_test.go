@@ -46,6 +46,35 @@ func TestAccSSOAdminPermissionSet_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminPermissionSet_provisionStaleAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetConfig_provisionStaleAccounts(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provision_stale_accounts", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "stale_account_ids.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provision_stale_accounts"},
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminPermissionSet_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ssoadmin_permission_set.test"
@@ -339,6 +368,18 @@ resource "aws_ssoadmin_permission_set" "test" {
 `, rName)
 }
 
+func testAccPermissionSetConfig_provisionStaleAccounts(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name                     = %[1]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  provision_stale_accounts = true
+}
+`, rName)
+}
+
 func testAccPermissionSetConfig_updateDescription(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
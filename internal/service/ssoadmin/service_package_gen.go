@@ -48,6 +48,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceApplicationAssignment,
 			Name:    "Application Assignment",
 		},
+		{
+			Factory: newResourceApplicationAssignments,
+			Name:    "Application Assignments",
+		},
 		{
 			Factory: newResourceApplicationAssignmentConfiguration,
 			Name:    "Application Assignment Configuration",
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagegateway
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_storagegateway_automatic_tape_creation_policy", name="Automatic Tape Creation Policy")
+func resourceAutomaticTapeCreationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomaticTapeCreationPolicyPut,
+		ReadWithoutTimeout:   resourceAutomaticTapeCreationPolicyRead,
+		UpdateWithoutTimeout: resourceAutomaticTapeCreationPolicyPut,
+		DeleteWithoutTimeout: resourceAutomaticTapeCreationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"automatic_tape_creation_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minimum_num_tapes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"pool_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"tape_barcode_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Z]{1,4}$`), "must be 1 to 4 uppercase letters"),
+						},
+						"tape_size_in_bytes": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"worm": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAutomaticTapeCreationPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
+
+	gatewayARN := d.Get("gateway_arn").(string)
+	input := &storagegateway.UpdateAutomaticTapeCreationPolicyInput{
+		AutomaticTapeCreationRules: expandAutomaticTapeCreationRules(d.Get("automatic_tape_creation_rule").([]interface{})),
+		GatewayARN:                 aws.String(gatewayARN),
+	}
+
+	_, err := conn.UpdateAutomaticTapeCreationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Storage Gateway Automatic Tape Creation Policy (%s): %s", gatewayARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(gatewayARN)
+	}
+
+	return append(diags, resourceAutomaticTapeCreationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceAutomaticTapeCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
+
+	output, err := FindAutomaticTapeCreationPolicyByGatewayARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Storage Gateway Automatic Tape Creation Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Storage Gateway Automatic Tape Creation Policy (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("automatic_tape_creation_rule", flattenAutomaticTapeCreationRules(output.AutomaticTapeCreationRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting automatic_tape_creation_rule: %s", err)
+	}
+	d.Set("gateway_arn", output.GatewayARN)
+
+	return diags
+}
+
+func resourceAutomaticTapeCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Storage Gateway Automatic Tape Creation Policy: %s", d.Id())
+	_, err := conn.DeleteAutomaticTapeCreationPolicyWithContext(ctx, &storagegateway.DeleteAutomaticTapeCreationPolicyInput{
+		GatewayARN: aws.String(d.Id()),
+	})
+
+	if IsErrGatewayNotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Storage Gateway Automatic Tape Creation Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandAutomaticTapeCreationRules(tfList []interface{}) []*storagegateway.AutomaticTapeCreationRule {
+	var apiObjects []*storagegateway.AutomaticTapeCreationRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &storagegateway.AutomaticTapeCreationRule{
+			MinimumNumTapes:   aws.Int64(int64(tfMap["minimum_num_tapes"].(int))),
+			PoolId:            aws.String(tfMap["pool_id"].(string)),
+			TapeBarcodePrefix: aws.String(tfMap["tape_barcode_prefix"].(string)),
+			TapeSizeInBytes:   aws.Int64(int64(tfMap["tape_size_in_bytes"].(int))),
+			Worm:              aws.Bool(tfMap["worm"].(bool)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAutomaticTapeCreationRules(apiObjects []*storagegateway.AutomaticTapeCreationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"minimum_num_tapes":   aws.Int64Value(apiObject.MinimumNumTapes),
+			"pool_id":             aws.StringValue(apiObject.PoolId),
+			"tape_barcode_prefix": aws.StringValue(apiObject.TapeBarcodePrefix),
+			"tape_size_in_bytes":  aws.Int64Value(apiObject.TapeSizeInBytes),
+			"worm":                aws.BoolValue(apiObject.Worm),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagegateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfstoragegateway "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccStorageGatewayAutomaticTapeCreationPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_automatic_tape_creation_policy.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomaticTapeCreationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomaticTapeCreationPolicyConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomaticTapeCreationPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.0.minimum_num_tapes", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.0.pool_id", "GLACIER"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.0.tape_barcode_prefix", "TFA"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.0.tape_size_in_bytes", "107374182400"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.0.worm", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomaticTapeCreationPolicyConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomaticTapeCreationPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "automatic_tape_creation_rule.0.minimum_num_tapes", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayAutomaticTapeCreationPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_automatic_tape_creation_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomaticTapeCreationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomaticTapeCreationPolicyConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomaticTapeCreationPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfstoragegateway.ResourceAutomaticTapeCreationPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAutomaticTapeCreationPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_storagegateway_automatic_tape_creation_policy" {
+				continue
+			}
+
+			_, err := tfstoragegateway.FindAutomaticTapeCreationPolicyByGatewayARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Storage Gateway Automatic Tape Creation Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomaticTapeCreationPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
+
+		_, err := tfstoragegateway.FindAutomaticTapeCreationPolicyByGatewayARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAutomaticTapeCreationPolicyConfig_basic(rName string, minimumNumTapes int) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_typeVtl(rName), fmt.Sprintf(`
+resource "aws_storagegateway_automatic_tape_creation_policy" "test" {
+  gateway_arn = aws_storagegateway_gateway.test.arn
+
+  automatic_tape_creation_rule {
+    minimum_num_tapes   = %[1]d
+    pool_id             = "GLACIER"
+    tape_barcode_prefix = "TFA"
+    tape_size_in_bytes  = 107374182400
+  }
+}
+`, minimumNumTapes))
+}
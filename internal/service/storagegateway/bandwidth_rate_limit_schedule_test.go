@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagegateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfstoragegateway "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccStorageGatewayBandwidthRateLimitSchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_bandwidth_rate_limit_schedule.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBandwidthRateLimitScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBandwidthRateLimitScheduleConfig_basic(rName, 102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBandwidthRateLimitScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "102400"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.days_of_week.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_hour_of_day", "8"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_hour_of_day", "17"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBandwidthRateLimitScheduleConfig_basic(rName, 204800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBandwidthRateLimitScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "204800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayBandwidthRateLimitSchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_bandwidth_rate_limit_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBandwidthRateLimitScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBandwidthRateLimitScheduleConfig_basic(rName, 102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBandwidthRateLimitScheduleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfstoragegateway.ResourceBandwidthRateLimitSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBandwidthRateLimitScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_storagegateway_bandwidth_rate_limit_schedule" {
+				continue
+			}
+
+			_, err := tfstoragegateway.FindBandwidthRateLimitScheduleByGatewayARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Storage Gateway Bandwidth Rate Limit Schedule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBandwidthRateLimitScheduleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
+
+		_, err := tfstoragegateway.FindBandwidthRateLimitScheduleByGatewayARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccBandwidthRateLimitScheduleConfig_basic(rName string, downloadRate int) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_typeCached(rName), fmt.Sprintf(`
+resource "aws_storagegateway_bandwidth_rate_limit_schedule" "test" {
+  gateway_arn = aws_storagegateway_gateway.test.arn
+
+  bandwidth_rate_limit_interval {
+    average_download_rate_limit_in_bits_per_sec = %[1]d
+    average_upload_rate_limit_in_bits_per_sec   = 51200
+    days_of_week                                = [1, 2]
+    start_hour_of_day                           = 8
+    start_minute_of_hour                        = 0
+    end_hour_of_day                             = 17
+    end_minute_of_hour                          = 59
+  }
+}
+`, downloadRate))
+}
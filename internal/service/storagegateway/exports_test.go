@@ -5,15 +5,17 @@ package storagegateway
 
 // Exports for use in tests only.
 var (
-	ResourceCache                 = resourceCache
-	ResourceCachediSCSIVolume     = resourceCachediSCSIVolume
-	ResourceFileSystemAssociation = resourceFileSystemAssociation
-	ResourceGateway               = resourceGateway
-	ResourceNFSFileShare          = resourceNFSFileShare
-	ResourceSMBFileShare          = resourceSMBFileShare
-	ResourceStorediSCSIVolume     = resourceStorediSCSIVolume
-	ResourceTapePool              = resourceTapePool
-	ResourceUploadBuffer          = resourceUploadBuffer
+	ResourceAutomaticTapeCreationPolicy = resourceAutomaticTapeCreationPolicy
+	ResourceBandwidthRateLimitSchedule  = resourceBandwidthRateLimitSchedule
+	ResourceCache                       = resourceCache
+	ResourceCachediSCSIVolume           = resourceCachediSCSIVolume
+	ResourceFileSystemAssociation       = resourceFileSystemAssociation
+	ResourceGateway                     = resourceGateway
+	ResourceNFSFileShare                = resourceNFSFileShare
+	ResourceSMBFileShare                = resourceSMBFileShare
+	ResourceStorediSCSIVolume           = resourceStorediSCSIVolume
+	ResourceTapePool                    = resourceTapePool
+	ResourceUploadBuffer                = resourceUploadBuffer
 
 	CacheParseResourceID = cacheParseResourceID
 )
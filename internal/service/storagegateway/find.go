@@ -196,3 +196,59 @@ func FindFileSystemAssociationByARN(ctx context.Context, conn *storagegateway.St
 
 	return output.FileSystemAssociationInfoList[0], nil
 }
+
+func FindBandwidthRateLimitScheduleByGatewayARN(ctx context.Context, conn *storagegateway.StorageGateway, gatewayARN string) (*storagegateway.DescribeBandwidthRateLimitScheduleOutput, error) {
+	input := &storagegateway.DescribeBandwidthRateLimitScheduleInput{
+		GatewayARN: aws.String(gatewayARN),
+	}
+
+	output, err := conn.DescribeBandwidthRateLimitScheduleWithContext(ctx, input)
+
+	if operationErrorCode(err) == operationErrCodeGatewayNotFound || tfawserr.ErrCodeEquals(err, storagegateway.ErrorCodeGatewayNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BandwidthRateLimitIntervals) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAutomaticTapeCreationPolicyByGatewayARN(ctx context.Context, conn *storagegateway.StorageGateway, gatewayARN string) (*storagegateway.AutomaticTapeCreationPolicyInfo, error) {
+	input := &storagegateway.ListAutomaticTapeCreationPoliciesInput{
+		GatewayARN: aws.String(gatewayARN),
+	}
+
+	output, err := conn.ListAutomaticTapeCreationPoliciesWithContext(ctx, input)
+
+	if operationErrorCode(err) == operationErrCodeGatewayNotFound || tfawserr.ErrCodeEquals(err, storagegateway.ErrorCodeGatewayNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.AutomaticTapeCreationPolicyInfos {
+		if v != nil && aws.StringValue(v.GatewayARN) == gatewayARN && len(v.AutomaticTapeCreationRules) > 0 {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
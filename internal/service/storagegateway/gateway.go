@@ -167,6 +167,21 @@ func resourceGateway() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IntBetween(0, 59),
 						},
+						"software_update_preferences": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"automatic_update_policy": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(storagegateway.AutomaticUpdatePolicy_Values(), false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -880,6 +895,24 @@ func expandUpdateMaintenanceStartTimeInput(tfMap map[string]interface{}) *storag
 		apiObject.MinuteOfHour = aws.Int64(int64(v))
 	}
 
+	if v, ok := tfMap["software_update_preferences"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SoftwareUpdatePreferences = expandSoftwareUpdatePreferences(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSoftwareUpdatePreferences(tfMap map[string]interface{}) *storagegateway.SoftwareUpdatePreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &storagegateway.SoftwareUpdatePreferences{}
+
+	if v, ok := tfMap["automatic_update_policy"].(string); ok && v != "" {
+		apiObject.AutomaticUpdatePolicy = aws.String(v)
+	}
+
 	return apiObject
 }
 
@@ -906,6 +939,24 @@ func flattenDescribeMaintenanceStartTimeOutput(apiObject *storagegateway.Describ
 		tfMap["minute_of_hour"] = aws.Int64Value(v)
 	}
 
+	if v := apiObject.SoftwareUpdatePreferences; v != nil {
+		tfMap["software_update_preferences"] = []interface{}{flattenSoftwareUpdatePreferences(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSoftwareUpdatePreferences(apiObject *storagegateway.SoftwareUpdatePreferences) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutomaticUpdatePolicy; v != nil {
+		tfMap["automatic_update_policy"] = aws.StringValue(v)
+	}
+
 	return tfMap
 }
 
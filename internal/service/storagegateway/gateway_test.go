@@ -872,6 +872,45 @@ func TestAccStorageGatewayGateway_maintenanceStartTime(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayGateway_softwareUpdatePreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_softwareUpdatePreferences(rName, storagegateway.AutomaticUpdatePolicyEmergencyVersionsOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.0.automatic_update_policy", storagegateway.AutomaticUpdatePolicyEmergencyVersionsOnly),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_softwareUpdatePreferences(rName, storagegateway.AutomaticUpdatePolicyAllVersions),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.0.automatic_update_policy", storagegateway.AutomaticUpdatePolicyAllVersions),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGatewayDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
@@ -1495,3 +1534,24 @@ resource "aws_storagegateway_gateway" "test" {
 }
 `, rName, hourOfDay, minuteOfHour, dayOfWeek, dayOfMonth))
 }
+
+func testAccGatewayConfig_softwareUpdatePreferences(rName, automaticUpdatePolicy string) string {
+	return acctest.ConfigCompose(testAcc_TapeAndVolumeGatewayBase(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "CACHED"
+
+  maintenance_start_time {
+    hour_of_day    = 22
+    minute_of_hour = 0
+    day_of_week    = 3
+
+    software_update_preferences {
+      automatic_update_policy = %[2]q
+    }
+  }
+}
+`, rName, automaticUpdatePolicy))
+}
@@ -37,6 +37,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAutomaticTapeCreationPolicy,
+			TypeName: "aws_storagegateway_automatic_tape_creation_policy",
+			Name:     "Automatic Tape Creation Policy",
+		},
+		{
+			Factory:  resourceBandwidthRateLimitSchedule,
+			TypeName: "aws_storagegateway_bandwidth_rate_limit_schedule",
+			Name:     "Bandwidth Rate Limit Schedule",
+		},
 		{
 			Factory:  resourceCache,
 			TypeName: "aws_storagegateway_cache",
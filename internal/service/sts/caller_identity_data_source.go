@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -69,7 +70,7 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 	output, err := FindCallerIdentity(ctx, conn)
 
 	if err != nil {
-		response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading STS Caller Identity", err))
 
 		return
 	}
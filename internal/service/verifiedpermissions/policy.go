@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -190,25 +191,25 @@ func statementReplaceIf(_ context.Context, req planmodifier.StringRequest, resp
 
 	cedarPlan, err := cedar.Tokenize([]byte(req.PlanValue.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(err.Error(), err))
 		return
 	}
 
 	cedarState, err := cedar.Tokenize([]byte(req.StateValue.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(err.Error(), err))
 		return
 	}
 
 	policyPlan, err := cedar.Parse(cedarPlan)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(err.Error(), err))
 		return
 	}
 
 	policyState, err := cedar.Parse(cedarState)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(err.Error(), err))
 		return
 	}
 
@@ -313,10 +314,10 @@ func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest,
 
 	out, err := conn.CreatePolicy(ctx, in)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicy, plan.PolicyStoreID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -327,10 +328,10 @@ func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest,
 
 	rID, err := interflex.FlattenResourceId(idParts, ResourcePolicyIDPartsCount, false)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicy, plan.PolicyStoreID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -352,10 +353,10 @@ func (r *resourcePolicy) Read(ctx context.Context, req resource.ReadRequest, res
 
 	rID, err := interflex.ExpandResourceId(state.ID.ValueString(), ResourcePolicyIDPartsCount, false)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionSetting, ResNamePolicy, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -366,10 +367,10 @@ func (r *resourcePolicy) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionSetting, ResNamePolicy, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 
@@ -467,10 +468,10 @@ func (r *resourcePolicy) Update(ctx context.Context, req resource.UpdateRequest,
 
 		_, err := conn.UpdatePolicy(ctx, in)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicy, plan.ID.String(), err),
-				err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
@@ -499,10 +500,10 @@ func (r *resourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(fwdiag.NewErrorDiagnostic(
 			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicy, state.ID.String(), err),
-			err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -24,6 +24,7 @@ const (
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
 	AutoScalingPlans             = "autoscalingplans"
+	B2BI                         = "b2bi"
 	BCMDataExports               = "bcmdataexports"
 	Backup                       = "backup"
 	Batch                        = "batch"
@@ -74,6 +75,7 @@ const (
 	DataPipeline                 = "datapipeline"
 	DataSync                     = "datasync"
 	DataZone                     = "datazone"
+	Deadline                     = "deadline"
 	Deploy                       = "deploy"
 	Detective                    = "detective"
 	DevOpsGuru                   = "devopsguru"
@@ -97,6 +99,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -112,6 +115,7 @@ const (
 	Greengrass                   = "greengrass"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
+	Health                       = "health"
 	HealthLake                   = "healthlake"
 	IAM                          = "iam"
 	IVS                          = "ivs"
@@ -265,6 +269,7 @@ const (
 	AuditManagerServiceID                 = "AuditManager"
 	AutoScalingServiceID                  = "Auto Scaling"
 	AutoScalingPlansServiceID             = "Auto Scaling Plans"
+	B2BIServiceID                         = "b2bi"
 	BCMDataExportsServiceID               = "BCM Data Exports"
 	BackupServiceID                       = "Backup"
 	BatchServiceID                        = "Batch"
@@ -315,6 +320,7 @@ const (
 	DataPipelineServiceID                 = "Data Pipeline"
 	DataSyncServiceID                     = "DataSync"
 	DataZoneServiceID                     = "DataZone"
+	DeadlineServiceID                     = "deadline"
 	DeployServiceID                       = "CodeDeploy"
 	DetectiveServiceID                    = "Detective"
 	DevOpsGuruServiceID                   = "DevOps Guru"
@@ -338,6 +344,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -353,6 +360,7 @@ const (
 	GreengrassServiceID                   = "Greengrass"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
+	HealthServiceID                       = "Health"
 	HealthLakeServiceID                   = "HealthLake"
 	IAMServiceID                          = "IAM"
 	IVSServiceID                          = "ivs"